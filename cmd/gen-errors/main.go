@@ -0,0 +1,445 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const errorMetadataCSVName = "error_metadata.csv"
+
+const sentinelName = "DCGM_FR_ERROR_SENTINEL"
+
+// ErrorCode describes one DCGM_FR_* value of the dcgmError_t enum.
+type ErrorCode struct {
+	Name        string
+	ID          int
+	Description string
+	Message     string
+	Suggestion  string
+	Severity    string
+	Category    string
+}
+
+// DeprecatedErrorAlias describes a deprecated DCGM_FR_* name that aliases a current code.
+type DeprecatedErrorAlias struct {
+	Name   string
+	Target string
+}
+
+type TemplateData struct {
+	Codes             []ErrorCode
+	DeprecatedAliases []DeprecatedErrorAlias
+	Sentinel          ErrorCode
+}
+
+// errorMetadata holds the curated severity and category of an error code.
+type errorMetadata struct {
+	Severity string
+	Category string
+}
+
+// Header is the parsed content of dcgm_errors.h.
+type Header struct {
+	Codes      []ErrorCode
+	Aliases    []DeprecatedErrorAlias
+	Severities map[string]bool
+	Categories map[string]bool
+	// Macros holds the raw body of every #define, keyed by name.
+	Macros map[string]string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("gen-errors", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	errorMetadataFlag := flags.String(
+		"error-metadata",
+		"",
+		"CSV file containing the severity and category of each error code (default: output directory)",
+	)
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 1
+	}
+	if len(flags.Args()) != 2 {
+		fmt.Fprintf(stderr, "Usage: gen-errors [--error-metadata path] <dcgm_errors.h> <const_errors.go>\n")
+		return 1
+	}
+
+	headerPath := flags.Arg(0)
+	outputPath := flags.Arg(1)
+	errorMetadataPath := errorMetadataCSVPath(*errorMetadataFlag, outputPath)
+
+	header, err := parseHeader(headerPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error parsing header: %v\n", err)
+		return 1
+	}
+
+	metadata, err := readErrorMetadataCSV(errorMetadataPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading error metadata from %q: %v\n", errorMetadataPath, err)
+		return 1
+	}
+
+	data, err := buildTemplateData(header, metadata)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error building error metadata: %v\n", err)
+		return 1
+	}
+
+	err = generateOutput(data, outputPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error generating output: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(stdout, "Generated %d error codes (+ %d deprecated aliases) to %s\n",
+		len(data.Codes), len(data.DeprecatedAliases), outputPath)
+	return 0
+}
+
+func errorMetadataCSVPath(flagPath, outputPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	return filepath.Join(filepath.Dir(outputPath), errorMetadataCSVName)
+}
+
+var (
+	enumStartPattern   = regexp.MustCompile(`^typedef enum (dcgmError|dcgmErrorSeverity|dcgmErrorCategory)_enum\b`)
+	enumEntryPattern   = regexp.MustCompile(`^(DCGM_\w+)\s*=\s*(\w+)\s*,?$`)
+	defineStartPattern = regexp.MustCompile(`^#define\s+(\w+)(?:\s+(.*))?$`)
+	leadingIDPattern   = regexp.MustCompile(`^\d+\s+`)
+	blockCommentRegexp = regexp.MustCompile(`/\*.*?\*/`)
+)
+
+// parseHeader reads the error code, severity and category enums and every
+// #define of dcgm_errors.h.
+func parseHeader(path string) (Header, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Header{}, fmt.Errorf("failed to open header file: %w", err)
+	}
+	defer file.Close()
+
+	header := Header{
+		Severities: make(map[string]bool),
+		Categories: make(map[string]bool),
+		Macros:     make(map[string]string),
+	}
+
+	var (
+		enumName string
+		entry    string
+		macro    string
+		body     strings.Builder
+	)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Continuation lines of a multi-line #define.
+		if macro != "" {
+			continued := strings.HasSuffix(line, `\`)
+			body.WriteString(" ")
+			body.WriteString(strings.TrimSuffix(line, `\`))
+			if !continued {
+				header.Macros[macro] = strings.TrimSpace(body.String())
+				macro = ""
+			}
+			continue
+		}
+
+		if enumName == "" {
+			if m := enumStartPattern.FindStringSubmatch(line); m != nil {
+				enumName = m[1]
+				continue
+			}
+			if m := defineStartPattern.FindStringSubmatch(line); m != nil {
+				rest := m[2]
+				if strings.HasSuffix(rest, `\`) {
+					macro = m[1]
+					body.Reset()
+					body.WriteString(strings.TrimSuffix(rest, `\`))
+					continue
+				}
+				header.Macros[m[1]] = strings.TrimSpace(rest)
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "}") {
+			enumName = ""
+			entry = ""
+			continue
+		}
+		if line == "{" || line == "" {
+			continue
+		}
+
+		// Enum entries may wrap before the '=', so accumulate until the
+		// trailing comma and the doc comment that follows it.
+		code, comment, _ := strings.Cut(line, "//!<")
+		entry += " " + strings.TrimSpace(code)
+		if !strings.HasSuffix(strings.TrimSpace(code), ",") && comment == "" {
+			continue
+		}
+		m := enumEntryPattern.FindStringSubmatch(strings.TrimSpace(entry))
+		entry = ""
+		if m == nil {
+			continue
+		}
+		name, value := m[1], m[2]
+		comment = strings.TrimSpace(comment)
+
+		switch enumName {
+		case "dcgmErrorSeverity":
+			header.Severities[name] = true
+		case "dcgmErrorCategory":
+			header.Categories[name] = true
+		case "dcgmError":
+			if id, err := strconv.Atoi(value); err == nil {
+				header.Codes = append(header.Codes, ErrorCode{
+					Name:        name,
+					ID:          id,
+					Description: leadingIDPattern.ReplaceAllString(comment, ""),
+				})
+				continue
+			}
+			if !strings.Contains(strings.ToLower(comment), "deprecated") {
+				return Header{}, fmt.Errorf("%s aliases %s without a Deprecated: comment", name, value)
+			}
+			header.Aliases = append(header.Aliases, DeprecatedErrorAlias{Name: name, Target: value})
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return Header{}, fmt.Errorf("error reading header: %w", err)
+	}
+	if len(header.Codes) == 0 {
+		return Header{}, errors.New("no DCGM_FR_* error codes found")
+	}
+
+	return header, nil
+}
+
+// expandStringMacro evaluates a macro whose body is a sequence of C string
+// literals and references to other such macros, as used by the _MSG and
+// _NEXT definitions of dcgm_errors.h.
+func expandStringMacro(macros map[string]string, name string, seen map[string]bool) (string, error) {
+	if seen[name] {
+		return "", fmt.Errorf("macro %s is recursive", name)
+	}
+	body, ok := macros[name]
+	if !ok {
+		return "", fmt.Errorf("macro %s is not defined", name)
+	}
+	seen[name] = true
+	defer delete(seen, name)
+
+	body = blockCommentRegexp.ReplaceAllString(body, "")
+
+	var out strings.Builder
+	for rest := strings.TrimSpace(body); rest != ""; rest = strings.TrimSpace(rest) {
+		if rest[0] == '"' {
+			end := closingQuote(rest)
+			if end < 0 {
+				return "", fmt.Errorf("macro %s has an unterminated string literal", name)
+			}
+			s, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return "", fmt.Errorf("macro %s: %w", name, err)
+			}
+			out.WriteString(s)
+			rest = rest[end+1:]
+			continue
+		}
+
+		ident := rest
+		if i := strings.IndexAny(rest, " \t\""); i >= 0 {
+			ident = rest[:i]
+		}
+		if strings.HasPrefix(ident, "//") {
+			break
+		}
+		s, err := expandStringMacro(macros, ident, seen)
+		if err != nil {
+			return "", fmt.Errorf("macro %s: %w", name, err)
+		}
+		out.WriteString(s)
+		rest = rest[len(ident):]
+	}
+
+	return out.String(), nil
+}
+
+// closingQuote returns the index of the quote that closes the string literal
+// at the start of s, or -1.
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+// buildTemplateData attaches the message format, suggestion, severity and
+// category to every error code. A code without metadata, or metadata for a
+// code that is not in the header, is an error so the CSV cannot drift.
+func buildTemplateData(header Header, metadata map[string]errorMetadata) (TemplateData, error) {
+	var data TemplateData
+	known := make(map[string]bool, len(header.Codes))
+
+	for _, code := range header.Codes {
+		known[code.Name] = true
+		if code.Name == sentinelName {
+			data.Sentinel = code
+			continue
+		}
+
+		var err error
+		if code.Message, err = expandStringMacro(header.Macros, code.Name+"_MSG", map[string]bool{}); err != nil {
+			return TemplateData{}, err
+		}
+		if code.Suggestion, err = expandStringMacro(header.Macros, code.Name+"_NEXT", map[string]bool{}); err != nil {
+			return TemplateData{}, err
+		}
+
+		meta, ok := metadata[code.Name]
+		if !ok {
+			return TemplateData{}, fmt.Errorf("%s has no row in %s", code.Name, errorMetadataCSVName)
+		}
+		if !header.Severities[meta.Severity] {
+			return TemplateData{}, fmt.Errorf("%s: unknown severity %q", code.Name, meta.Severity)
+		}
+		if !header.Categories[meta.Category] {
+			return TemplateData{}, fmt.Errorf("%s: unknown category %q", code.Name, meta.Category)
+		}
+		code.Severity = meta.Severity
+		code.Category = meta.Category
+
+		data.Codes = append(data.Codes, code)
+	}
+
+	if data.Sentinel.Name == "" {
+		return TemplateData{}, fmt.Errorf("%s not found", sentinelName)
+	}
+
+	for name := range metadata {
+		if !known[name] {
+			return TemplateData{}, fmt.Errorf("%s names unknown error code %s", errorMetadataCSVName, name)
+		}
+	}
+
+	for _, alias := range header.Aliases {
+		if !known[alias.Target] {
+			return TemplateData{}, fmt.Errorf("deprecated alias %s targets unknown error code %s", alias.Name, alias.Target)
+		}
+	}
+	data.DeprecatedAliases = header.Aliases
+
+	return data, nil
+}
+
+func readErrorMetadataCSV(path string) (map[string]errorMetadata, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open error metadata CSV: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read error metadata CSV header: %w", err)
+	}
+	if len(header) != 3 || strings.TrimSpace(header[0]) != "name" ||
+		strings.TrimSpace(header[1]) != "severity" || strings.TrimSpace(header[2]) != "category" {
+		return nil, errors.New("error metadata CSV header must be: name,severity,category")
+	}
+
+	metadata := make(map[string]errorMetadata)
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error metadata CSV row %d: %w", row, err)
+		}
+
+		name := strings.TrimSpace(record[0])
+		if !strings.HasPrefix(name, "DCGM_FR_") {
+			return nil, fmt.Errorf("error metadata CSV row %d: %q is not a DCGM_FR_ error code", row, name)
+		}
+		if _, exists := metadata[name]; exists {
+			return nil, fmt.Errorf("error metadata CSV row %d: duplicate name %q", row, name)
+		}
+		metadata[name] = errorMetadata{
+			Severity: strings.TrimSpace(record[1]),
+			Category: strings.TrimSpace(record[2]),
+		}
+	}
+
+	return metadata, nil
+}
+
+func generateOutput(data TemplateData, outputPath string) error {
+	tmpl, err := template.New("errors").Parse(fileTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	err = tmpl.Execute(file, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return nil
+}
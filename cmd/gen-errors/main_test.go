@@ -0,0 +1,315 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testHeader = `
+typedef enum dcgmError_enum
+{
+    DCGM_FR_OK              = 0, //!< 0 No error
+    DCGM_FR_CLOCKS_EVENT_THERMAL = 1, //!< 1 Clocks being optimized for thermal performance
+    DCGM_FR_CLOCK_THROTTLE_THERMAL
+        = DCGM_FR_CLOCKS_EVENT_THERMAL, //!< Deprecated: Use DCGM_FR_CLOCKS_EVENT_THERMAL instead
+    DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE
+        = 2, //!< 2 P2P copy test detected an error writing from this GPU over PCIE
+    DCGM_FR_ERROR_SENTINEL  = 3, //!< 3 MUST BE THE LAST ERROR CODE
+} dcgmError_t;
+
+typedef enum dcgmErrorSeverity_enum
+{
+    DCGM_ERROR_NONE    = 0, //!< 0 NONE
+    DCGM_ERROR_MONITOR = 1, //!< 1 Can perform workload, but needs to be monitored.
+} dcgmErrorSeverity_t;
+
+typedef enum dcgmErrorCategory_enum
+{
+    DCGM_FR_EC_NONE             = 0, //!< 0 NONE
+    DCGM_FR_EC_HARDWARE_THERMAL = 9, //!< 9 Hardware Thermal
+} dcgmErrorCategory_t;
+
+#define DEBUG_COOLING_MSG                          \
+    "Verify that the cooling on this machine is " \
+    "functional."
+#define BUG_REPORT_MSG "Please capture an nvidia-bug-report."
+
+#define DCGM_FR_OK_MSG "The operation completed successfully."
+// gpu id
+#define DCGM_FR_CLOCKS_EVENT_THERMAL_MSG "Detected clocks event in GPU %u."
+#define DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE_MSG \
+    "GPU %u unsuccessfully wrote data to GPU %u: %s"
+#define DCGM_FR_ERROR_SENTINEL_MSG "" /* See message inplace */
+
+#define DCGM_FR_OK_NEXT "N/A"
+#define DCGM_FR_CLOCKS_EVENT_THERMAL_NEXT "Check the \"cooling\": " DEBUG_COOLING_MSG
+#define DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE_NEXT BUG_REPORT_MSG
+#define DCGM_FR_ERROR_SENTINEL_NEXT "" /* See message inplace */
+`
+
+const testMetadata = `# comment
+name,severity,category
+DCGM_FR_OK,DCGM_ERROR_NONE,DCGM_FR_EC_NONE
+DCGM_FR_CLOCKS_EVENT_THERMAL,DCGM_ERROR_MONITOR,DCGM_FR_EC_HARDWARE_THERMAL
+DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE,DCGM_ERROR_MONITOR,DCGM_FR_EC_NONE
+`
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	return path
+}
+
+func findCode(codes []ErrorCode, name string) (ErrorCode, bool) {
+	for _, c := range codes {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return ErrorCode{}, false
+}
+
+func TestParseHeader(t *testing.T) {
+	header, err := parseHeader(writeFile(t, "dcgm_errors.h", testHeader))
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+
+	if len(header.Codes) != 4 {
+		t.Fatalf("got %d codes, want 4: %+v", len(header.Codes), header.Codes)
+	}
+	wrapped, ok := findCode(header.Codes, "DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE")
+	if !ok {
+		t.Fatal("wrapped enum entry not parsed")
+	}
+	if wrapped.ID != 2 || wrapped.Description != "P2P copy test detected an error writing from this GPU over PCIE" {
+		t.Errorf("wrapped entry = %+v", wrapped)
+	}
+
+	want := DeprecatedErrorAlias{Name: "DCGM_FR_CLOCK_THROTTLE_THERMAL", Target: "DCGM_FR_CLOCKS_EVENT_THERMAL"}
+	if len(header.Aliases) != 1 || header.Aliases[0] != want {
+		t.Errorf("aliases = %+v, want [%+v]", header.Aliases, want)
+	}
+	if !header.Severities["DCGM_ERROR_MONITOR"] || !header.Categories["DCGM_FR_EC_HARDWARE_THERMAL"] {
+		t.Errorf("severity or category enum not parsed: %v %v", header.Severities, header.Categories)
+	}
+}
+
+func TestParseHeader_UndocumentedAliasFails(t *testing.T) {
+	path := writeFile(t, "dcgm_errors.h", `
+typedef enum dcgmError_enum
+{
+    DCGM_FR_OK  = 0, //!< 0 No error
+    DCGM_FR_NEW = DCGM_FR_OK, //!< Same as OK
+} dcgmError_t;
+`)
+	if _, err := parseHeader(path); err == nil || !strings.Contains(err.Error(), "DCGM_FR_NEW") {
+		t.Fatalf("expected error naming the alias, got %v", err)
+	}
+}
+
+func TestExpandStringMacro(t *testing.T) {
+	macros := map[string]string{
+		"A":         `"first " "second"`,
+		"B":         `"prefix: " A /* trailing comment */`,
+		"ESCAPED":   `"run \"nvidia-smi\""`,
+		"COMMENTED": `"value" // trailing comment`,
+		"LOOP":      `LOOP`,
+	}
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"A", "first second"},
+		{"B", "prefix: first second"},
+		{"ESCAPED", `run "nvidia-smi"`},
+		{"COMMENTED", "value"},
+	}
+	for _, tt := range tests {
+		got, err := expandStringMacro(macros, tt.name, map[string]bool{})
+		if err != nil {
+			t.Fatalf("expandStringMacro(%s): %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("expandStringMacro(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := expandStringMacro(macros, "LOOP", map[string]bool{}); err == nil {
+		t.Error("expected recursive macro to fail")
+	}
+	if _, err := expandStringMacro(macros, "MISSING", map[string]bool{}); err == nil {
+		t.Error("expected undefined macro to fail")
+	}
+}
+
+func TestBuildTemplateData(t *testing.T) {
+	header, err := parseHeader(writeFile(t, "dcgm_errors.h", testHeader))
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+	metadata, err := readErrorMetadataCSV(writeFile(t, errorMetadataCSVName, testMetadata))
+	if err != nil {
+		t.Fatalf("readErrorMetadataCSV: %v", err)
+	}
+
+	data, err := buildTemplateData(header, metadata)
+	if err != nil {
+		t.Fatalf("buildTemplateData: %v", err)
+	}
+	if data.Sentinel.Name != sentinelName || data.Sentinel.ID != 3 {
+		t.Errorf("sentinel = %+v", data.Sentinel)
+	}
+	if _, ok := findCode(data.Codes, sentinelName); ok {
+		t.Error("sentinel must not be part of the metadata table")
+	}
+
+	thermal, _ := findCode(data.Codes, "DCGM_FR_CLOCKS_EVENT_THERMAL")
+	if thermal.Message != "Detected clocks event in GPU %u." {
+		t.Errorf("Message = %q", thermal.Message)
+	}
+	if thermal.Suggestion != `Check the "cooling": Verify that the cooling on this machine is functional.` {
+		t.Errorf("Suggestion = %q", thermal.Suggestion)
+	}
+	if thermal.Severity != "DCGM_ERROR_MONITOR" || thermal.Category != "DCGM_FR_EC_HARDWARE_THERMAL" {
+		t.Errorf("Severity, Category = %s, %s", thermal.Severity, thermal.Category)
+	}
+}
+
+func TestBuildTemplateData_MetadataMustMatchHeader(t *testing.T) {
+	header, err := parseHeader(writeFile(t, "dcgm_errors.h", testHeader))
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr string
+	}{
+		{
+			"missing row",
+			"name,severity,category\nDCGM_FR_OK,DCGM_ERROR_NONE,DCGM_FR_EC_NONE\n",
+			"has no row",
+		},
+		{
+			"unknown code",
+			testMetadata + "DCGM_FR_REMOVED,DCGM_ERROR_NONE,DCGM_FR_EC_NONE\n",
+			"unknown error code DCGM_FR_REMOVED",
+		},
+		{
+			"unknown severity",
+			strings.Replace(testMetadata, "DCGM_ERROR_NONE", "DCGM_ERROR_PANIC", 1),
+			`unknown severity "DCGM_ERROR_PANIC"`,
+		},
+		{
+			"unknown category",
+			strings.Replace(testMetadata, "DCGM_FR_EC_HARDWARE_THERMAL", "DCGM_FR_EC_WEATHER", 1),
+			`unknown category "DCGM_FR_EC_WEATHER"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := readErrorMetadataCSV(writeFile(t, errorMetadataCSVName, tt.csv))
+			if err != nil {
+				t.Fatalf("readErrorMetadataCSV: %v", err)
+			}
+			_, err = buildTemplateData(header, metadata)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReadErrorMetadataCSV_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{"bad header", "name,severity\n", "header must be"},
+		{"not an error code", "name,severity,category\nDCGM_ST_OK,DCGM_ERROR_NONE,DCGM_FR_EC_NONE\n", "not a DCGM_FR_ error code"},
+		{
+			"duplicate",
+			"name,severity,category\nDCGM_FR_OK,DCGM_ERROR_NONE,DCGM_FR_EC_NONE\nDCGM_FR_OK,DCGM_ERROR_NONE,DCGM_FR_EC_NONE\n",
+			"duplicate name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readErrorMetadataCSV(writeFile(t, errorMetadataCSVName, tt.contents))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRun_DefaultsMetadataCSVToOutputDirectory(t *testing.T) {
+	headerPath := writeFile(t, "dcgm_errors.h", testHeader)
+	dir := t.TempDir()
+	outputPath := filepath.Join(dir, "const_errors.go")
+	if err := os.WriteFile(filepath.Join(dir, errorMetadataCSVName), []byte(testMetadata), 0o600); err != nil {
+		t.Fatalf("writing default metadata CSV: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{headerPath, outputPath}, &stdout, &stderr); code != 0 {
+		t.Fatalf("run returned %d, stderr: %s", code, stderr.String())
+	}
+
+	out, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	got := string(out)
+	for _, want := range []string{
+		"DCGM_FR_CLOCKS_EVENT_THERMAL HealthCheckErrorCode = 1",
+		"DCGM_FR_ERROR_SENTINEL HealthCheckErrorCode = 3",
+		"DCGM_FR_CLOCK_THROTTLE_THERMAL HealthCheckErrorCode = DCGM_FR_CLOCKS_EVENT_THERMAL",
+		`Suggestion:    "Check the \"cooling\": Verify that the cooling on this machine is functional.",`,
+		"Severity:      DCGM_ERROR_MONITOR,",
+		"Category:      DCGM_FR_EC_HARDWARE_THERMAL,",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestRun_MissingDefaultMetadataCSVFails(t *testing.T) {
+	headerPath := writeFile(t, "dcgm_errors.h", testHeader)
+	outputPath := filepath.Join(t.TempDir(), "const_errors.go")
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{headerPath, outputPath}, &stdout, &stderr); code == 0 {
+		t.Fatalf("run unexpectedly succeeded, stdout: %s", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), errorMetadataCSVName) {
+		t.Fatalf("stderr should name missing default CSV, got: %s", stderr.String())
+	}
+}
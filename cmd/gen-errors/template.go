@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+const fileTemplate = `/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by gen-errors; DO NOT EDIT.
+
+package dcgm
+
+import "strconv"
+
+const (
+{{- range .Codes}}
+	// {{.Name}}{{if .Description}} {{.Description}}{{end}}
+	{{.Name}} HealthCheckErrorCode = {{.ID}}
+{{- end}}
+	// {{.Sentinel.Name}}{{if .Sentinel.Description}} {{.Sentinel.Description}}{{end}}
+	{{.Sentinel.Name}} HealthCheckErrorCode = {{.Sentinel.ID}}
+{{- if .DeprecatedAliases}}
+
+	// Deprecated DCGM error code aliases retained for source compatibility.
+{{- range .DeprecatedAliases}}
+	// {{.Name}} is deprecated; use {{.Target}}.
+	{{.Name}} HealthCheckErrorCode = {{.Target}}
+{{- end}}
+{{- end}}
+)
+
+// healthCheckError is the static description of a health check error code
+type healthCheckError struct {
+	name        string
+	description string
+	meta        ErrorMeta
+}
+
+// healthCheckErrors holds the static metadata of every error code, keyed by code
+var healthCheckErrors = map[HealthCheckErrorCode]healthCheckError{
+{{- range .Codes}}
+	{{.Name}}: {
+		name:        "{{.Name}}",
+		description: {{printf "%q" .Description}},
+		meta: ErrorMeta{
+			ErrorID:       {{.Name}},
+			MessageFormat: {{printf "%q" .Message}},
+			Suggestion:    {{printf "%q" .Suggestion}},
+			Severity:      {{.Severity}},
+			Category:      {{.Category}},
+		},
+	},
+{{- end}}
+}
+
+// String returns the DCGM_FR_* name of the error code
+func (c HealthCheckErrorCode) String() string {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.name
+	}
+	if c == {{.Sentinel.Name}} {
+		return "{{.Sentinel.Name}}"
+	}
+	return "HealthCheckErrorCode(" + strconv.FormatUint(uint64(c), 10) + ")"
+}
+
+// Description returns the one-line description of the error code from dcgm_errors.h
+func (c HealthCheckErrorCode) Description() string {
+	return healthCheckErrors[c].description
+}
+
+// MessageFormat returns the printf-style format DCGM uses to report the error
+func (c HealthCheckErrorCode) MessageFormat() string {
+	return healthCheckErrors[c].meta.MessageFormat
+}
+
+// Suggestion returns the suggested next step for resolving the error
+func (c HealthCheckErrorCode) Suggestion() string {
+	return healthCheckErrors[c].meta.Suggestion
+}
+
+// Severity returns the action required for the error, or DCGM_ERROR_UNKNOWN for an unknown code
+func (c HealthCheckErrorCode) Severity() ErrorSeverity {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.meta.Severity
+	}
+	return DCGM_ERROR_UNKNOWN
+}
+
+// Category returns the subsystem of the error, or DCGM_FR_EC_NONE for an unknown code
+func (c HealthCheckErrorCode) Category() ErrorCategory {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.meta.Category
+	}
+	return DCGM_FR_EC_NONE
+}
+
+// StaticErrorMeta returns the metadata of an error code and whether it is known
+// Unlike GetErrorMeta it does not require libdcgm to be loaded
+func StaticErrorMeta(code HealthCheckErrorCode) (ErrorMeta, bool) {
+	e, ok := healthCheckErrors[code]
+	return e.meta, ok
+}
+`
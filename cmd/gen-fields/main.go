@@ -38,6 +38,9 @@ type Field struct {
 	Name    string
 	ID      int
 	Comment string
+	// Description is the full text of the field's doc comment, joined into
+	// a single line. Comment keeps only the last line for the const docs.
+	Description string
 }
 
 // DeprecatedFieldAlias describes a deprecated DCGM field name that aliases a current field.
@@ -51,6 +54,7 @@ type TemplateData struct {
 	Fields            []Field
 	DeprecatedAliases []DeprecatedFieldAlias
 	LegacyFields      map[string]int
+	FieldInfos        []FieldInfo
 }
 
 func main() {
@@ -65,6 +69,11 @@ func run(args []string, stdout, stderr io.Writer) int {
 		"",
 		"CSV file containing curated legacy field names (default: output directory)",
 	)
+	fieldMetadataFlag := flags.String(
+		"field-metadata",
+		"",
+		"CSV file containing curated field metadata overrides (default: output directory)",
+	)
 	if err := flags.Parse(args); err != nil {
 		if err == flag.ErrHelp {
 			return 0
@@ -72,13 +81,14 @@ func run(args []string, stdout, stderr io.Writer) int {
 		return 1
 	}
 	if len(flags.Args()) != 2 {
-		fmt.Fprintf(stderr, "Usage: gen-fields [--legacy-fields path] <dcgm_fields.h> <const_fields.go>\n")
+		fmt.Fprintf(stderr, "Usage: gen-fields [--legacy-fields path] [--field-metadata path] <dcgm_fields.h> <const_fields.go>\n")
 		return 1
 	}
 
 	headerPath := flags.Arg(0)
 	outputPath := flags.Arg(1)
 	legacyFieldsPath := legacyFieldsCSVPath(*legacyFieldsFlag, outputPath)
+	fieldMetadataPath := fieldMetadataCSVPath(*fieldMetadataFlag, outputPath)
 
 	// Parse header file
 	fields, aliases, err := parseHeader(headerPath)
@@ -106,11 +116,24 @@ func run(args []string, stdout, stderr io.Writer) int {
 		legacyFields[alias.Name] = alias.ID
 	}
 
+	overrides, err := readFieldMetadataCSV(fieldMetadataPath)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading field metadata from %q: %v\n", fieldMetadataPath, err)
+		return 1
+	}
+
+	fieldInfos, err := buildFieldInfos(fields, overrides)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error building field metadata: %v\n", err)
+		return 1
+	}
+
 	// Generate output
 	data := TemplateData{
 		Fields:            fields,
 		DeprecatedAliases: deprecatedAliases,
 		LegacyFields:      legacyFields,
+		FieldInfos:        fieldInfos,
 	}
 
 	err = generateOutput(data, outputPath)
@@ -159,6 +182,9 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 	aliases := make(map[string]string)
 
 	var lastComment string
+	// commentLines collects every interior line of the current comment
+	// block for Field.Description.
+	var commentLines []string
 	// inCommentBlock tracks /** ... */ spans so the closing */ never feeds
 	// commentPattern (which would otherwise capture "/" and corrupt
 	// lastComment -- the origin of the "// X represents /" artefacts in the
@@ -190,6 +216,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 			inDeprecatedBlock = true
 			deprecatedBlockDepth = 1
 			lastComment = ""
+			commentLines = nil
 			commentHasDeprecated = false
 			continue
 		}
@@ -199,6 +226,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 				strings.HasPrefix(trimmed, "#if ") {
 				deprecatedBlockDepth++
 				lastComment = ""
+				commentLines = nil
 				commentHasDeprecated = false
 				continue
 			}
@@ -208,6 +236,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 					inDeprecatedBlock = false
 				}
 				lastComment = ""
+				commentLines = nil
 				commentHasDeprecated = false
 				continue
 			}
@@ -229,6 +258,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 		// block comments for define/alias parsing below.
 		if hasOpen && hasClose && strings.HasPrefix(trimmed, "/*") {
 			lastComment = ""
+			commentLines = nil
 			commentHasDeprecated = containsDeprecatedMarker(line)
 			inCommentBlock = false
 			continue
@@ -241,6 +271,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 		if hasOpen && !hasClose {
 			inCommentBlock = true
 			lastComment = ""
+			commentLines = nil
 			commentHasDeprecated = false
 			continue
 		}
@@ -256,6 +287,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 		if inCommentBlock {
 			if matches := commentPattern.FindStringSubmatch(line); len(matches) > 1 {
 				lastComment = strings.TrimSpace(matches[1])
+				commentLines = append(commentLines, lastComment)
 			}
 			if containsDeprecatedMarker(line) {
 				commentHasDeprecated = true
@@ -271,6 +303,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 			id, err := strconv.Atoi(idStr)
 			if err != nil {
 				lastComment = ""
+				commentLines = nil
 				commentHasDeprecated = false
 				continue
 			}
@@ -284,12 +317,14 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 			}
 
 			fields = append(fields, Field{
-				Name:    name,
-				ID:      id,
-				Comment: comment,
+				Name:        name,
+				ID:          id,
+				Comment:     comment,
+				Description: joinCommentLines(commentLines),
 			})
 
 			lastComment = ""
+			commentLines = nil
 			commentHasDeprecated = false
 			continue
 		}
@@ -306,6 +341,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 				aliases[aliasName] = targetName
 			}
 			lastComment = ""
+			commentLines = nil
 			commentHasDeprecated = false
 			continue
 		}
@@ -313,6 +349,7 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 		// Any other non-blank line resets comment state so a comment meant for
 		// one field never leaks onto a later construct.
 		lastComment = ""
+		commentLines = nil
 		commentHasDeprecated = false
 	}
 
@@ -328,6 +365,27 @@ func parseHeader(path string) ([]Field, map[string]string, error) {
 	return fields, aliases, nil
 }
 
+// htmlTagPattern matches the <p> and similar markup some field comments use.
+var htmlTagPattern = regexp.MustCompile(`</?[a-zA-Z]+>`)
+
+// joinCommentLines flattens a doc comment into a single line of text. Doxygen
+// tags such as @note end the description.
+func joinCommentLines(lines []string) string {
+	parts := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.ReplaceAll(line, "&nbsp;", " ")
+		line = htmlTagPattern.ReplaceAllString(line, "")
+		line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if strings.HasPrefix(line, "@") {
+			break
+		}
+		if line != "" {
+			parts = append(parts, strings.Join(strings.Fields(line), " "))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 func isDeprecatedBlockStart(trimmed string) bool {
 	switch trimmed {
 	case "#ifdef DCGM_DEPRECATED",
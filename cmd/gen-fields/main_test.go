@@ -346,6 +346,16 @@ func writeLegacyCSV(t *testing.T, contents string) string {
 	return path
 }
 
+func writeFieldMetadataCSV(t *testing.T, contents string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, fieldMetadataCSVName)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("writing field metadata CSV: %v", err)
+	}
+	return path
+}
+
 func TestRun_DefaultsLegacyCSVToOutputDirectory(t *testing.T) {
 	headerPath := writeHeader(t, `
 /**
@@ -360,6 +370,10 @@ dcgm_gpu_temp,150
 `), 0o600); err != nil {
 		t.Fatalf("writing default legacy CSV: %v", err)
 	}
+	if err := os.WriteFile(filepath.Join(dir, fieldMetadataCSVName), []byte(`name,type,unit,entity,kind
+`), 0o600); err != nil {
+		t.Fatalf("writing default field metadata CSV: %v", err)
+	}
 
 	var stdout, stderr bytes.Buffer
 	if code := run([]string{headerPath, outputPath}, &stdout, &stderr); code != 0 {
@@ -384,11 +398,14 @@ func TestRun_UsesExplicitLegacyCSVPath(t *testing.T) {
 `)
 	legacyCSVPath := writeLegacyCSV(t, `name,id
 dcgm_gpu_temp,150
+`)
+	metadataCSVPath := writeFieldMetadataCSV(t, `name,type,unit,entity,kind
 `)
 	outputPath := filepath.Join(t.TempDir(), "const_fields.go")
 
 	var stdout, stderr bytes.Buffer
-	if code := run([]string{"--legacy-fields", legacyCSVPath, headerPath, outputPath}, &stdout, &stderr); code != 0 {
+	args := []string{"--legacy-fields", legacyCSVPath, "--field-metadata", metadataCSVPath, headerPath, outputPath}
+	if code := run(args, &stdout, &stderr); code != 0 {
 		t.Fatalf("run returned %d, stderr: %s", code, stderr.String())
 	}
 
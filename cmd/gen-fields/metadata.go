@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const fieldMetadataCSVName = "field_metadata.csv"
+
+// FieldInfo is the static metadata emitted for one canonical field. The
+// Unit, Type, Entity and Kind members hold the Go identifiers written into
+// the generated table (e.g. "UnitCelsius", "DCGM_FT_INT64").
+type FieldInfo struct {
+	Name        string
+	ID          int
+	Description string
+	Unit        string
+	Type        string
+	Entity      string
+	Kind        string
+}
+
+// fieldMetadataOverride is one row of field_metadata.csv. Empty members keep
+// the inferred value.
+type fieldMetadataOverride struct {
+	Type   string
+	Unit   string
+	Entity string
+	Kind   string
+}
+
+// unitIdents maps the unit names accepted in field_metadata.csv to the Unit
+// constants declared in pkg/dcgm/typed_value.go.
+var unitIdents = map[string]string{
+	"none":                 "UnitNone",
+	"celsius":              "UnitCelsius",
+	"watts":                "UnitWatts",
+	"milliwatts":           "UnitMilliwatts",
+	"joules":               "UnitJoules",
+	"millijoules":          "UnitMillijoules",
+	"hertz":                "UnitHertz",
+	"megahertz":            "UnitMegahertz",
+	"bytes":                "UnitBytes",
+	"kibibytes":            "UnitKibibytes",
+	"mebibytes":            "UnitMebibytes",
+	"bytes_per_second":     "UnitBytesPerSecond",
+	"mebibytes_per_second": "UnitMebibytesPerSecond",
+	"ratio":                "UnitRatio",
+	"percent":              "UnitPercent",
+	"nanoseconds":          "UnitNanoseconds",
+	"microseconds":         "UnitMicroseconds",
+	"milliseconds":         "UnitMilliseconds",
+	"seconds":              "UnitSeconds",
+	"volts":                "UnitVolts",
+	"millivolts":           "UnitMillivolts",
+	"milliamps":            "UnitMilliamps",
+	"rpm":                  "UnitRPM",
+	"count":                "UnitCount",
+}
+
+var typeIdents = map[string]string{
+	"int64":     "DCGM_FT_INT64",
+	"double":    "DCGM_FT_DOUBLE",
+	"string":    "DCGM_FT_STRING",
+	"binary":    "DCGM_FT_BINARY",
+	"timestamp": "DCGM_FT_TIMESTAMP",
+}
+
+var entityIdents = map[string]string{
+	"none":     "FE_NONE",
+	"gpu":      "FE_GPU",
+	"vgpu":     "FE_VGPU",
+	"switch":   "FE_SWITCH",
+	"gpu_i":    "FE_GPU_I",
+	"gpu_ci":   "FE_GPU_CI",
+	"link":     "FE_LINK",
+	"cpu":      "FE_CPU",
+	"cpu_core": "FE_CPU_CORE",
+	"connectx": "FE_CONNECTX",
+}
+
+var kindIdents = map[string]string{
+	"gauge":   "FieldKindGauge",
+	"counter": "FieldKindCounter",
+	"info":    "FieldKindInfo",
+}
+
+// rangeMarkerPattern matches #defines that bound ID ranges rather than name
+// real fields, e.g. DCGM_FI_FIRST_VGPU_FIELD_ID or DCGM_FI_INTERNAL_FIELDS_0_END.
+var rangeMarkerPattern = regexp.MustCompile(`^DCGM_FI_(?:.*_)?(?:FIRST|LAST)_\w+_FIELD_ID$|^DCGM_FI_INTERNAL_FIELDS_|^DCGM_FI_SYSTEM_FIELD_UNKNOWN$`)
+
+// isRangeMarker reports whether name is a range sentinel rather than a field.
+func isRangeMarker(name string) bool {
+	return rangeMarkerPattern.MatchString(name)
+}
+
+// Unit suffixes in DCGM 4.6 canonical names. Longer suffixes come first.
+var unitNameSuffixes = []struct {
+	suffix string
+	unit   string
+}{
+	{"_BYTES_PER_SECOND", "bytes_per_second"},
+	{"_JOULES_TOTAL", "joules"},
+	{"_BYTES_TOTAL", "bytes"},
+	{"_CELSIUS", "celsius"},
+	{"_WATTS", "watts"},
+	{"_HERTZ", "hertz"},
+	{"_BYTES", "bytes"},
+	{"_RATIO", "ratio"},
+	{"_MVOLT", "millivolts"},
+	{"_NS", "nanoseconds"},
+}
+
+// Unit phrases used by field comments whose names predate the unit suffixes.
+var unitCommentPatterns = []struct {
+	pattern *regexp.Regexp
+	unit    string
+}{
+	{regexp.MustCompile(`\bin MB/s\b`), "mebibytes_per_second"},
+	{regexp.MustCompile(`\bin MB\b`), "mebibytes"},
+	{regexp.MustCompile(`\bin KB\b`), "kibibytes"},
+	{regexp.MustCompile(`\bin bytes\b`), "bytes"},
+	{regexp.MustCompile(`\bin mJ\b`), "millijoules"},
+	{regexp.MustCompile(`(?i)\bin joules\b`), "joules"},
+	{regexp.MustCompile(`(?i)\bin watts\b`), "watts"},
+	{regexp.MustCompile(`\bin MHz\b`), "megahertz"},
+	{regexp.MustCompile(`(?i)\bin hertz\b`), "hertz"},
+	{regexp.MustCompile(`\bin degrees C\b`), "celsius"},
+	{regexp.MustCompile(`\bin ns\b`), "nanoseconds"},
+	{regexp.MustCompile(`\bin usec\b|\bin us\b`), "microseconds"},
+	{regexp.MustCompile(`\bin ms\b|\bin milliseconds\b`), "milliseconds"},
+	{regexp.MustCompile(`\bin seconds\b`), "seconds"},
+	{regexp.MustCompile(`\bin percent\b|%`), "percent"},
+}
+
+var (
+	binaryNamePattern  = regexp.MustCompile(`_(?:INFO|STATS|IDS|TOPOLOGY|ATTRIBUTES)$`)
+	stringNamePattern  = regexp.MustCompile(`_(?:VERSION|NAME|UUID|SERIAL|SERIAL_NUMBER|BUS_ID|BRAND|VENDOR|MODEL|CLASS|LICENSE|GUID|VM_ID|PCI_ID)$`)
+	counterNamePattern = regexp.MustCompile(`_TOTAL$|_NS$|_VIOLATION$|^DCGM_FI_DEV_ECC_[SD]BE_(?:VOL|AGG)_|^DCGM_FI_DEV_NVLINK_(?:TX_|RX_)?THROUGHPUT_(?:L\d+|TOTAL|PER_LINK)$`)
+	profBytesPattern   = regexp.MustCompile(`^DCGM_FI_PROF_\w+_BYTES(?:_PER_LINK)?$`)
+	linkNamePattern    = regexp.MustCompile(`_PER_LINK(?:_|$)|^DCGM_FI_DEV_NVSWITCH_LINK_`)
+	linkCommentPattern = regexp.MustCompile(`dcgm_link_t|DCGM_FE_LINK`)
+)
+
+// inferFieldInfo derives the metadata of a field from its name and comment.
+func inferFieldInfo(field Field) FieldInfo {
+	info := FieldInfo{
+		Name:        field.Name,
+		ID:          field.ID,
+		Description: field.Description,
+	}
+
+	typ := inferType(field.Name)
+	info.Type = typeIdents[typ]
+	info.Unit = unitIdents[inferUnit(field)]
+	info.Entity = entityIdents[inferEntity(field)]
+
+	switch {
+	case typ == "string" || typ == "binary":
+		info.Kind = kindIdents["info"]
+	case counterNamePattern.MatchString(field.Name):
+		info.Kind = kindIdents["counter"]
+	default:
+		info.Kind = kindIdents["gauge"]
+	}
+
+	return info
+}
+
+func inferType(name string) string {
+	switch {
+	case binaryNamePattern.MatchString(name):
+		return "binary"
+	case stringNamePattern.MatchString(name):
+		return "string"
+	case strings.HasSuffix(name, "_RATIO"),
+		strings.HasSuffix(name, "_WATTS"):
+		return "double"
+	default:
+		return "int64"
+	}
+}
+
+func inferUnit(field Field) string {
+	// Profiling byte fields report bytes transferred per second.
+	if profBytesPattern.MatchString(field.Name) {
+		return "bytes_per_second"
+	}
+	for _, s := range unitNameSuffixes {
+		if strings.HasSuffix(field.Name, s.suffix) {
+			return s.unit
+		}
+	}
+	for _, p := range unitCommentPatterns {
+		if p.pattern.MatchString(field.Description) {
+			return p.unit
+		}
+	}
+	return "none"
+}
+
+func inferEntity(field Field) string {
+	name := strings.TrimPrefix(field.Name, "DCGM_FI_")
+	switch {
+	case strings.HasPrefix(name, "SYSTEM_"),
+		strings.HasPrefix(name, "IMEX_"),
+		name == "CUDA_DRIVER_VERSION":
+		return "none"
+	case linkNamePattern.MatchString(field.Name),
+		linkCommentPattern.MatchString(field.Description):
+		return "link"
+	case strings.HasPrefix(name, "DEV_NVSWITCH_"),
+		strings.HasPrefix(name, "DEV_SXID_"):
+		return "switch"
+	case strings.HasPrefix(name, "DEV_CONNECTX_"):
+		return "connectx"
+	case strings.HasPrefix(name, "DEV_VGPU_") && field.ID >= 520:
+		return "vgpu"
+	case strings.HasPrefix(name, "DEV_CPU_") && !strings.HasPrefix(name, "DEV_CPU_AFFINITY_"),
+		strings.HasPrefix(name, "DEV_SYSIO_"),
+		strings.HasPrefix(name, "DEV_MODULE_POWER"):
+		return "cpu"
+	default:
+		return "gpu"
+	}
+}
+
+// buildFieldInfos infers metadata for every canonical field and applies the
+// curated overrides. Range markers are skipped. An override naming a field
+// that is not in the header is an error so stale rows are noticed.
+func buildFieldInfos(fields []Field, overrides map[string]fieldMetadataOverride) ([]FieldInfo, error) {
+	known := make(map[string]bool, len(fields))
+	infos := make([]FieldInfo, 0, len(fields))
+	for _, field := range fields {
+		known[field.Name] = true
+		if isRangeMarker(field.Name) {
+			continue
+		}
+
+		info := inferFieldInfo(field)
+		if o, ok := overrides[field.Name]; ok {
+			if o.Type != "" {
+				info.Type = typeIdents[o.Type]
+			}
+			if o.Unit != "" {
+				info.Unit = unitIdents[o.Unit]
+			}
+			if o.Entity != "" {
+				info.Entity = entityIdents[o.Entity]
+			}
+			if o.Kind != "" {
+				info.Kind = kindIdents[o.Kind]
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	var unknown []string
+	for name := range overrides {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("field metadata overrides name unknown fields: %s", strings.Join(unknown, ", "))
+	}
+
+	return infos, nil
+}
+
+func fieldMetadataCSVPath(flagPath, outputPath string) string {
+	if flagPath != "" {
+		return flagPath
+	}
+	return filepath.Join(filepath.Dir(outputPath), fieldMetadataCSVName)
+}
+
+func readFieldMetadataCSV(path string) (map[string]fieldMetadataOverride, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open field metadata CSV: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read field metadata CSV header: %w", err)
+	}
+	wantHeader := []string{"name", "type", "unit", "entity", "kind"}
+	if len(header) != len(wantHeader) {
+		return nil, errors.New("field metadata CSV header must be: name,type,unit,entity,kind")
+	}
+	for i := range header {
+		if strings.TrimSpace(header[i]) != wantHeader[i] {
+			return nil, errors.New("field metadata CSV header must be: name,type,unit,entity,kind")
+		}
+	}
+
+	overrides := make(map[string]fieldMetadataOverride)
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field metadata CSV row %d: %w", row, err)
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+
+		name := record[0]
+		if !strings.HasPrefix(name, "DCGM_FI_") {
+			return nil, fmt.Errorf("field metadata CSV row %d: %q is not a DCGM_FI_ field name", row, name)
+		}
+		if _, exists := overrides[name]; exists {
+			return nil, fmt.Errorf("field metadata CSV row %d: duplicate name %q", row, name)
+		}
+
+		o := fieldMetadataOverride{Type: record[1], Unit: record[2], Entity: record[3], Kind: record[4]}
+		for _, check := range []struct {
+			column string
+			value  string
+			valid  map[string]string
+		}{
+			{"type", o.Type, typeIdents},
+			{"unit", o.Unit, unitIdents},
+			{"entity", o.Entity, entityIdents},
+			{"kind", o.Kind, kindIdents},
+		} {
+			if check.value == "" {
+				continue
+			}
+			if _, ok := check.valid[check.value]; !ok {
+				return nil, fmt.Errorf("field metadata CSV row %d: unknown %s %q", row, check.column, check.value)
+			}
+		}
+		if o == (fieldMetadataOverride{}) {
+			return nil, fmt.Errorf("field metadata CSV row %d: %q overrides nothing", row, name)
+		}
+		overrides[name] = o
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,214 @@
+/*
+ * Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseHeader_JoinsDescription(t *testing.T) {
+	path := writeHeader(t, `
+/**
+ * Memory temperature for the device.
+ * Reported in degrees C.
+ * <b>Note:</b>&nbsp;not all boards report it.
+ *
+ * @note Ignored annotation.
+ */
+#define DCGM_FI_DEV_MEMORY_TEMP_CELSIUS 140
+`)
+
+	fields, _, err := parseHeader(path)
+	if err != nil {
+		t.Fatalf("parseHeader: %v", err)
+	}
+	f, ok := findField(fields, "DCGM_FI_DEV_MEMORY_TEMP_CELSIUS")
+	if !ok {
+		t.Fatal("field not parsed")
+	}
+	want := "Memory temperature for the device. Reported in degrees C. Note: not all boards report it."
+	if f.Description != want {
+		t.Errorf("Description = %q, want %q", f.Description, want)
+	}
+}
+
+func TestInferFieldInfo(t *testing.T) {
+	tests := []struct {
+		field      Field
+		wantType   string
+		wantUnit   string
+		wantEntity string
+		wantKind   string
+	}{
+		{Field{Name: "DCGM_FI_DEV_GPU_TEMP_CELSIUS", ID: 150}, "DCGM_FT_INT64", "UnitCelsius", "FE_GPU", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_DEV_BOARD_POWER_WATTS", ID: 155}, "DCGM_FT_DOUBLE", "UnitWatts", "FE_GPU", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_DEV_GPU_UUID", ID: 54}, "DCGM_FT_STRING", "UnitNone", "FE_GPU", "FieldKindInfo"},
+		{Field{Name: "DCGM_FI_DEV_ECC_SBE_VOL_TOTAL", ID: 310}, "DCGM_FT_INT64", "UnitNone", "FE_GPU", "FieldKindCounter"},
+		{Field{Name: "DCGM_FI_DEV_PCIE_REPLAY_COUNTER", ID: 202, Description: "PCIe replay counter, in bytes"}, "DCGM_FT_INT64", "UnitBytes", "FE_GPU", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_PROF_PCIE_TX_BYTES", ID: 1009}, "DCGM_FT_INT64", "UnitBytesPerSecond", "FE_GPU", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX", ID: 780}, "DCGM_FT_INT64", "UnitNone", "FE_LINK", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_DEV_NVSWITCH_VOLTAGE_MVOLT", ID: 701}, "DCGM_FT_INT64", "UnitMillivolts", "FE_SWITCH", "FieldKindGauge"},
+		{Field{Name: "DCGM_FI_DEV_CPU_UTIL_TOTAL", ID: 1100}, "DCGM_FT_INT64", "UnitNone", "FE_CPU", "FieldKindCounter"},
+		{Field{Name: "DCGM_FI_DEV_VGPU_VM_ID", ID: 520}, "DCGM_FT_STRING", "UnitNone", "FE_VGPU", "FieldKindInfo"},
+		{Field{Name: "DCGM_FI_SYSTEM_HOSTNAME", ID: 5}, "DCGM_FT_INT64", "UnitNone", "FE_NONE", "FieldKindGauge"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field.Name, func(t *testing.T) {
+			got := inferFieldInfo(tt.field)
+			if got.Type != tt.wantType || got.Unit != tt.wantUnit || got.Entity != tt.wantEntity || got.Kind != tt.wantKind {
+				t.Errorf("inferFieldInfo = {%s %s %s %s}, want {%s %s %s %s}",
+					got.Type, got.Unit, got.Entity, got.Kind,
+					tt.wantType, tt.wantUnit, tt.wantEntity, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestBuildFieldInfos_SkipsRangeMarkersAndAppliesOverrides(t *testing.T) {
+	fields := []Field{
+		{Name: "DCGM_FI_FIRST_VGPU_FIELD_ID", ID: 520},
+		{Name: "DCGM_FI_DEV_VGPU_VM_ID", ID: 520},
+		{Name: "DCGM_FI_DEV_FB_TOTAL", ID: 250},
+		{Name: "DCGM_FI_MAX_FIELDS", ID: 2000},
+	}
+	overrides := map[string]fieldMetadataOverride{
+		"DCGM_FI_DEV_FB_TOTAL": {Unit: "mebibytes", Kind: "gauge"},
+	}
+
+	infos, err := buildFieldInfos(fields, overrides)
+	if err != nil {
+		t.Fatalf("buildFieldInfos: %v", err)
+	}
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+	if strings.Contains(strings.Join(names, ","), "FIRST_VGPU_FIELD_ID") {
+		t.Errorf("range marker should be skipped, got %v", names)
+	}
+	for _, info := range infos {
+		if info.Name == "DCGM_FI_DEV_FB_TOTAL" && info.Unit != "UnitMebibytes" {
+			t.Errorf("override not applied, unit = %s", info.Unit)
+		}
+	}
+}
+
+func TestBuildFieldInfos_UnknownOverrideFails(t *testing.T) {
+	fields := []Field{{Name: "DCGM_FI_DEV_FB_TOTAL", ID: 250}}
+	overrides := map[string]fieldMetadataOverride{
+		"DCGM_FI_DEV_REMOVED": {Unit: "bytes"},
+	}
+
+	_, err := buildFieldInfos(fields, overrides)
+	if err == nil || !strings.Contains(err.Error(), "DCGM_FI_DEV_REMOVED") {
+		t.Fatalf("expected error naming the unknown field, got %v", err)
+	}
+}
+
+func TestReadFieldMetadataCSV(t *testing.T) {
+	path := writeFieldMetadataCSV(t, `# curated corrections
+name,type,unit,entity,kind
+DCGM_FI_DEV_FB_TOTAL,,mebibytes,,gauge
+DCGM_FI_DEV_CPU_CLOCK_CURRENT, , ,cpu_core,
+`)
+
+	overrides, err := readFieldMetadataCSV(path)
+	if err != nil {
+		t.Fatalf("readFieldMetadataCSV: %v", err)
+	}
+	if got := overrides["DCGM_FI_DEV_FB_TOTAL"]; got != (fieldMetadataOverride{Unit: "mebibytes", Kind: "gauge"}) {
+		t.Errorf("FB_TOTAL override = %+v", got)
+	}
+	if got := overrides["DCGM_FI_DEV_CPU_CLOCK_CURRENT"]; got != (fieldMetadataOverride{Entity: "cpu_core"}) {
+		t.Errorf("CPU_CLOCK_CURRENT override = %+v", got)
+	}
+}
+
+func TestReadFieldMetadataCSV_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		wantErr  string
+	}{
+		{"bad header", "name,unit\n", "header must be"},
+		{"not a field", "name,type,unit,entity,kind\ngpu_temp,,celsius,,\n", "not a DCGM_FI_ field name"},
+		{"unknown unit", "name,type,unit,entity,kind\nDCGM_FI_DEV_FB_TOTAL,,furlongs,,\n", `unknown unit "furlongs"`},
+		{"unknown kind", "name,type,unit,entity,kind\nDCGM_FI_DEV_FB_TOTAL,,,,histogram\n", `unknown kind "histogram"`},
+		{"empty row", "name,type,unit,entity,kind\nDCGM_FI_DEV_FB_TOTAL,,,,\n", "overrides nothing"},
+		{
+			"duplicate",
+			"name,type,unit,entity,kind\nDCGM_FI_DEV_FB_TOTAL,,bytes,,\nDCGM_FI_DEV_FB_TOTAL,,,,gauge\n",
+			"duplicate name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readFieldMetadataCSV(writeFieldMetadataCSV(t, tt.contents))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRun_EmitsFieldInfoTable(t *testing.T) {
+	headerPath := writeHeader(t, `
+/**
+ * GPU temperature (in C).
+ */
+#define DCGM_FI_DEV_GPU_TEMP_CELSIUS 150
+
+#define DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID 1300
+`)
+	legacyCSVPath := writeLegacyCSV(t, "name,id\n")
+	metadataCSVPath := writeFieldMetadataCSV(t, `name,type,unit,entity,kind
+DCGM_FI_DEV_GPU_TEMP_CELSIUS,double,,,
+`)
+	outputPath := filepath.Join(t.TempDir(), "const_fields.go")
+
+	var stdout, stderr strings.Builder
+	args := []string{"--legacy-fields", legacyCSVPath, "--field-metadata", metadataCSVPath, headerPath, outputPath}
+	if code := run(args, &stdout, &stderr); code != 0 {
+		t.Fatalf("run returned %d, stderr: %s", code, stderr.String())
+	}
+
+	out, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("read output: %v", err)
+	}
+	got := string(out)
+	for _, want := range []string{
+		`Name:        "DCGM_FI_DEV_GPU_TEMP_CELSIUS"`,
+		`Description: "GPU temperature (in C)."`,
+		"Unit:        UnitCelsius",
+		"Type:        DCGM_FT_DOUBLE",
+		"EntityLevel: FE_GPU",
+		"Kind:        FieldKindGauge",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, `Name:        "DCGM_FI_DEV_FIRST_CONNECTX_FIELD_ID"`) {
+		t.Errorf("range marker should not be in the field info table:\n%s", got)
+	}
+}
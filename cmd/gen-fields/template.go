@@ -65,6 +65,21 @@ var legacyDCGMFields = map[string]Short{
 {{- end}}
 }
 
+// fieldInfos holds the static metadata of every canonical field, keyed by ID
+var fieldInfos = map[Short]FieldInfo{
+{{- range .FieldInfos}}
+	{{.ID}}: {
+		ID:          {{.ID}},
+		Name:        "{{.Name}}",
+		Description: {{printf "%q" .Description}},
+		Unit:        {{.Unit}},
+		Type:        {{.Type}},
+		EntityLevel: {{.Entity}},
+		Kind:        {{.Kind}},
+	},
+{{- end}}
+}
+
 // GetFieldID returns the DCGM field ID for a given field name and whether it was found
 // It first checks the current field IDs, then falls back to legacy field IDs if not found
 func GetFieldID(fieldName string) (Short, bool) {
@@ -102,4 +117,21 @@ func IsCurrentField(fieldName string) bool {
 	_, ok := dcgmFields[fieldName]
 	return ok
 }
+
+// GetFieldInfo returns the static metadata for a field ID and whether it was found
+// It does not require libdcgm to be loaded
+func GetFieldInfo(fieldID Short) (FieldInfo, bool) {
+	info, ok := fieldInfos[fieldID]
+	return info, ok
+}
+
+// GetFieldInfoByName returns the static metadata for a field name and whether it was found
+// Legacy and deprecated names resolve to the metadata of the current field
+func GetFieldInfoByName(fieldName string) (FieldInfo, bool) {
+	fieldID, ok := GetFieldID(fieldName)
+	if !ok {
+		return FieldInfo{}, false
+	}
+	return GetFieldInfo(fieldID)
+}
 `
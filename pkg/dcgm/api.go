@@ -93,11 +93,96 @@ func GetDeviceStatus(gpuID uint) (DeviceStatus, error) {
 	return latestValuesForDevice(gpuID)
 }
 
+// GetEntityStatus returns current status information about a GPU, MIG
+// instance, NVSwitch, CPU or CPU core. The concrete type of the status
+// depends on the entity group, see EntityStatusInfo.
+func GetEntityStatus(entity GroupEntityPair) (EntityStatusInfo, error) {
+	return getEntityStatus(entity)
+}
+
 // GetDeviceTopology returns the topology (connectivity) information for the specified GPU
 func GetDeviceTopology(gpuID uint) ([]P2PLink, error) {
 	return getDeviceTopology(gpuID)
 }
 
+// GetGPUAffinity returns the CPUs, NUMA nodes and DCGM managed CPUs close to the specified GPU
+func GetGPUAffinity(gpuID uint) (GPUAffinity, error) {
+	return getGPUAffinity(gpuID)
+}
+
+// GetCPUs returns the CPUs managed by DCGM with the cores they own. It is
+// empty on nodes without datacenter NVIDIA CPUs.
+func GetCPUs() ([]CPUInfo, error) {
+	return getCPUs()
+}
+
+// WatchCPUs watches the power, temperature, clock and utilization of the CPUs
+// and their cores, and sends a sample every interval. The returned channel is
+// closed and the watches are removed when the context is canceled.
+func WatchCPUs(ctx context.Context, cpus []CPUInfo, interval time.Duration) (<-chan CPUSample, error) {
+	return watchCPUs(ctx, cpus, interval)
+}
+
+// EnableCPUHealth creates a group of the CPUs and enables the CPU health
+// watches, CPUHealthSystems, on it. DCGM gathers the health data in the
+// background, so a check made right after the watches are enabled has nothing
+// to report and passes. Keep the group and call CheckCPUHealth, or WatchHealth
+// with CPUHealthSystems, once the watches have sampled for a while. The caller
+// must destroy the group with DestroyGroup.
+func EnableCPUHealth(cpus []CPUInfo) (GroupHandle, error) {
+	return enableCPUHealth(cpus)
+}
+
+// CheckCPUHealth checks the health watches of a group created by
+// EnableCPUHealth and returns a health report for each of the CPUs
+func CheckCPUHealth(group GroupHandle, cpus []CPUInfo) (CPUHealth, error) {
+	return checkCPUHealth(group, cpus)
+}
+
+// GetNvSwitches returns the NVSwitches with their links, mapping each up link
+// to the GPU and link index at its other end
+func GetNvSwitches() ([]NvSwitch, error) {
+	return getNvSwitches()
+}
+
+// GetNvSwitchLinkCounters returns the throughput and error counters of the up
+// links of an NVSwitch. Use NvSwitchLinkCounters.Rates or
+// NvSwitchLinkRatesBetween to compute rates between two calls.
+func GetNvSwitchLinkCounters(switchID uint) ([]NvSwitchLinkCounters, error) {
+	return getNvSwitchLinkCounters(switchID)
+}
+
+// GetNvLinkCounters returns the throughput and error counters of the up
+// NVLinks of a GPU. Use NvLinkCounters.Rates or NvLinkRatesBetween to compute
+// rates between two calls.
+func GetNvLinkCounters(gpuID uint) ([]NvLinkCounters, error) {
+	return getNvLinkCounters(gpuID)
+}
+
+// GetNvLinkRates returns the throughput and error rates of the up NVLinks of a
+// GPU over a window, blocking for the duration of the window
+func GetNvLinkRates(gpuID uint, window time.Duration) ([]NvLinkRates, error) {
+	return getNvLinkRates(gpuID, window)
+}
+
+// GetMigTree returns the MIG hierarchy as a tree of the GPUs with MIG enabled,
+// their GPU instances and their compute instances
+func GetMigTree() (*MigTree, error) {
+	return getMigTree()
+}
+
+// GetInventory returns a snapshot of the GPUs, CPUs, NVSwitches and NVLinks of
+// the node. The GPU fields are watched once and read in a single batch.
+func GetInventory() (*Inventory, error) {
+	return getInventory()
+}
+
+// GetTopology returns the graph of the supported GPUs and of the NVSwitches,
+// CPUs and NICs of the system, with their PCIe and NVLink connections
+func GetTopology() (*Topology, error) {
+	return getTopology()
+}
+
 // WatchPidFields configures DCGM to start recording stats for GPU processes
 // Must be called before GetProcessInfo.
 //
@@ -165,7 +250,19 @@ func ListenForPolicyViolations(ctx context.Context, typ ...policyCondition) (<-c
 // Empty condition lists and unknown policy conditions return an error before registering with DCGM.
 // See ListenForPolicyViolations for usage example.
 func ListenForPolicyViolationsForGroup(ctx context.Context, group GroupHandle, typ ...policyCondition) (<-chan PolicyViolation, error) {
-	return registerPolicy(ctx, group, typ...)
+	return registerPolicy(ctx, group, nil, typ...)
+}
+
+// ListenForPolicyViolationsWithFilter is ListenForPolicyViolationsForGroup for the
+// violations that pass the filter, e.g. to ignore XID 43 or PCIe violations with
+// few replays. Filtered violations are not counted by PolicyViolationDropCount.
+func ListenForPolicyViolationsWithFilter(
+	ctx context.Context,
+	group GroupHandle,
+	filter PolicyFilter,
+	typ ...PolicyCondition,
+) (<-chan PolicyViolation, error) {
+	return registerPolicy(ctx, group, &filter, typ...)
 }
 
 // Introspect returns memory and CPU usage statistics for the DCGM hostengine
@@ -225,9 +322,39 @@ func ClearPolicyForGroup(group GroupHandle) error {
 // Unlike ListenForPolicyViolationsForGroup, it does not set policy thresholds first. Delivery is
 // best-effort, the context must be canceled to release resources, and canceling one watcher does
 // not stop surviving watchers. Empty condition lists and unknown policy conditions return an error
-// before registering with DCGM.
+// before registering with DCGM. The conditions of rules started with StartPolicyRules on the group
+// may be watched alongside the hardware conditions.
 func WatchPolicyViolationsForGroup(ctx context.Context, group GroupHandle, typ ...PolicyCondition) (<-chan PolicyViolation, error) {
-	return registerPolicyOnly(ctx, group, typ...)
+	return registerPolicyOnly(ctx, group, nil, typ...)
+}
+
+// WatchPolicyViolationsWithFilter is WatchPolicyViolationsForGroup for the
+// violations that pass the filter. Filtered violations are not counted by
+// PolicyViolationDropCount.
+func WatchPolicyViolationsWithFilter(
+	ctx context.Context,
+	group GroupHandle,
+	filter PolicyFilter,
+	typ ...PolicyCondition,
+) (<-chan PolicyViolation, error) {
+	return registerPolicyOnly(ctx, group, &filter, typ...)
+}
+
+// PolicyTrigger makes DCGM run one iteration of policy evaluation now instead of
+// waiting for the next periodic one, so the listeners of violated conditions are
+// called back without delay.
+func PolicyTrigger() error {
+	return policyTrigger()
+}
+
+// ReplayPolicyViolations delivers violations to the listeners of a group as if
+// DCGM had reported them, e.g. to replay violations recorded by a sink through
+// an alerting pipeline. Each violation reaches the listeners of its condition;
+// violations of conditions nobody listens to are discarded.
+func ReplayPolicyViolations(group GroupHandle, violations ...PolicyViolation) {
+	for _, violation := range violations {
+		policyCallbacks.deliverGroup(group, violation)
+	}
 }
 
 // PolicyViolationDropCount returns the number of local policy violations dropped because
@@ -272,283 +272,19 @@ const (
 )
 
 // HealthCheckErrorCode error codes for passive and active health checks.
+// The DCGM_FR_* constants are generated from dcgm_errors.h into const_errors.go.
 type HealthCheckErrorCode uint
 
+// Error code names that are no longer in dcgm_errors.h, retained for source compatibility.
 const (
-	// DCGM_FR_OK No error
-	DCGM_FR_OK HealthCheckErrorCode = 0
-	// DCGM_FR_UNKNOWN Unknown error code
-	DCGM_FR_UNKNOWN HealthCheckErrorCode = 1
-	// DCGM_FR_UNRECOGNIZED Unrecognized error code
-	DCGM_FR_UNRECOGNIZED HealthCheckErrorCode = 2
-	// DCGM_FR_PCI_REPLAY_RATE Unacceptable rate of PCI errors
-	DCGM_FR_PCI_REPLAY_RATE HealthCheckErrorCode = 3
-	// DCGM_FR_VOLATILE_DBE_DETECTED Unacceptable rate of volatile double bit errors
-	DCGM_FR_VOLATILE_DBE_DETECTED HealthCheckErrorCode = 4
-	// DCGM_FR_VOLATILE_SBE_DETECTED Unacceptable rate of volatile single bit errors
-	DCGM_FR_VOLATILE_SBE_DETECTED HealthCheckErrorCode = 5
-	// DCGM_FR_VOLATILE_SBE_DETECTED_TS Unacceptable rate of volatile single bit errors with a timestamp
+	// DCGM_FR_VOLATILE_SBE_DETECTED_TS shares its value with DCGM_FR_PENDING_PAGE_RETIREMENTS.
+	//
+	// Deprecated: not reported by DCGM.
 	DCGM_FR_VOLATILE_SBE_DETECTED_TS HealthCheckErrorCode = 6
-	// DCGM_FR_PENDING_PAGE_RETIREMENTS Pending page retirements detected
-	DCGM_FR_PENDING_PAGE_RETIREMENTS HealthCheckErrorCode = 6
-	// DCGM_FR_RETIRED_PAGES_LIMIT Unacceptable total page retirements detected
-	DCGM_FR_RETIRED_PAGES_LIMIT HealthCheckErrorCode = 7
-	// DCGM_FR_RETIRED_PAGES_DBE_LIMIT Unacceptable total page retirements due to uncorrectable errors
-	DCGM_FR_RETIRED_PAGES_DBE_LIMIT HealthCheckErrorCode = 8
-	// DCGM_FR_CORRUPT_INFOROM Corrupt inforom found
-	DCGM_FR_CORRUPT_INFOROM HealthCheckErrorCode = 9
-	// DCGM_FR_CLOCK_THROTTLE_THERMAL Clocks being throttled due to overheating
-	DCGM_FR_CLOCK_THROTTLE_THERMAL HealthCheckErrorCode = 10
-	// DCGM_FR_POWER_UNREADABLE Cannot get a reading for power from NVML
-	DCGM_FR_POWER_UNREADABLE HealthCheckErrorCode = 11
-	// DCGM_FR_CLOCK_THROTTLE_POWER Clock being throttled due to power restrictions
-	DCGM_FR_CLOCK_THROTTLE_POWER HealthCheckErrorCode = 12
-	// DCGM_FR_NVLINK_ERROR_THRESHOLD Unacceptable rate of NVLink errors
-	DCGM_FR_NVLINK_ERROR_THRESHOLD HealthCheckErrorCode = 13
-	// DCGM_FR_NVLINK_DOWN NVLink is down
-	DCGM_FR_NVLINK_DOWN HealthCheckErrorCode = 14
-	// DCGM_FR_NVSWITCH_FATAL_ERROR Fatal errors on the NVSwitch
-	DCGM_FR_NVSWITCH_FATAL_ERROR HealthCheckErrorCode = 15
-	// DCGM_FR_NVSWITCH_NON_FATAL_ERROR Non-fatal errors on the NVSwitch
-	DCGM_FR_NVSWITCH_NON_FATAL_ERROR HealthCheckErrorCode = 16
-	// DCGM_FR_NVSWITCH_DOWN NVSwitch is down
-	DCGM_FR_NVSWITCH_DOWN HealthCheckErrorCode = 17
-	// DCGM_FR_NO_ACCESS_TO_FILE Cannot access a file
-	DCGM_FR_NO_ACCESS_TO_FILE HealthCheckErrorCode = 18
-	// DCGM_FR_NVML_API Error occurred on an NVML API - NOT USED: DEPRECATED
-	DCGM_FR_NVML_API HealthCheckErrorCode = 19
-	// DCGM_FR_DEVICE_COUNT_MISMATCH Device count mismatch
-	DCGM_FR_DEVICE_COUNT_MISMATCH HealthCheckErrorCode = 20
-	// DCGM_FR_BAD_PARAMETER Bad parameter passed to API
-	DCGM_FR_BAD_PARAMETER HealthCheckErrorCode = 21
-	// DCGM_FR_CANNOT_OPEN_LIB Cannot open a library that must be accessed
-	DCGM_FR_CANNOT_OPEN_LIB HealthCheckErrorCode = 22
-	// DCGM_FR_DENYLISTED_DRIVER A driver on the denylist (nouveau) is active
-	DCGM_FR_DENYLISTED_DRIVER HealthCheckErrorCode = 23
-	// DCGM_FR_NVML_LIB_BAD NVML library is missing expected functions - NOT USED: DEPRECATED
-	DCGM_FR_NVML_LIB_BAD HealthCheckErrorCode = 24
-	// DCGM_FR_GRAPHICS_PROCESSES HealthCheckErrorCode = 25
-	DCGM_FR_GRAPHICS_PROCESSES HealthCheckErrorCode = 25
-	// DCGM_FR_HOSTENGINE_CONN Bad connection to nv-hostengine - NOT USED: DEPRECATED
-	DCGM_FR_HOSTENGINE_CONN HealthCheckErrorCode = 26
-	// DCGM_FR_FIELD_QUERY Field query failed
-	DCGM_FR_FIELD_QUERY HealthCheckErrorCode = 27
-	// DCGM_FR_BAD_CUDA_ENV The environment has variables that hurt CUDA
-	DCGM_FR_BAD_CUDA_ENV HealthCheckErrorCode = 28
-	// DCGM_FR_PERSISTENCE_MODE Persistence mode is disabled
-	DCGM_FR_PERSISTENCE_MODE HealthCheckErrorCode = 29
-	// DCGM_FR_BAD_NVLINK_ENV The environment has variables that hurt NVLink
+	// DCGM_FR_BAD_NVLINK_ENV shares its value with DCGM_FR_PERSISTENCE_MODE.
+	//
+	// Deprecated: not reported by DCGM.
 	DCGM_FR_BAD_NVLINK_ENV HealthCheckErrorCode = 29
-	// DCGM_FR_LOW_BANDWIDTH The bandwidth is unacceptably low
-	DCGM_FR_LOW_BANDWIDTH HealthCheckErrorCode = 30
-	// DCGM_FR_HIGH_LATENCY Latency is too high
-	DCGM_FR_HIGH_LATENCY HealthCheckErrorCode = 31
-	// DCGM_FR_CANNOT_GET_FIELD_TAG Cannot find a tag for a field
-	DCGM_FR_CANNOT_GET_FIELD_TAG HealthCheckErrorCode = 32
-	// DCGM_FR_FIELD_VIOLATION The value for the specified error field is above 0
-	DCGM_FR_FIELD_VIOLATION HealthCheckErrorCode = 33
-	// DCGM_FR_FIELD_THRESHOLD The value for the specified field is above the threshold
-	DCGM_FR_FIELD_THRESHOLD HealthCheckErrorCode = 34
-	// DCGM_FR_FIELD_VIOLATION_DBL The value for the specified error field is above 0
-	DCGM_FR_FIELD_VIOLATION_DBL HealthCheckErrorCode = 35
-	// DCGM_FR_FIELD_THRESHOLD_DBL The value for the specified field is above the threshold
-	DCGM_FR_FIELD_THRESHOLD_DBL HealthCheckErrorCode = 36
-	// DCGM_FR_UNSUPPORTED_FIELD_TYPE Field type cannot be supported
-	DCGM_FR_UNSUPPORTED_FIELD_TYPE HealthCheckErrorCode = 37
-	// DCGM_FR_FIELD_THRESHOLD_TS The value for the specified field is above the threshold
-	DCGM_FR_FIELD_THRESHOLD_TS HealthCheckErrorCode = 38
-	// DCGM_FR_FIELD_THRESHOLD_TS_DBL The value for the specified field is above the threshold
-	DCGM_FR_FIELD_THRESHOLD_TS_DBL HealthCheckErrorCode = 39
-	// DCGM_FR_THERMAL_VIOLATIONS Thermal violations detected
-	DCGM_FR_THERMAL_VIOLATIONS HealthCheckErrorCode = 40
-	// DCGM_FR_THERMAL_VIOLATIONS_TS Thermal violations detected with a timestamp
-	DCGM_FR_THERMAL_VIOLATIONS_TS HealthCheckErrorCode = 41
-	// DCGM_FR_TEMP_VIOLATION Non-benign clock throttling is occurring
-	DCGM_FR_TEMP_VIOLATION HealthCheckErrorCode = 42
-	// DCGM_FR_THROTTLING_VIOLATION Non-benign clock throttling is occurring
-	DCGM_FR_THROTTLING_VIOLATION HealthCheckErrorCode = 43
-	// DCGM_FR_INTERNAL An internal error was detected
-	DCGM_FR_INTERNAL HealthCheckErrorCode = 44
-	// DCGM_FR_PCIE_GENERATION PCIe generation is too low
-	DCGM_FR_PCIE_GENERATION HealthCheckErrorCode = 45
-	// DCGM_FR_PCIE_WIDTH PCIe width is too low
-	DCGM_FR_PCIE_WIDTH HealthCheckErrorCode = 46
-	// DCGM_FR_ABORTED Test was aborted by a user signal
-	DCGM_FR_ABORTED HealthCheckErrorCode = 47
-	// DCGM_FR_TEST_DISABLED Test was disabled by a user signal
-	DCGM_FR_TEST_DISABLED HealthCheckErrorCode = 48
-	// DCGM_FR_CANNOT_GET_STAT Cannot get telemetry for a needed value
-	DCGM_FR_CANNOT_GET_STAT HealthCheckErrorCode = 49
-	// DCGM_FR_STRESS_LEVEL Stress level is too low (bad performance)
-	DCGM_FR_STRESS_LEVEL HealthCheckErrorCode = 50
-	// DCGM_FR_CUDA_API HealthCheckErrorCode = 51
-	DCGM_FR_CUDA_API HealthCheckErrorCode = 51
-	// DCGM_FR_FAULTY_MEMORY Faulty memory detected on this GPU
-	DCGM_FR_FAULTY_MEMORY HealthCheckErrorCode = 52
-	// DCGM_FR_CANNOT_SET_WATCHES Unable to set field watches in DCGM - NOT USED: DEPRECATED
-	DCGM_FR_CANNOT_SET_WATCHES HealthCheckErrorCode = 53
-	// DCGM_FR_CUDA_UNBOUND CUDA context is no longer bound
-	DCGM_FR_CUDA_UNBOUND HealthCheckErrorCode = 54
-	// DCGM_FR_ECC_DISABLED ECC memory is disabled right now
-	DCGM_FR_ECC_DISABLED HealthCheckErrorCode = 55
-	// DCGM_FR_MEMORY_ALLOC Cannot allocate memory on the GPU
-	DCGM_FR_MEMORY_ALLOC HealthCheckErrorCode = 56
-	// DCGM_FR_CUDA_DBE CUDA detected unrecovable double-bit error
-	DCGM_FR_CUDA_DBE HealthCheckErrorCode = 57
-	// DCGM_FR_MEMORY_MISMATCH Memory error detected
-	DCGM_FR_MEMORY_MISMATCH HealthCheckErrorCode = 58
-	// DCGM_FR_CUDA_DEVICE No CUDA device discoverable for existing GPU
-	DCGM_FR_CUDA_DEVICE HealthCheckErrorCode = 59
-	// DCGM_FR_ECC_UNSUPPORTED ECC memory is unsupported by this SKU
-	DCGM_FR_ECC_UNSUPPORTED HealthCheckErrorCode = 60
-	// DCGM_FR_ECC_PENDING ECC memory is in a pending state - NOT USED: DEPRECATED
-	DCGM_FR_ECC_PENDING HealthCheckErrorCode = 61
-	// DCGM_FR_MEMORY_BANDWIDTH Memory bandwidth is too low
-	DCGM_FR_MEMORY_BANDWIDTH HealthCheckErrorCode = 62
-	// DCGM_FR_TARGET_POWER The target power is too low
-	DCGM_FR_TARGET_POWER HealthCheckErrorCode = 63
-	// DCGM_FR_API_FAIL The specified API call failed
-	DCGM_FR_API_FAIL HealthCheckErrorCode = 64
-	// DCGM_FR_API_FAIL_GPU The specified API call failed for the specified GPU
-	DCGM_FR_API_FAIL_GPU HealthCheckErrorCode = 65
-	// DCGM_FR_CUDA_CONTEXT Cannot create a CUDA context on this GPU
-	DCGM_FR_CUDA_CONTEXT HealthCheckErrorCode = 66
-	// DCGM_FR_DCGM_API DCGM API failure
-	DCGM_FR_DCGM_API HealthCheckErrorCode = 67
-	// DCGM_FR_CONCURRENT_GPUS Need multiple GPUs to run this test
-	DCGM_FR_CONCURRENT_GPUS HealthCheckErrorCode = 68
-	// DCGM_FR_TOO_MANY_ERRORS More errors than fit in the return struct - NOT USED: DEPRECATED
-	DCGM_FR_TOO_MANY_ERRORS HealthCheckErrorCode = 69
-	// DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD NVLink CRC error threshold violation
-	DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD HealthCheckErrorCode = 70
-	// DCGM_FR_NVLINK_ERROR_CRITICAL NVLink error for a field that should always be 0
-	DCGM_FR_NVLINK_ERROR_CRITICAL HealthCheckErrorCode = 71
-	// DCGM_FR_ENFORCED_POWER_LIMIT The enforced power limit is too low to hit the target
-	DCGM_FR_ENFORCED_POWER_LIMIT HealthCheckErrorCode = 72
-	// DCGM_FR_MEMORY_ALLOC_HOST Cannot allocate memory on the host
-	DCGM_FR_MEMORY_ALLOC_HOST HealthCheckErrorCode = 73
-	// DCGM_FR_GPU_OP_MODE Bad GPU operating mode for running plugin - NOT USED: DEPRECATED
-	DCGM_FR_GPU_OP_MODE HealthCheckErrorCode = 74
-	// DCGM_FR_NO_MEMORY_CLOCKS No memory clocks with the needed MHz found - NOT USED: DEPRECATED
-	DCGM_FR_NO_MEMORY_CLOCKS HealthCheckErrorCode = 75
-	// DCGM_FR_NO_GRAPHICS_CLOCKS No graphics clocks with the needed MHz found - NOT USED: DEPRECATED
-	DCGM_FR_NO_GRAPHICS_CLOCKS HealthCheckErrorCode = 76
-	// DCGM_FR_HAD_TO_RESTORE_STATE Note that we had to restore a GPU's state
-	DCGM_FR_HAD_TO_RESTORE_STATE HealthCheckErrorCode = 77
-	// DCGM_FR_L1TAG_UNSUPPORTED L1TAG test is unsupported by this SKU
-	DCGM_FR_L1TAG_UNSUPPORTED HealthCheckErrorCode = 78
-	// DCGM_FR_L1TAG_MISCOMPARE L1TAG test failed on a miscompare
-	DCGM_FR_L1TAG_MISCOMPARE HealthCheckErrorCode = 79
-	// DCGM_FR_ROW_REMAP_FAILURE Row remapping failed (Ampere or newer GPUs)
-	DCGM_FR_ROW_REMAP_FAILURE HealthCheckErrorCode = 80
-	// DCGM_FR_UNCONTAINED_ERROR Uncontained error - XID 95
-	DCGM_FR_UNCONTAINED_ERROR HealthCheckErrorCode = 81
-	// DCGM_FR_EMPTY_GPU_LIST No GPU information given to plugin
-	DCGM_FR_EMPTY_GPU_LIST HealthCheckErrorCode = 82
-	// DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS Pending page retirements due to a DBE
-	DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS HealthCheckErrorCode = 83
-	// DCGM_FR_UNCORRECTABLE_ROW_REMAP Uncorrectable row remapping
-	DCGM_FR_UNCORRECTABLE_ROW_REMAP HealthCheckErrorCode = 84
-	// DCGM_FR_PENDING_ROW_REMAP Row remapping is pending
-	DCGM_FR_PENDING_ROW_REMAP HealthCheckErrorCode = 85
-	// DCGM_FR_BROKEN_P2P_MEMORY_DEVICE P2P copy test detected an error writing to this GPU
-	DCGM_FR_BROKEN_P2P_MEMORY_DEVICE HealthCheckErrorCode = 86
-	// DCGM_FR_BROKEN_P2P_WRITER_DEVICE P2P copy test detected an error writing from this GPU
-	DCGM_FR_BROKEN_P2P_WRITER_DEVICE HealthCheckErrorCode = 87
-	// DCGM_FR_NVSWITCH_NVLINK_DOWN An NvLink is down for the specified NVSwitch
-	DCGM_FR_NVSWITCH_NVLINK_DOWN HealthCheckErrorCode = 88
-	// DCGM_FR_EUD_BINARY_PERMISSIONS EUD binary permissions are incorrect
-	DCGM_FR_EUD_BINARY_PERMISSIONS HealthCheckErrorCode = 89
-	// DCGM_FR_EUD_NON_ROOT_USER EUD plugin is not running as root
-	DCGM_FR_EUD_NON_ROOT_USER HealthCheckErrorCode = 90
-	// DCGM_FR_EUD_SPAWN_FAILURE EUD plugin failed to spawn the EUD binary
-	DCGM_FR_EUD_SPAWN_FAILURE HealthCheckErrorCode = 91
-	// DCGM_FR_EUD_TIMEOUT EUD plugin timed out
-	DCGM_FR_EUD_TIMEOUT HealthCheckErrorCode = 92
-	// DCGM_FR_EUD_ZOMBIE EUD process remains running after the plugin considers it finished
-	DCGM_FR_EUD_ZOMBIE HealthCheckErrorCode = 93
-	// DCGM_FR_EUD_NON_ZERO_EXIT_CODE EUD process exited with a non-zero exit code
-	DCGM_FR_EUD_NON_ZERO_EXIT_CODE HealthCheckErrorCode = 94
-	// DCGM_FR_EUD_TEST_FAILED EUD test failed
-	DCGM_FR_EUD_TEST_FAILED HealthCheckErrorCode = 95
-	// DCGM_FR_FILE_CREATE_PERMISSIONS We cannot create a file in this directory.
-	DCGM_FR_FILE_CREATE_PERMISSIONS HealthCheckErrorCode = 96
-	// DCGM_FR_PAUSE_RESUME_FAILED Pause/Resume failed
-	DCGM_FR_PAUSE_RESUME_FAILED HealthCheckErrorCode = 97
-	// DCGM_FR_PCIE_H_REPLAY_VIOLATION PCIe H replay violation
-	DCGM_FR_PCIE_H_REPLAY_VIOLATION HealthCheckErrorCode = 98
-	// DCGM_FR_GPU_EXPECTED_NVLINKS_UP Expected nvlinks up per gpu
-	DCGM_FR_GPU_EXPECTED_NVLINKS_UP HealthCheckErrorCode = 99
-	// DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP Expected nvlinks up per nvswitch
-	DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP HealthCheckErrorCode = 100
-	// DCGM_FR_XID_ERROR XID error detected
-	DCGM_FR_XID_ERROR HealthCheckErrorCode = 101
-	// DCGM_FR_SBE_VIOLATION Single bit error detected
-	DCGM_FR_SBE_VIOLATION HealthCheckErrorCode = 102
-	// DCGM_FR_DBE_VIOLATION Double bit error detected
-	DCGM_FR_DBE_VIOLATION HealthCheckErrorCode = 103
-	// DCGM_FR_PCIE_REPLAY_VIOLATION PCIe replay errors detected
-	DCGM_FR_PCIE_REPLAY_VIOLATION HealthCheckErrorCode = 104
-	// DCGM_FR_SBE_THRESHOLD_VIOLATION SBE threshold violated
-	DCGM_FR_SBE_THRESHOLD_VIOLATION HealthCheckErrorCode = 105
-	// DCGM_FR_DBE_THRESHOLD_VIOLATION DBE threshold violated
-	DCGM_FR_DBE_THRESHOLD_VIOLATION HealthCheckErrorCode = 106
-	// DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION PCIe replay count violated
-	DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION HealthCheckErrorCode = 107
-	// DCGM_FR_CUDA_FM_NOT_INITIALIZED The fabricmanager is not initialized
-	DCGM_FR_CUDA_FM_NOT_INITIALIZED HealthCheckErrorCode = 108
-	// DCGM_FR_SXID_ERROR NvSwitch fatal error detected
-	DCGM_FR_SXID_ERROR HealthCheckErrorCode = 109
-	// DCGM_FR_GFLOPS_THRESHOLD_VIOLATION GPU GFLOPs threshold violated
-	DCGM_FR_GFLOPS_THRESHOLD_VIOLATION HealthCheckErrorCode = 110
-	// DCGM_FR_NAN_VALUE NaN value detected on this GPU
-	DCGM_FR_NAN_VALUE HealthCheckErrorCode = 111
-	// DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR Fabric Manager did not finish training
-	DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR HealthCheckErrorCode = 112
-	// DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE P2P copy test detected an error writing to this GPU over PCIE
-	DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE HealthCheckErrorCode = 113
-	// DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE P2P copy test detected an error writing from this GPU over PCIE
-	DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE HealthCheckErrorCode = 114
-	// DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE P2P copy test detected an error writing to this GPU over NVLink
-	DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE HealthCheckErrorCode = 115
-	// DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE P2P copy test detected an error writing from this GPU over NVLink
-	DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE HealthCheckErrorCode = 116
-	// DCGM_FR_TEST_SKIPPED Indicates that the test was skipped
-	DCGM_FR_TEST_SKIPPED HealthCheckErrorCode = 117
-	// DCGM_FR_SRAM_THRESHOLD SRAM Threshold Count exceeded
-	DCGM_FR_SRAM_THRESHOLD HealthCheckErrorCode = 118
-	// DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD Effective BER threshold exceeded
-	DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD HealthCheckErrorCode = 119
-	// DCGM_FR_FALLEN_OFF_BUS GPU has fallen off the bus
-	DCGM_FR_FALLEN_OFF_BUS HealthCheckErrorCode = 120
-	// DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD Symbol BER threshold exceeded
-	DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD HealthCheckErrorCode = 121
-	// DCGM_FR_IMEX_UNHEALTHY IMEX domain or daemon status is unhealthy
-	DCGM_FR_IMEX_UNHEALTHY HealthCheckErrorCode = 122
-	// DCGM_FR_FABRIC_PROBE_STATE Fabric probe state error
-	DCGM_FR_FABRIC_PROBE_STATE HealthCheckErrorCode = 123
-	// DCGM_FR_BINARY_PERMISSIONS Binary permissions are incorrect
-	DCGM_FR_BINARY_PERMISSIONS HealthCheckErrorCode = 124
-	// DCGM_FR_GPU_RECOVERY_RESET GPU requires reset to recover from a fault
-	DCGM_FR_GPU_RECOVERY_RESET HealthCheckErrorCode = 125
-	// DCGM_FR_GPU_RECOVERY_REBOOT Node requires reboot due to GPU fault
-	DCGM_FR_GPU_RECOVERY_REBOOT HealthCheckErrorCode = 126
-	// DCGM_FR_GPU_RECOVERY_DRAIN_P2P Peer-to-peer traffic must be drained
-	DCGM_FR_GPU_RECOVERY_DRAIN_P2P HealthCheckErrorCode = 127
-	// DCGM_FR_GPU_RECOVERY_DRAIN_RESET GPU operating at reduced capacity, drain and reset required
-	DCGM_FR_GPU_RECOVERY_DRAIN_RESET HealthCheckErrorCode = 128
-	// DCGM_FR_NCCL_ERROR Detected a NCCL error
-	DCGM_FR_NCCL_ERROR HealthCheckErrorCode = 129
-	// DCGM_FR_RETEST_REQUESTED Retest requested before providing results
-	DCGM_FR_RETEST_REQUESTED HealthCheckErrorCode = 130
-	// DCGM_FR_CONTAINED_ERROR GPU contained error
-	DCGM_FR_CONTAINED_ERROR HealthCheckErrorCode = 131
-	// DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT Uncorrectable row remap threshold exceeded
-	DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT HealthCheckErrorCode = 132
-	// DCGM_FR_CPU_SDC_TEST_FAILED SDC test failed
-	DCGM_FR_CPU_SDC_TEST_FAILED HealthCheckErrorCode = 133
-	// DCGM_FR_ERROR_SENTINEL MUST BE THE LAST ERROR CODE
-	DCGM_FR_ERROR_SENTINEL HealthCheckErrorCode = 134
 )
 
 // BindUnbindEventState represents the state of GPU bind/unbind events
@@ -0,0 +1,1836 @@
+/*
+ * Copyright (c) 2026, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by gen-errors; DO NOT EDIT.
+
+package dcgm
+
+import "strconv"
+
+const (
+	// DCGM_FR_OK No error
+	DCGM_FR_OK HealthCheckErrorCode = 0
+	// DCGM_FR_UNKNOWN Unknown error code
+	DCGM_FR_UNKNOWN HealthCheckErrorCode = 1
+	// DCGM_FR_UNRECOGNIZED Unrecognized error code
+	DCGM_FR_UNRECOGNIZED HealthCheckErrorCode = 2
+	// DCGM_FR_PCI_REPLAY_RATE Unacceptable rate of PCI errors
+	DCGM_FR_PCI_REPLAY_RATE HealthCheckErrorCode = 3
+	// DCGM_FR_VOLATILE_DBE_DETECTED Uncorrectable volatile double bit error
+	DCGM_FR_VOLATILE_DBE_DETECTED HealthCheckErrorCode = 4
+	// DCGM_FR_VOLATILE_SBE_DETECTED Unacceptable rate of volatile single bit errors
+	DCGM_FR_VOLATILE_SBE_DETECTED HealthCheckErrorCode = 5
+	// DCGM_FR_PENDING_PAGE_RETIREMENTS Pending page retirements detected
+	DCGM_FR_PENDING_PAGE_RETIREMENTS HealthCheckErrorCode = 6
+	// DCGM_FR_RETIRED_PAGES_LIMIT Unacceptable total page retirements detected
+	DCGM_FR_RETIRED_PAGES_LIMIT HealthCheckErrorCode = 7
+	// DCGM_FR_RETIRED_PAGES_DBE_LIMIT Unacceptable total page retirements due to uncorrectable errors
+	DCGM_FR_RETIRED_PAGES_DBE_LIMIT HealthCheckErrorCode = 8
+	// DCGM_FR_CORRUPT_INFOROM Corrupt inforom found
+	DCGM_FR_CORRUPT_INFOROM HealthCheckErrorCode = 9
+	// DCGM_FR_CLOCKS_EVENT_THERMAL Clocks being optimized for thermal performance
+	DCGM_FR_CLOCKS_EVENT_THERMAL HealthCheckErrorCode = 10
+	// DCGM_FR_POWER_UNREADABLE Cannot get a reading for power from NVML
+	DCGM_FR_POWER_UNREADABLE HealthCheckErrorCode = 11
+	// DCGM_FR_CLOCKS_EVENT_POWER Clock being optimized to meet the product's power limit requirements
+	DCGM_FR_CLOCKS_EVENT_POWER HealthCheckErrorCode = 12
+	// DCGM_FR_NVLINK_ERROR_THRESHOLD Unacceptable rate of NVLink errors
+	DCGM_FR_NVLINK_ERROR_THRESHOLD HealthCheckErrorCode = 13
+	// DCGM_FR_NVLINK_DOWN NVLink is down
+	DCGM_FR_NVLINK_DOWN HealthCheckErrorCode = 14
+	// DCGM_FR_NVSWITCH_FATAL_ERROR Fatal errors on the NVSwitch
+	DCGM_FR_NVSWITCH_FATAL_ERROR HealthCheckErrorCode = 15
+	// DCGM_FR_NVSWITCH_NON_FATAL_ERROR Non-fatal errors on the NVSwitch
+	DCGM_FR_NVSWITCH_NON_FATAL_ERROR HealthCheckErrorCode = 16
+	// DCGM_FR_NVSWITCH_DOWN NVSwitch is down - NOT USED: DEPRECATED
+	DCGM_FR_NVSWITCH_DOWN HealthCheckErrorCode = 17
+	// DCGM_FR_NO_ACCESS_TO_FILE Cannot access a file
+	DCGM_FR_NO_ACCESS_TO_FILE HealthCheckErrorCode = 18
+	// DCGM_FR_NVML_API Error occurred on an NVML API - NOT USED: DEPRECATED
+	DCGM_FR_NVML_API HealthCheckErrorCode = 19
+	// DCGM_FR_DEVICE_COUNT_MISMATCH Disagreement in GPU count between /dev and NVML
+	DCGM_FR_DEVICE_COUNT_MISMATCH HealthCheckErrorCode = 20
+	// DCGM_FR_BAD_PARAMETER Bad parameter passed to API
+	DCGM_FR_BAD_PARAMETER HealthCheckErrorCode = 21
+	// DCGM_FR_CANNOT_OPEN_LIB Cannot open a library that must be accessed
+	DCGM_FR_CANNOT_OPEN_LIB HealthCheckErrorCode = 22
+	// DCGM_FR_DENYLISTED_DRIVER A driver on the denylist (nouveau) is active
+	DCGM_FR_DENYLISTED_DRIVER HealthCheckErrorCode = 23
+	// DCGM_FR_NVML_LIB_BAD NVML library is missing expected functions - NOT USED: DEPRECATED
+	DCGM_FR_NVML_LIB_BAD HealthCheckErrorCode = 24
+	// DCGM_FR_GRAPHICS_PROCESSES Graphics processes are active on this GPU
+	DCGM_FR_GRAPHICS_PROCESSES HealthCheckErrorCode = 25
+	// DCGM_FR_HOSTENGINE_CONN Bad connection to nv-hostengine - NOT USED: DEPRECATED
+	DCGM_FR_HOSTENGINE_CONN HealthCheckErrorCode = 26
+	// DCGM_FR_FIELD_QUERY Error querying a field from DCGM
+	DCGM_FR_FIELD_QUERY HealthCheckErrorCode = 27
+	// DCGM_FR_BAD_CUDA_ENV The environment has variables that hurt CUDA
+	DCGM_FR_BAD_CUDA_ENV HealthCheckErrorCode = 28
+	// DCGM_FR_PERSISTENCE_MODE Persistence mode is disabled
+	DCGM_FR_PERSISTENCE_MODE HealthCheckErrorCode = 29
+	// DCGM_FR_LOW_BANDWIDTH The bandwidth is unacceptably low
+	DCGM_FR_LOW_BANDWIDTH HealthCheckErrorCode = 30
+	// DCGM_FR_HIGH_LATENCY Latency is too high
+	DCGM_FR_HIGH_LATENCY HealthCheckErrorCode = 31
+	// DCGM_FR_CANNOT_GET_FIELD_TAG Cannot find a tag for a field
+	DCGM_FR_CANNOT_GET_FIELD_TAG HealthCheckErrorCode = 32
+	// DCGM_FR_FIELD_VIOLATION The value for the specified error field is above 0
+	DCGM_FR_FIELD_VIOLATION HealthCheckErrorCode = 33
+	// DCGM_FR_FIELD_THRESHOLD The value for the specified field is above the threshold
+	DCGM_FR_FIELD_THRESHOLD HealthCheckErrorCode = 34
+	// DCGM_FR_FIELD_VIOLATION_DBL The value for the specified error field is above 0
+	DCGM_FR_FIELD_VIOLATION_DBL HealthCheckErrorCode = 35
+	// DCGM_FR_FIELD_THRESHOLD_DBL The value for the specified field is above the threshold
+	DCGM_FR_FIELD_THRESHOLD_DBL HealthCheckErrorCode = 36
+	// DCGM_FR_UNSUPPORTED_FIELD_TYPE Field type cannot be supported
+	DCGM_FR_UNSUPPORTED_FIELD_TYPE HealthCheckErrorCode = 37
+	// DCGM_FR_FIELD_THRESHOLD_TS The value for the specified field is above the threshold
+	DCGM_FR_FIELD_THRESHOLD_TS HealthCheckErrorCode = 38
+	// DCGM_FR_FIELD_THRESHOLD_TS_DBL The value for the specified field is above the threshold
+	DCGM_FR_FIELD_THRESHOLD_TS_DBL HealthCheckErrorCode = 39
+	// DCGM_FR_THERMAL_VIOLATIONS Thermal violations detected
+	DCGM_FR_THERMAL_VIOLATIONS HealthCheckErrorCode = 40
+	// DCGM_FR_THERMAL_VIOLATIONS_TS Thermal violations detected with a timestamp
+	DCGM_FR_THERMAL_VIOLATIONS_TS HealthCheckErrorCode = 41
+	// DCGM_FR_TEMP_VIOLATION Temperature is too high
+	DCGM_FR_TEMP_VIOLATION HealthCheckErrorCode = 42
+	// DCGM_FR_CLOCKS_EVENT_VIOLATION Non-benign clocks event is occurring
+	DCGM_FR_CLOCKS_EVENT_VIOLATION HealthCheckErrorCode = 43
+	// DCGM_FR_INTERNAL An internal error was detected
+	DCGM_FR_INTERNAL HealthCheckErrorCode = 44
+	// DCGM_FR_PCIE_GENERATION PCIe generation is too low
+	DCGM_FR_PCIE_GENERATION HealthCheckErrorCode = 45
+	// DCGM_FR_PCIE_WIDTH PCIe width is too low
+	DCGM_FR_PCIE_WIDTH HealthCheckErrorCode = 46
+	// DCGM_FR_ABORTED Test was aborted by a user signal
+	DCGM_FR_ABORTED HealthCheckErrorCode = 47
+	// DCGM_FR_TEST_DISABLED This test is disabled for this GPU
+	DCGM_FR_TEST_DISABLED HealthCheckErrorCode = 48
+	// DCGM_FR_CANNOT_GET_STAT Cannot get telemetry for a needed value
+	DCGM_FR_CANNOT_GET_STAT HealthCheckErrorCode = 49
+	// DCGM_FR_STRESS_LEVEL Stress level is too low (bad performance)
+	DCGM_FR_STRESS_LEVEL HealthCheckErrorCode = 50
+	// DCGM_FR_CUDA_API Error calling the specified CUDA API
+	DCGM_FR_CUDA_API HealthCheckErrorCode = 51
+	// DCGM_FR_FAULTY_MEMORY Faulty memory detected on this GPU
+	DCGM_FR_FAULTY_MEMORY HealthCheckErrorCode = 52
+	// DCGM_FR_CANNOT_SET_WATCHES Unable to set field watches in DCGM - NOT USED: DEPRECATED
+	DCGM_FR_CANNOT_SET_WATCHES HealthCheckErrorCode = 53
+	// DCGM_FR_CUDA_UNBOUND CUDA context is no longer bound
+	DCGM_FR_CUDA_UNBOUND HealthCheckErrorCode = 54
+	// DCGM_FR_ECC_DISABLED ECC memory is disabled right now
+	DCGM_FR_ECC_DISABLED HealthCheckErrorCode = 55
+	// DCGM_FR_MEMORY_ALLOC Cannot allocate memory on the GPU
+	DCGM_FR_MEMORY_ALLOC HealthCheckErrorCode = 56
+	// DCGM_FR_CUDA_DBE CUDA detected unrecovable double-bit error
+	DCGM_FR_CUDA_DBE HealthCheckErrorCode = 57
+	// DCGM_FR_MEMORY_MISMATCH Memory error detected
+	DCGM_FR_MEMORY_MISMATCH HealthCheckErrorCode = 58
+	// DCGM_FR_CUDA_DEVICE No CUDA device discoverable for existing GPU
+	DCGM_FR_CUDA_DEVICE HealthCheckErrorCode = 59
+	// DCGM_FR_ECC_UNSUPPORTED ECC memory is unsupported by this SKU
+	DCGM_FR_ECC_UNSUPPORTED HealthCheckErrorCode = 60
+	// DCGM_FR_ECC_PENDING ECC memory is in a pending state - NOT USED: DEPRECATED
+	DCGM_FR_ECC_PENDING HealthCheckErrorCode = 61
+	// DCGM_FR_MEMORY_BANDWIDTH Memory bandwidth is too low
+	DCGM_FR_MEMORY_BANDWIDTH HealthCheckErrorCode = 62
+	// DCGM_FR_TARGET_POWER Cannot hit the target power draw
+	DCGM_FR_TARGET_POWER HealthCheckErrorCode = 63
+	// DCGM_FR_API_FAIL The specified API call failed
+	DCGM_FR_API_FAIL HealthCheckErrorCode = 64
+	// DCGM_FR_API_FAIL_GPU The specified API call failed for the specified GPU
+	DCGM_FR_API_FAIL_GPU HealthCheckErrorCode = 65
+	// DCGM_FR_CUDA_CONTEXT Cannot create a CUDA context on this GPU
+	DCGM_FR_CUDA_CONTEXT HealthCheckErrorCode = 66
+	// DCGM_FR_DCGM_API DCGM API failure
+	DCGM_FR_DCGM_API HealthCheckErrorCode = 67
+	// DCGM_FR_CONCURRENT_GPUS Need multiple GPUs to run this test
+	DCGM_FR_CONCURRENT_GPUS HealthCheckErrorCode = 68
+	// DCGM_FR_TOO_MANY_ERRORS More errors than fit in the return struct - NOT USED: DEPRECATED
+	DCGM_FR_TOO_MANY_ERRORS HealthCheckErrorCode = 69
+	// DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD More than 100 CRC errors are happening per second
+	DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD HealthCheckErrorCode = 70
+	// DCGM_FR_NVLINK_ERROR_CRITICAL NVLink error for a field that should always be 0
+	DCGM_FR_NVLINK_ERROR_CRITICAL HealthCheckErrorCode = 71
+	// DCGM_FR_ENFORCED_POWER_LIMIT The enforced power limit is too low to hit the target
+	DCGM_FR_ENFORCED_POWER_LIMIT HealthCheckErrorCode = 72
+	// DCGM_FR_MEMORY_ALLOC_HOST Cannot allocate memory on the host
+	DCGM_FR_MEMORY_ALLOC_HOST HealthCheckErrorCode = 73
+	// DCGM_FR_GPU_OP_MODE Bad GPU operating mode for running plugin - NOT USED: DEPRECATED
+	DCGM_FR_GPU_OP_MODE HealthCheckErrorCode = 74
+	// DCGM_FR_NO_MEMORY_CLOCKS No memory clocks with the needed MHz found - NOT USED: DEPRECATED
+	DCGM_FR_NO_MEMORY_CLOCKS HealthCheckErrorCode = 75
+	// DCGM_FR_NO_GRAPHICS_CLOCKS No graphics clocks with the needed MHz found - NOT USED: DEPRECATED
+	DCGM_FR_NO_GRAPHICS_CLOCKS HealthCheckErrorCode = 76
+	// DCGM_FR_HAD_TO_RESTORE_STATE Note that we had to restore a GPU's state
+	DCGM_FR_HAD_TO_RESTORE_STATE HealthCheckErrorCode = 77
+	// DCGM_FR_L1TAG_UNSUPPORTED L1TAG test is unsupported by this SKU
+	DCGM_FR_L1TAG_UNSUPPORTED HealthCheckErrorCode = 78
+	// DCGM_FR_L1TAG_MISCOMPARE L1TAG test failed on a miscompare
+	DCGM_FR_L1TAG_MISCOMPARE HealthCheckErrorCode = 79
+	// DCGM_FR_ROW_REMAP_FAILURE Row remapping failed (Ampere or newer GPUs)
+	DCGM_FR_ROW_REMAP_FAILURE HealthCheckErrorCode = 80
+	// DCGM_FR_UNCONTAINED_ERROR Uncontained error - XID 95
+	DCGM_FR_UNCONTAINED_ERROR HealthCheckErrorCode = 81
+	// DCGM_FR_EMPTY_GPU_LIST No GPU information given to plugin
+	DCGM_FR_EMPTY_GPU_LIST HealthCheckErrorCode = 82
+	// DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS Pending page retirements due to a DBE
+	DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS HealthCheckErrorCode = 83
+	// DCGM_FR_UNCORRECTABLE_ROW_REMAP Uncorrectable row remapping
+	DCGM_FR_UNCORRECTABLE_ROW_REMAP HealthCheckErrorCode = 84
+	// DCGM_FR_PENDING_ROW_REMAP Row remapping is pending
+	DCGM_FR_PENDING_ROW_REMAP HealthCheckErrorCode = 85
+	// DCGM_FR_BROKEN_P2P_MEMORY_DEVICE P2P copy test detected an error writing to this GPU
+	DCGM_FR_BROKEN_P2P_MEMORY_DEVICE HealthCheckErrorCode = 86
+	// DCGM_FR_BROKEN_P2P_WRITER_DEVICE P2P copy test detected an error writing from this GPU
+	DCGM_FR_BROKEN_P2P_WRITER_DEVICE HealthCheckErrorCode = 87
+	// DCGM_FR_NVSWITCH_NVLINK_DOWN An NvLink is down for the specified NVSwitch - NOT USED: DEPRECATED
+	DCGM_FR_NVSWITCH_NVLINK_DOWN HealthCheckErrorCode = 88
+	// DCGM_FR_EUD_BINARY_PERMISSIONS EUD binary permissions are incorrect
+	DCGM_FR_EUD_BINARY_PERMISSIONS HealthCheckErrorCode = 89
+	// DCGM_FR_EUD_NON_ROOT_USER EUD plugin is not running as root
+	DCGM_FR_EUD_NON_ROOT_USER HealthCheckErrorCode = 90
+	// DCGM_FR_EUD_SPAWN_FAILURE EUD plugin failed to spawn the EUD binary
+	DCGM_FR_EUD_SPAWN_FAILURE HealthCheckErrorCode = 91
+	// DCGM_FR_EUD_TIMEOUT EUD plugin timed out
+	DCGM_FR_EUD_TIMEOUT HealthCheckErrorCode = 92
+	// DCGM_FR_EUD_ZOMBIE EUD process remains running after the plugin considers it finished
+	DCGM_FR_EUD_ZOMBIE HealthCheckErrorCode = 93
+	// DCGM_FR_EUD_NON_ZERO_EXIT_CODE EUD process exited with a non-zero exit code
+	DCGM_FR_EUD_NON_ZERO_EXIT_CODE HealthCheckErrorCode = 94
+	// DCGM_FR_EUD_TEST_FAILED EUD test failed
+	DCGM_FR_EUD_TEST_FAILED HealthCheckErrorCode = 95
+	// DCGM_FR_FILE_CREATE_PERMISSIONS We cannot create a file in this directory.
+	DCGM_FR_FILE_CREATE_PERMISSIONS HealthCheckErrorCode = 96
+	// DCGM_FR_PAUSE_RESUME_FAILED Pause/Resume failed
+	DCGM_FR_PAUSE_RESUME_FAILED HealthCheckErrorCode = 97
+	// DCGM_FR_PCIE_H_REPLAY_VIOLATION PCIe test caught correctable errors
+	DCGM_FR_PCIE_H_REPLAY_VIOLATION HealthCheckErrorCode = 98
+	// DCGM_FR_GPU_EXPECTED_NVLINKS_UP Expected nvlinks up per gpu
+	DCGM_FR_GPU_EXPECTED_NVLINKS_UP HealthCheckErrorCode = 99
+	// DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP Expected nvlinks up per nvswitch
+	DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP HealthCheckErrorCode = 100
+	// DCGM_FR_XID_ERROR XID error detected
+	DCGM_FR_XID_ERROR HealthCheckErrorCode = 101
+	// DCGM_FR_SBE_VIOLATION Single bit error detected
+	DCGM_FR_SBE_VIOLATION HealthCheckErrorCode = 102
+	// DCGM_FR_DBE_VIOLATION Double bit error detected
+	DCGM_FR_DBE_VIOLATION HealthCheckErrorCode = 103
+	// DCGM_FR_PCIE_REPLAY_VIOLATION PCIe replay errors detected
+	DCGM_FR_PCIE_REPLAY_VIOLATION HealthCheckErrorCode = 104
+	// DCGM_FR_SBE_THRESHOLD_VIOLATION SBE threshold violated
+	DCGM_FR_SBE_THRESHOLD_VIOLATION HealthCheckErrorCode = 105
+	// DCGM_FR_DBE_THRESHOLD_VIOLATION DBE threshold violated
+	DCGM_FR_DBE_THRESHOLD_VIOLATION HealthCheckErrorCode = 106
+	// DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION PCIE replay count violated
+	DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION HealthCheckErrorCode = 107
+	// DCGM_FR_CUDA_FM_NOT_INITIALIZED The fabricmanager is not initialized
+	DCGM_FR_CUDA_FM_NOT_INITIALIZED HealthCheckErrorCode = 108
+	// DCGM_FR_SXID_ERROR NvSwitch fatal error detected
+	DCGM_FR_SXID_ERROR HealthCheckErrorCode = 109
+	// DCGM_FR_GFLOPS_THRESHOLD_VIOLATION GPU GFLOPs threshold violated
+	DCGM_FR_GFLOPS_THRESHOLD_VIOLATION HealthCheckErrorCode = 110
+	// DCGM_FR_NAN_VALUE NaN value detected on this GPU
+	DCGM_FR_NAN_VALUE HealthCheckErrorCode = 111
+	// DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR Fabric Manager did not finish training
+	DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR HealthCheckErrorCode = 112
+	// DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE P2P copy test detected an error writing to this GPU over PCIE
+	DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE HealthCheckErrorCode = 113
+	// DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE P2P copy test detected an error writing from this GPU over PCIE
+	DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE HealthCheckErrorCode = 114
+	// DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE P2P copy test detected an error writing to this GPU over NVLink
+	DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE HealthCheckErrorCode = 115
+	// DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE P2P copy test detected an error writing from this GPU over NVLink
+	DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE HealthCheckErrorCode = 116
+	// DCGM_FR_TEST_SKIPPED Indicates that the test was skipped
+	DCGM_FR_TEST_SKIPPED HealthCheckErrorCode = 117
+	// DCGM_FR_SRAM_THRESHOLD indicates SRAM Threshold Count exceeded
+	DCGM_FR_SRAM_THRESHOLD HealthCheckErrorCode = 118
+	// DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD indicates effective BER threshold exceeded
+	DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD HealthCheckErrorCode = 119
+	// DCGM_FR_FALLEN_OFF_BUS GPU has fallen off the bus
+	DCGM_FR_FALLEN_OFF_BUS HealthCheckErrorCode = 120
+	// DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD indicates symbol BER threshold exceeded
+	DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD HealthCheckErrorCode = 121
+	// DCGM_FR_IMEX_UNHEALTHY IMEX domain or daemon status is unhealthy
+	DCGM_FR_IMEX_UNHEALTHY HealthCheckErrorCode = 122
+	// DCGM_FR_FABRIC_PROBE_STATE Fabric probe state error
+	DCGM_FR_FABRIC_PROBE_STATE HealthCheckErrorCode = 123
+	// DCGM_FR_BINARY_PERMISSIONS Binary permissions are incorrect
+	DCGM_FR_BINARY_PERMISSIONS HealthCheckErrorCode = 124
+	// DCGM_FR_GPU_RECOVERY_RESET GPU requires reset to recover from a fault
+	DCGM_FR_GPU_RECOVERY_RESET HealthCheckErrorCode = 125
+	// DCGM_FR_GPU_RECOVERY_REBOOT Node requires reboot due to GPU fault
+	DCGM_FR_GPU_RECOVERY_REBOOT HealthCheckErrorCode = 126
+	// DCGM_FR_GPU_RECOVERY_DRAIN_P2P Peer-to-peer traffic must be drained
+	DCGM_FR_GPU_RECOVERY_DRAIN_P2P HealthCheckErrorCode = 127
+	// DCGM_FR_GPU_RECOVERY_DRAIN_RESET GPU operating at reduced capacity, drain and reset required
+	DCGM_FR_GPU_RECOVERY_DRAIN_RESET HealthCheckErrorCode = 128
+	// DCGM_FR_NCCL_ERROR Detected a NCCL error
+	DCGM_FR_NCCL_ERROR HealthCheckErrorCode = 129
+	// DCGM_FR_RETEST_REQUESTED Retest requested before providing results
+	DCGM_FR_RETEST_REQUESTED HealthCheckErrorCode = 130
+	// DCGM_FR_CONTAINED_ERROR GPU contained error
+	DCGM_FR_CONTAINED_ERROR HealthCheckErrorCode = 131
+	// DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT Uncorrectable row remap threshold exceeded
+	DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT HealthCheckErrorCode = 132
+	// DCGM_FR_CPU_SDC_TEST_FAILED SDC test failed
+	DCGM_FR_CPU_SDC_TEST_FAILED HealthCheckErrorCode = 133
+	// DCGM_FR_ERROR_SENTINEL MUST BE THE LAST ERROR CODE
+	DCGM_FR_ERROR_SENTINEL HealthCheckErrorCode = 134
+
+	// Deprecated DCGM error code aliases retained for source compatibility.
+	// DCGM_FR_CLOCK_THROTTLE_THERMAL is deprecated; use DCGM_FR_CLOCKS_EVENT_THERMAL.
+	DCGM_FR_CLOCK_THROTTLE_THERMAL HealthCheckErrorCode = DCGM_FR_CLOCKS_EVENT_THERMAL
+	// DCGM_FR_CLOCK_THROTTLE_POWER is deprecated; use DCGM_FR_CLOCKS_EVENT_POWER.
+	DCGM_FR_CLOCK_THROTTLE_POWER HealthCheckErrorCode = DCGM_FR_CLOCKS_EVENT_POWER
+	// DCGM_FR_THROTTLING_VIOLATION is deprecated; use DCGM_FR_CLOCKS_EVENT_VIOLATION.
+	DCGM_FR_THROTTLING_VIOLATION HealthCheckErrorCode = DCGM_FR_CLOCKS_EVENT_VIOLATION
+)
+
+// healthCheckError is the static description of a health check error code
+type healthCheckError struct {
+	name        string
+	description string
+	meta        ErrorMeta
+}
+
+// healthCheckErrors holds the static metadata of every error code, keyed by code
+var healthCheckErrors = map[HealthCheckErrorCode]healthCheckError{
+	DCGM_FR_OK: {
+		name:        "DCGM_FR_OK",
+		description: "No error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_OK,
+			MessageFormat: "The operation completed successfully.",
+			Suggestion:    "N/A",
+			Severity:      DCGM_ERROR_NONE,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_UNKNOWN: {
+		name:        "DCGM_FR_UNKNOWN",
+		description: "Unknown error code",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNKNOWN,
+			MessageFormat: "Unknown error.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_UNKNOWN,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_UNRECOGNIZED: {
+		name:        "DCGM_FR_UNRECOGNIZED",
+		description: "Unrecognized error code",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNRECOGNIZED,
+			MessageFormat: "Unrecognized error code.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_UNKNOWN,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_PCI_REPLAY_RATE: {
+		name:        "DCGM_FR_PCI_REPLAY_RATE",
+		description: "Unacceptable rate of PCI errors",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCI_REPLAY_RATE,
+			MessageFormat: "Detected more than %u PCIe replays per minute for GPU %u : %d",
+			Suggestion:    "Reconnect PCIe card. Run system side PCIE diagnostic utilities to verify hops off the GPU board. If issue is on the board, run the field diagnostic.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_VOLATILE_DBE_DETECTED: {
+		name:        "DCGM_FR_VOLATILE_DBE_DETECTED",
+		description: "Uncorrectable volatile double bit error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_VOLATILE_DBE_DETECTED,
+			MessageFormat: "Detected %d volatile double-bit ECC error(s) in GPU %u.",
+			Suggestion:    "Drain the GPU and reset it or reboot the node.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_VOLATILE_SBE_DETECTED: {
+		name:        "DCGM_FR_VOLATILE_SBE_DETECTED",
+		description: "Unacceptable rate of volatile single bit errors",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_VOLATILE_SBE_DETECTED,
+			MessageFormat: "More than %u single-bit ECC error(s) detected in GPU %u Volatile SBEs: %lld",
+			Suggestion:    "Monitor - this GPU can still perform workload.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_PENDING_PAGE_RETIREMENTS: {
+		name:        "DCGM_FR_PENDING_PAGE_RETIREMENTS",
+		description: "Pending page retirements detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PENDING_PAGE_RETIREMENTS,
+			MessageFormat: "A pending retired page has been detected in GPU %u.",
+			Suggestion:    "Monitor - this GPU can still perform workload",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_RETIRED_PAGES_LIMIT: {
+		name:        "DCGM_FR_RETIRED_PAGES_LIMIT",
+		description: "Unacceptable total page retirements detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_RETIRED_PAGES_LIMIT,
+			MessageFormat: "%u or more retired pages have been detected in GPU %u. ",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_RETIRED_PAGES_DBE_LIMIT: {
+		name:        "DCGM_FR_RETIRED_PAGES_DBE_LIMIT",
+		description: "Unacceptable total page retirements due to uncorrectable errors",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_RETIRED_PAGES_DBE_LIMIT,
+			MessageFormat: "An excess of %u retired pages due to DBEs have been detected and more than one page has been retired due to DBEs in the past week in GPU %u.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_CORRUPT_INFOROM: {
+		name:        "DCGM_FR_CORRUPT_INFOROM",
+		description: "Corrupt inforom found",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CORRUPT_INFOROM,
+			MessageFormat: "A corrupt InfoROM has been detected in GPU %u.",
+			Suggestion:    "Flash the InfoROM to clear this corruption.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_CLOCKS_EVENT_THERMAL: {
+		name:        "DCGM_FR_CLOCKS_EVENT_THERMAL",
+		description: "Clocks being optimized for thermal performance",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CLOCKS_EVENT_THERMAL,
+			MessageFormat: "Detected clocks event due to thermal violation in GPU %u.",
+			Suggestion:    "Verify that the cooling on this machine is functional, including external, thermal material interface, fans, and any other components.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_THERMAL,
+		},
+	},
+	DCGM_FR_POWER_UNREADABLE: {
+		name:        "DCGM_FR_POWER_UNREADABLE",
+		description: "Cannot get a reading for power from NVML",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_POWER_UNREADABLE,
+			MessageFormat: "Cannot reliably read the power usage for GPU %u.",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_POWER,
+		},
+	},
+	DCGM_FR_CLOCKS_EVENT_POWER: {
+		name:        "DCGM_FR_CLOCKS_EVENT_POWER",
+		description: "Clock being optimized to meet the product's power limit requirements",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CLOCKS_EVENT_POWER,
+			MessageFormat: "Detected clocks event due to power violation in GPU %u.",
+			Suggestion:    "Monitor the power conditions. This GPU can still perform workload.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_POWER,
+		},
+	},
+	DCGM_FR_NVLINK_ERROR_THRESHOLD: {
+		name:        "DCGM_FR_NVLINK_ERROR_THRESHOLD",
+		description: "Unacceptable rate of NVLink errors",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_ERROR_THRESHOLD,
+			MessageFormat: "Detected %ld %s NvLink errors on GPU %u's NVLink which exceeds threshold of %u",
+			Suggestion:    "Monitor the NVLink. It can still perform workload.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_NVLINK_DOWN: {
+		name:        "DCGM_FR_NVLINK_DOWN",
+		description: "NVLink is down",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_DOWN,
+			MessageFormat: "GPU %u's NvLink link %d is currently down",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_NVSWITCH_FATAL_ERROR: {
+		name:        "DCGM_FR_NVSWITCH_FATAL_ERROR",
+		description: "Fatal errors on the NVSwitch",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVSWITCH_FATAL_ERROR,
+			MessageFormat: "Detected fatal errors on NvSwitch %u link %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_NVSWITCH_NON_FATAL_ERROR: {
+		name:        "DCGM_FR_NVSWITCH_NON_FATAL_ERROR",
+		description: "Non-fatal errors on the NVSwitch",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVSWITCH_NON_FATAL_ERROR,
+			MessageFormat: "Detected nonfatal errors on NvSwitch %u link %u",
+			Suggestion:    "Monitor the NVSwitch. It can still perform workload.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_NVSWITCH_DOWN: {
+		name:        "DCGM_FR_NVSWITCH_DOWN",
+		description: "NVSwitch is down - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVSWITCH_DOWN,
+			MessageFormat: "NvSwitch physical ID %u's NvLink port %d is currently down.",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_NO_ACCESS_TO_FILE: {
+		name:        "DCGM_FR_NO_ACCESS_TO_FILE",
+		description: "Cannot access a file",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NO_ACCESS_TO_FILE,
+			MessageFormat: "File %s could not be accessed directly: %s",
+			Suggestion:    "Check relevant permissions, access, and existence of the file.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_NVML_API: {
+		name:        "DCGM_FR_NVML_API",
+		description: "Error occurred on an NVML API - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVML_API,
+			MessageFormat: "Error calling NVML API %s: %s",
+			Suggestion:    "Check the error condition and ensure that appropriate libraries are present and accessible.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_LIBRARY,
+		},
+	},
+	DCGM_FR_DEVICE_COUNT_MISMATCH: {
+		name:        "DCGM_FR_DEVICE_COUNT_MISMATCH",
+		description: "Disagreement in GPU count between /dev and NVML",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DEVICE_COUNT_MISMATCH,
+			MessageFormat: "The number of devices NVML returns is different than the number of devices in /dev.",
+			Suggestion:    "Check for the presence of cgroups, operating system blocks, and or unsupported / older cards",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_BAD_PARAMETER: {
+		name:        "DCGM_FR_BAD_PARAMETER",
+		description: "Bad parameter passed to API",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BAD_PARAMETER,
+			MessageFormat: "Bad parameter to function %s cannot be processed",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_CANNOT_OPEN_LIB: {
+		name:        "DCGM_FR_CANNOT_OPEN_LIB",
+		description: "Cannot open a library that must be accessed",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CANNOT_OPEN_LIB,
+			MessageFormat: "Cannot open library %s: '%s'",
+			Suggestion:    "Check for the existence of the library and set LD_LIBRARY_PATH if needed.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_LIBRARY,
+		},
+	},
+	DCGM_FR_DENYLISTED_DRIVER: {
+		name:        "DCGM_FR_DENYLISTED_DRIVER",
+		description: "A driver on the denylist (nouveau) is active",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DENYLISTED_DRIVER,
+			MessageFormat: "Found driver on the denylist: %s",
+			Suggestion:    "Please load the appropriate driver.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_NVML_LIB_BAD: {
+		name:        "DCGM_FR_NVML_LIB_BAD",
+		description: "NVML library is missing expected functions - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVML_LIB_BAD,
+			MessageFormat: "Cannot get pointer to %s from libnvidia-ml.so",
+			Suggestion:    "Make sure that the required version of libnvidia-ml.so is present and accessible on the system.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_LIBRARY,
+		},
+	},
+	DCGM_FR_GRAPHICS_PROCESSES: {
+		name:        "DCGM_FR_GRAPHICS_PROCESSES",
+		description: "Graphics processes are active on this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GRAPHICS_PROCESSES,
+			MessageFormat: "NVVS has detected processes with graphics contexts open running on at least one GPU. This may cause some tests to fail.",
+			Suggestion:    "Stop the graphics processes or run this diagnostic on a server that is not being used for display purposes.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_HOSTENGINE_CONN: {
+		name:        "DCGM_FR_HOSTENGINE_CONN",
+		description: "Bad connection to nv-hostengine - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_HOSTENGINE_CONN,
+			MessageFormat: "Could not connect to the host engine: '%s'",
+			Suggestion:    "If hostengine is run separately, please ensure that it is up and responsive.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_FIELD_QUERY: {
+		name:        "DCGM_FR_FIELD_QUERY",
+		description: "Error querying a field from DCGM",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_QUERY,
+			MessageFormat: "Could not query field %s for GPU %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_BAD_CUDA_ENV: {
+		name:        "DCGM_FR_BAD_CUDA_ENV",
+		description: "The environment has variables that hurt CUDA",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BAD_CUDA_ENV,
+			MessageFormat: "Found CUDA performance-limiting environment variable '%s'.",
+			Suggestion:    "Please unset this environment variable to address test failures.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_PERSISTENCE_MODE: {
+		name:        "DCGM_FR_PERSISTENCE_MODE",
+		description: "Persistence mode is disabled",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PERSISTENCE_MODE,
+			MessageFormat: "Persistence mode for GPU %u is disabled.",
+			Suggestion:    "Enable persistence mode by running \"nvidia-smi -i <gpuId> -pm 1 \" as root.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_LOW_BANDWIDTH: {
+		name:        "DCGM_FR_LOW_BANDWIDTH",
+		description: "The bandwidth is unacceptably low",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_LOW_BANDWIDTH,
+			MessageFormat: "Bandwidth of GPU %u in direction %s of %.2f did not exceed minimum required bandwidth of %.2f.",
+			Suggestion:    "Verify that your minimum bandwidth setting is appropriate for the topology of each GPU. If so, and errors are consistent, please run a field diagnostic.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_HIGH_LATENCY: {
+		name:        "DCGM_FR_HIGH_LATENCY",
+		description: "Latency is too high",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_HIGH_LATENCY,
+			MessageFormat: "Latency type %s of GPU %u value %.2f exceeded maximum allowed latency of %.2f.",
+			Suggestion:    "Verify that your maximum latency setting is appropriate for the topology of each GPU. If so, and errors are consistent, please run a field diagnostic.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_CANNOT_GET_FIELD_TAG: {
+		name:        "DCGM_FR_CANNOT_GET_FIELD_TAG",
+		description: "Cannot find a tag for a field",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CANNOT_GET_FIELD_TAG,
+			MessageFormat: "Unable to get field information for field id %hu",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_FIELD_VIOLATION: {
+		name:        "DCGM_FR_FIELD_VIOLATION",
+		description: "The value for the specified error field is above 0",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_VIOLATION,
+		},
+	},
+	DCGM_FR_FIELD_THRESHOLD: {
+		name:        "DCGM_FR_FIELD_THRESHOLD",
+		description: "The value for the specified field is above the threshold",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_THRESHOLD,
+			MessageFormat: "Detected %ld %s for GPU %u which is above the threshold %ld",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_FIELD_VIOLATION_DBL: {
+		name:        "DCGM_FR_FIELD_VIOLATION_DBL",
+		description: "The value for the specified error field is above 0",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_VIOLATION_DBL,
+			MessageFormat: "Detected %.1f %s for GPU %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_VIOLATION,
+		},
+	},
+	DCGM_FR_FIELD_THRESHOLD_DBL: {
+		name:        "DCGM_FR_FIELD_THRESHOLD_DBL",
+		description: "The value for the specified field is above the threshold",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_THRESHOLD_DBL,
+			MessageFormat: "Detected %.1f %s for %s:%u which is above the threshold %.1f",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_UNSUPPORTED_FIELD_TYPE: {
+		name:        "DCGM_FR_UNSUPPORTED_FIELD_TYPE",
+		description: "Field type cannot be supported",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNSUPPORTED_FIELD_TYPE,
+			MessageFormat: "Field %s is not supported by this API because it is neither an int64 nor a double type.",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_FIELD_THRESHOLD_TS: {
+		name:        "DCGM_FR_FIELD_THRESHOLD_TS",
+		description: "The value for the specified field is above the threshold",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_THRESHOLD_TS,
+			MessageFormat: "%s met or exceeded the threshold of %lu per second: %lu at %.1f seconds into the test.",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_FIELD_THRESHOLD_TS_DBL: {
+		name:        "DCGM_FR_FIELD_THRESHOLD_TS_DBL",
+		description: "The value for the specified field is above the threshold",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FIELD_THRESHOLD_TS_DBL,
+			MessageFormat: "%s met or exceeded the threshold of %.1f per second: %.1f at %.1f seconds into the test.",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_THERMAL_VIOLATIONS: {
+		name:        "DCGM_FR_THERMAL_VIOLATIONS",
+		description: "Thermal violations detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_THERMAL_VIOLATIONS,
+			MessageFormat: "There were thermal violations totaling %.1f seconds for GPU %u",
+			Suggestion:    "Verify that the cooling on this machine is functional, including external, thermal material interface, fans, and any other components.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_THERMAL,
+		},
+	},
+	DCGM_FR_THERMAL_VIOLATIONS_TS: {
+		name:        "DCGM_FR_THERMAL_VIOLATIONS_TS",
+		description: "Thermal violations detected with a timestamp",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_THERMAL_VIOLATIONS_TS,
+			MessageFormat: "Thermal violations totaling %.1f seconds started at %.1f seconds into the test for GPU %u",
+			Suggestion:    "Verify that the cooling on this machine is functional, including external, thermal material interface, fans, and any other components.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_THERMAL,
+		},
+	},
+	DCGM_FR_TEMP_VIOLATION: {
+		name:        "DCGM_FR_TEMP_VIOLATION",
+		description: "Temperature is too high",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_TEMP_VIOLATION,
+			MessageFormat: "Temperature %lld of %s %u exceeded user-specified maximum allowed temperature %lld",
+			Suggestion:    "Verify that the user-specified temperature maximum is set correctly. If it is, check the cooling for this GPU and node: Verify that the cooling on this machine is functional, including external, thermal material interface, fans, and any other components.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_THERMAL,
+		},
+	},
+	DCGM_FR_CLOCKS_EVENT_VIOLATION: {
+		name:        "DCGM_FR_CLOCKS_EVENT_VIOLATION",
+		description: "Non-benign clocks event is occurring",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CLOCKS_EVENT_VIOLATION,
+			MessageFormat: "Clocks event for GPU %u because of clocks event starting %.1f seconds into the test. %s",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_VIOLATION,
+		},
+	},
+	DCGM_FR_INTERNAL: {
+		name:        "DCGM_FR_INTERNAL",
+		description: "An internal error was detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_INTERNAL,
+			MessageFormat: "There was an internal error during the test: '%s'",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_PCIE_GENERATION: {
+		name:        "DCGM_FR_PCIE_GENERATION",
+		description: "PCIe generation is too low",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCIE_GENERATION,
+			MessageFormat: "GPU %u is running at PCI link generation %d, which is below the minimum allowed link generation of %d (parameter '%s')",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_PCIE_WIDTH: {
+		name:        "DCGM_FR_PCIE_WIDTH",
+		description: "PCIe width is too low",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCIE_WIDTH,
+			MessageFormat: "GPU %u is running at PCI link width %dX, which is below the minimum allowed link generation of %d (parameter '%s')",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_ABORTED: {
+		name:        "DCGM_FR_ABORTED",
+		description: "Test was aborted by a user signal",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ABORTED,
+			MessageFormat: "Test was aborted early due to user signal",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_NONE,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_TEST_DISABLED: {
+		name:        "DCGM_FR_TEST_DISABLED",
+		description: "This test is disabled for this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_TEST_DISABLED,
+			MessageFormat: "The %s test is skipped.",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_CANNOT_GET_STAT: {
+		name:        "DCGM_FR_CANNOT_GET_STAT",
+		description: "Cannot get telemetry for a needed value",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CANNOT_GET_STAT,
+			MessageFormat: "Unable to generate / collect stat %s for GPU %u",
+			Suggestion:    "If running a standalone nv-hostengine, verify that it is up and responsive.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_STRESS_LEVEL: {
+		name:        "DCGM_FR_STRESS_LEVEL",
+		description: "Stress level is too low (bad performance)",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_STRESS_LEVEL,
+			MessageFormat: "Max stress level of %.1f did not reach desired stress level of %.1f for GPU %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_CUDA_API: {
+		name:        "DCGM_FR_CUDA_API",
+		description: "Error calling the specified CUDA API",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_API,
+			MessageFormat: "Error using CUDA API %s",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_CUDA,
+		},
+	},
+	DCGM_FR_FAULTY_MEMORY: {
+		name:        "DCGM_FR_FAULTY_MEMORY",
+		description: "Faulty memory detected on this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FAULTY_MEMORY,
+			MessageFormat: "Found %lld faulty memory elements on GPU %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_CANNOT_SET_WATCHES: {
+		name:        "DCGM_FR_CANNOT_SET_WATCHES",
+		description: "Unable to set field watches in DCGM - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CANNOT_SET_WATCHES,
+			MessageFormat: "Unable to add field watches to DCGM: %s",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_CUDA_UNBOUND: {
+		name:        "DCGM_FR_CUDA_UNBOUND",
+		description: "CUDA context is no longer bound",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_UNBOUND,
+			MessageFormat: "Cuda GPU %d is no longer bound to a CUDA context...Aborting",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_CUDA,
+		},
+	},
+	DCGM_FR_ECC_DISABLED: {
+		name:        "DCGM_FR_ECC_DISABLED",
+		description: "ECC memory is disabled right now",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ECC_DISABLED,
+			MessageFormat: "Skipping test %s because ECC is not enabled on GPU %u",
+			Suggestion:    "Enable ECC memory by running \"nvidia-smi -i <gpuId> -e 1\" to enable. This may require a GPU reset or reboot to take effect.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_MEMORY_ALLOC: {
+		name:        "DCGM_FR_MEMORY_ALLOC",
+		description: "Cannot allocate memory on the GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_MEMORY_ALLOC,
+			MessageFormat: "Couldn't allocate at least %.1f%% of GPU memory on GPU %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_CUDA,
+		},
+	},
+	DCGM_FR_CUDA_DBE: {
+		name:        "DCGM_FR_CUDA_DBE",
+		description: "CUDA detected unrecovable double-bit error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_DBE,
+			MessageFormat: "CUDA APIs have indicated that a double-bit ECC error has occured on GPU %u.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_MEMORY_MISMATCH: {
+		name:        "DCGM_FR_MEMORY_MISMATCH",
+		description: "Memory error detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_MEMORY_MISMATCH,
+			MessageFormat: "A memory mismatch was detected on GPU %u, but no error was reported by CUDA or NVML.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_CUDA_DEVICE: {
+		name:        "DCGM_FR_CUDA_DEVICE",
+		description: "No CUDA device discoverable for existing GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_DEVICE,
+			MessageFormat: "Unable to find a corresponding CUDA device for GPU %u: '%s'",
+			Suggestion:    "Make sure CUDA_VISIBLE_DEVICES is not preventing visibility of this GPU. Also check if CUDA libraries are compatible and correctly installed.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CUDA,
+		},
+	},
+	DCGM_FR_ECC_UNSUPPORTED: {
+		name:        "DCGM_FR_ECC_UNSUPPORTED",
+		description: "ECC memory is unsupported by this SKU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ECC_UNSUPPORTED,
+			MessageFormat: "ECC Memory is not turned on or is unsupported. Skipping test.",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_ECC_PENDING: {
+		name:        "DCGM_FR_ECC_PENDING",
+		description: "ECC memory is in a pending state - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ECC_PENDING,
+			MessageFormat: "ECC memory for GPU %u is in a pending state.",
+			Suggestion:    "Reboot to complete activation of the ECC memory.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_MEMORY_BANDWIDTH: {
+		name:        "DCGM_FR_MEMORY_BANDWIDTH",
+		description: "Memory bandwidth is too low",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_MEMORY_BANDWIDTH,
+			MessageFormat: "GPU %u only achieved a memory bandwidth of %.2f GB/s, failing to meet %.2f GB/s for test %d",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_TARGET_POWER: {
+		name:        "DCGM_FR_TARGET_POWER",
+		description: "Cannot hit the target power draw",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_TARGET_POWER,
+			MessageFormat: "Max power of %.1f did not reach desired power minimum %s of %.1f for GPU %u",
+			Suggestion:    "Verify that the clock speeds and GPU utilization are high.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_POWER,
+		},
+	},
+	DCGM_FR_API_FAIL: {
+		name:        "DCGM_FR_API_FAIL",
+		description: "The specified API call failed",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_API_FAIL,
+			MessageFormat: "API call %s failed: '%s'",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_LIBRARY,
+		},
+	},
+	DCGM_FR_API_FAIL_GPU: {
+		name:        "DCGM_FR_API_FAIL_GPU",
+		description: "The specified API call failed for the specified GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_API_FAIL_GPU,
+			MessageFormat: "API call %s failed for GPU %u: '%s'",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_LIBRARY,
+		},
+	},
+	DCGM_FR_CUDA_CONTEXT: {
+		name:        "DCGM_FR_CUDA_CONTEXT",
+		description: "Cannot create a CUDA context on this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_CONTEXT,
+			MessageFormat: "GPU %u failed to create a CUDA context: %s",
+			Suggestion:    "Please make sure the correct driver version is installed and verify that no conflicting libraries are present.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CUDA,
+		},
+	},
+	DCGM_FR_DCGM_API: {
+		name:        "DCGM_FR_DCGM_API",
+		description: "DCGM API failure",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DCGM_API,
+			MessageFormat: "Error using DCGM API %s",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_CONCURRENT_GPUS: {
+		name:        "DCGM_FR_CONCURRENT_GPUS",
+		description: "Need multiple GPUs to run this test",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CONCURRENT_GPUS,
+			MessageFormat: "Unable to run concurrent pair bandwidth test without 2 or more gpus. Skipping",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_TOO_MANY_ERRORS: {
+		name:        "DCGM_FR_TOO_MANY_ERRORS",
+		description: "More errors than fit in the return struct - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_TOO_MANY_ERRORS,
+			MessageFormat: "This API can only return up to four errors per system. Additional errors were found for this system that couldn't be communicated.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_UNKNOWN,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD: {
+		name:        "DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD",
+		description: "More than 100 CRC errors are happening per second",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_CRC_ERROR_THRESHOLD,
+			MessageFormat: "%.1f %s NvLink errors found occuring per second on GPU %u, exceeding the limit of 100 per second.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_NVLINK_ERROR_CRITICAL: {
+		name:        "DCGM_FR_NVLINK_ERROR_CRITICAL",
+		description: "NVLink error for a field that should always be 0",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_ERROR_CRITICAL,
+			MessageFormat: "Detected %ld %s NvLink errors on GPU %u's NVLink (should be 0)",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_ENFORCED_POWER_LIMIT: {
+		name:        "DCGM_FR_ENFORCED_POWER_LIMIT",
+		description: "The enforced power limit is too low to hit the target",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ENFORCED_POWER_LIMIT,
+			MessageFormat: "Enforced power limit on GPU %u set to %.1f, which is too low to attempt to achieve target power %.1f",
+			Suggestion:    "If this enforced power limit is necessary, then this test cannot be run. If it is unnecessary, then raise the enforced power limit setting to be able to run this test.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_POWER,
+		},
+	},
+	DCGM_FR_MEMORY_ALLOC_HOST: {
+		name:        "DCGM_FR_MEMORY_ALLOC_HOST",
+		description: "Cannot allocate memory on the host",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_MEMORY_ALLOC_HOST,
+			MessageFormat: "Cannot allocate %zu bytes on the host",
+			Suggestion:    "Manually kill processes or restart your machine.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_OTHER,
+		},
+	},
+	DCGM_FR_GPU_OP_MODE: {
+		name:        "DCGM_FR_GPU_OP_MODE",
+		description: "Bad GPU operating mode for running plugin - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_OP_MODE,
+			MessageFormat: "Skipping plugin due to a GPU being in GPU Operating Mode: LOW_DP.",
+			Suggestion:    "Fix by running nvidia-smi as root with: nvidia-smi --gom=0 -i <gpu index>",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_NO_MEMORY_CLOCKS: {
+		name:        "DCGM_FR_NO_MEMORY_CLOCKS",
+		description: "No memory clocks with the needed MHz found - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NO_MEMORY_CLOCKS,
+			MessageFormat: "No memory clocks <= %u MHZ were found in %u supported memory clocks.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_NO_GRAPHICS_CLOCKS: {
+		name:        "DCGM_FR_NO_GRAPHICS_CLOCKS",
+		description: "No graphics clocks with the needed MHz found - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NO_GRAPHICS_CLOCKS,
+			MessageFormat: "No graphics clocks <= %u MHZ were found in %u supported graphics clocks for memory clock %u MHZ.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_HAD_TO_RESTORE_STATE: {
+		name:        "DCGM_FR_HAD_TO_RESTORE_STATE",
+		description: "Note that we had to restore a GPU's state",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_HAD_TO_RESTORE_STATE,
+			MessageFormat: "Had to restore GPU state on NVML GPU(s): %s",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_OTHER,
+		},
+	},
+	DCGM_FR_L1TAG_UNSUPPORTED: {
+		name:        "DCGM_FR_L1TAG_UNSUPPORTED",
+		description: "L1TAG test is unsupported by this SKU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_L1TAG_UNSUPPORTED,
+			MessageFormat: "This card does not support the L1 cache test. Skipping test.",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_L1TAG_MISCOMPARE: {
+		name:        "DCGM_FR_L1TAG_MISCOMPARE",
+		description: "L1TAG test failed on a miscompare",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_L1TAG_MISCOMPARE,
+			MessageFormat: "Detected a miscompare failure in the L1 cache.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_ROW_REMAP_FAILURE: {
+		name:        "DCGM_FR_ROW_REMAP_FAILURE",
+		description: "Row remapping failed (Ampere or newer GPUs)",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_ROW_REMAP_FAILURE,
+			MessageFormat: "GPU %u had uncorrectable memory errors and row remapping failed.",
+			Suggestion:    "Row remapping failure indicates unrecoverable memory hardware damage. Reset the GPU or reboot the node immediately.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_UNCONTAINED_ERROR: {
+		name:        "DCGM_FR_UNCONTAINED_ERROR",
+		description: "Uncontained error - XID 95",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNCONTAINED_ERROR,
+			MessageFormat: "GPU had an uncontained error (XID 95)",
+			Suggestion:    "Drain the GPU and reset it or reboot the node.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_SOFTWARE_XID,
+		},
+	},
+	DCGM_FR_EMPTY_GPU_LIST: {
+		name:        "DCGM_FR_EMPTY_GPU_LIST",
+		description: "No GPU information given to plugin",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EMPTY_GPU_LIST,
+			MessageFormat: "No valid GPUs passed to plugin",
+			Suggestion:    "Check DCGM and system configuration. This error may be eliminated with an updated configuration.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS: {
+		name:        "DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS",
+		description: "Pending page retirements due to a DBE",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DBE_PENDING_PAGE_RETIREMENTS,
+			MessageFormat: "Pending page retirements together with a DBE were detected on GPU %u.",
+			Suggestion:    "Drain the GPU and reset it or reboot the node to resolve this issue.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_UNCORRECTABLE_ROW_REMAP: {
+		name:        "DCGM_FR_UNCORRECTABLE_ROW_REMAP",
+		description: "Uncorrectable row remapping",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNCORRECTABLE_ROW_REMAP,
+			MessageFormat: "GPU %u had uncorrectable memory errors and %u rows were remapped",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_PENDING_ROW_REMAP: {
+		name:        "DCGM_FR_PENDING_ROW_REMAP",
+		description: "Row remapping is pending",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PENDING_ROW_REMAP,
+			MessageFormat: "GPU %u had memory errors and row remappings are pending",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_MEMORY_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_MEMORY_DEVICE",
+		description: "P2P copy test detected an error writing to this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_MEMORY_DEVICE,
+			MessageFormat: "GPU %u was unsuccessfully written to by GPU %u in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_WRITER_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_WRITER_DEVICE",
+		description: "P2P copy test detected an error writing from this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_WRITER_DEVICE,
+			MessageFormat: "GPU %u unsuccessfully wrote data to GPU %u in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_NVSWITCH_NVLINK_DOWN: {
+		name:        "DCGM_FR_NVSWITCH_NVLINK_DOWN",
+		description: "An NvLink is down for the specified NVSwitch - NOT USED: DEPRECATED",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVSWITCH_NVLINK_DOWN,
+			MessageFormat: "NVSwitch %u's NvLink %u is down.",
+			Suggestion:    "Please check fabric manager and initialization logs to figure out why the link is down. You may also need to run a field diagnostic.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_EUD_BINARY_PERMISSIONS: {
+		name:        "DCGM_FR_EUD_BINARY_PERMISSIONS",
+		description: "EUD binary permissions are incorrect",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_BINARY_PERMISSIONS,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_NON_ROOT_USER: {
+		name:        "DCGM_FR_EUD_NON_ROOT_USER",
+		description: "EUD plugin is not running as root",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_NON_ROOT_USER,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_SPAWN_FAILURE: {
+		name:        "DCGM_FR_EUD_SPAWN_FAILURE",
+		description: "EUD plugin failed to spawn the EUD binary",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_SPAWN_FAILURE,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_TIMEOUT: {
+		name:        "DCGM_FR_EUD_TIMEOUT",
+		description: "EUD plugin timed out",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_TIMEOUT,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_ZOMBIE: {
+		name:        "DCGM_FR_EUD_ZOMBIE",
+		description: "EUD process remains running after the plugin considers it finished",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_ZOMBIE,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_NON_ZERO_EXIT_CODE: {
+		name:        "DCGM_FR_EUD_NON_ZERO_EXIT_CODE",
+		description: "EUD process exited with a non-zero exit code",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_NON_ZERO_EXIT_CODE,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_EUD_TEST_FAILED: {
+		name:        "DCGM_FR_EUD_TEST_FAILED",
+		description: "EUD test failed",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_EUD_TEST_FAILED,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_EUD,
+		},
+	},
+	DCGM_FR_FILE_CREATE_PERMISSIONS: {
+		name:        "DCGM_FR_FILE_CREATE_PERMISSIONS",
+		description: "We cannot create a file in this directory.",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FILE_CREATE_PERMISSIONS,
+			MessageFormat: "The DCGM Diagnostic does not have permissions to create a file in directory '%s'",
+			Suggestion:    "Please restart the hostengine with parameter --home-dir to specify a different home directory for the diagnostic or change permissions in the current directory to allow the user to write files there.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_PAUSE_RESUME_FAILED: {
+		name:        "DCGM_FR_PAUSE_RESUME_FAILED",
+		description: "Pause/Resume failed",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PAUSE_RESUME_FAILED,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_INTERNAL_OTHER,
+		},
+	},
+	DCGM_FR_PCIE_H_REPLAY_VIOLATION: {
+		name:        "DCGM_FR_PCIE_H_REPLAY_VIOLATION",
+		description: "PCIe test caught correctable errors",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCIE_H_REPLAY_VIOLATION,
+			MessageFormat: "GPU %u host-side PCIe replay violation, see dmesg for more information",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_GPU_EXPECTED_NVLINKS_UP: {
+		name:        "DCGM_FR_GPU_EXPECTED_NVLINKS_UP",
+		description: "Expected nvlinks up per gpu",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_EXPECTED_NVLINKS_UP,
+			MessageFormat: "Only %u NvLinks are up out of the expected %u",
+			Suggestion:    "Ensure Fabric Manager is running. Check system logs, dmesg, and fabric-manager logs for more info.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP: {
+		name:        "DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP",
+		description: "Expected nvlinks up per nvswitch",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVSWITCH_EXPECTED_NVLINKS_UP,
+			MessageFormat: "NvSwitch %u - Only %u NvLinks are up out of the expected %u",
+			Suggestion:    "Ensure Fabric Manager is running. Check system logs, dmesg, and fabric-manager logs for more info.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_XID_ERROR: {
+		name:        "DCGM_FR_XID_ERROR",
+		description: "XID error detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_XID_ERROR,
+			MessageFormat: "Detected XID %u for GPU %u",
+			Suggestion:    "Please consult the documentation for details of this XID.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_SOFTWARE_XID,
+		},
+	},
+	DCGM_FR_SBE_VIOLATION: {
+		name:        "DCGM_FR_SBE_VIOLATION",
+		description: "Single bit error detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_SBE_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_DBE_VIOLATION: {
+		name:        "DCGM_FR_DBE_VIOLATION",
+		description: "Double bit error detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DBE_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_PCIE_REPLAY_VIOLATION: {
+		name:        "DCGM_FR_PCIE_REPLAY_VIOLATION",
+		description: "PCIe replay errors detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCIE_REPLAY_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_SBE_THRESHOLD_VIOLATION: {
+		name:        "DCGM_FR_SBE_THRESHOLD_VIOLATION",
+		description: "SBE threshold violated",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_SBE_THRESHOLD_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u which is above the threshold %ld",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_DBE_THRESHOLD_VIOLATION: {
+		name:        "DCGM_FR_DBE_THRESHOLD_VIOLATION",
+		description: "DBE threshold violated",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_DBE_THRESHOLD_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u which is above the threshold %ld",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION: {
+		name:        "DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION",
+		description: "PCIE replay count violated",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_PCIE_REPLAY_THRESHOLD_VIOLATION,
+			MessageFormat: "Detected %ld %s for GPU %u which is above the threshold %ld",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_CUDA_FM_NOT_INITIALIZED: {
+		name:        "DCGM_FR_CUDA_FM_NOT_INITIALIZED",
+		description: "The fabricmanager is not initialized",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CUDA_FM_NOT_INITIALIZED,
+			MessageFormat: "",
+			Suggestion:    "Ensure that the FabricManager is running without errors.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_SXID_ERROR: {
+		name:        "DCGM_FR_SXID_ERROR",
+		description: "NvSwitch fatal error detected",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_SXID_ERROR,
+			MessageFormat: "Detected fatal NvSwitch SXID %u",
+			Suggestion:    "Check DCGM and system logs for errors. Reset GPU. Restart DCGM. Rerun diagnostics.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_GFLOPS_THRESHOLD_VIOLATION: {
+		name:        "DCGM_FR_GFLOPS_THRESHOLD_VIOLATION",
+		description: "GPU GFLOPs threshold violated",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GFLOPS_THRESHOLD_VIOLATION,
+			MessageFormat: "Detected %.2f %s for GPU %u which is below the threshold %.2f",
+			Suggestion:    "Please verify your user-specified variance tolerance is set appropriately; if so, and if errors are persistent, please run a field diagnostic.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_PERF_THRESHOLD,
+		},
+	},
+	DCGM_FR_NAN_VALUE: {
+		name:        "DCGM_FR_NAN_VALUE",
+		description: "NaN value detected on this GPU",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NAN_VALUE,
+			MessageFormat: "Found %lld NaN-value memory elements on GPU %u",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR: {
+		name:        "DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR",
+		description: "Fabric Manager did not finish training",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FABRIC_MANAGER_TRAINING_ERROR,
+			MessageFormat: "Fabric Manager (Cluster UUID: %s, Clique ID: %ld, Health Mask: %#lx): %s.",
+			Suggestion:    "Ensure that the FabricManager is running without errors.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE",
+		description: "P2P copy test detected an error writing to this GPU over PCIE",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_PCIE_MEMORY_DEVICE,
+			MessageFormat: "GPU %u was unsuccessfully written to by GPU %u over PCIe in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE",
+		description: "P2P copy test detected an error writing from this GPU over PCIE",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_PCIE_WRITER_DEVICE,
+			MessageFormat: "GPU %u unsuccessfully wrote data to GPU %u over PCIe in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE",
+		description: "P2P copy test detected an error writing to this GPU over NVLink",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_NVLINK_MEMORY_DEVICE,
+			MessageFormat: "GPU %u was unsuccessfully written to by GPU %u over NVLink in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE: {
+		name:        "DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE",
+		description: "P2P copy test detected an error writing from this GPU over NVLink",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BROKEN_P2P_NVLINK_WRITER_DEVICE,
+			MessageFormat: "GPU %u unsuccessfully wrote data to GPU %u over NVLink in a peer-to-peer test: %s",
+			Suggestion:    "Please capture an nvidia-bug-report and send it to NVIDIA.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_TEST_SKIPPED: {
+		name:        "DCGM_FR_TEST_SKIPPED",
+		description: "Indicates that the test was skipped",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_TEST_SKIPPED,
+			MessageFormat: "Test %s was skipped.",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_NONE,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_SRAM_THRESHOLD: {
+		name:        "DCGM_FR_SRAM_THRESHOLD",
+		description: "indicates SRAM Threshold Count exceeded",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_SRAM_THRESHOLD,
+			MessageFormat: "SRAM Threshold Count exceeded on GPU %d: %ld",
+			Suggestion:    "Check memory",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD: {
+		name:        "DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD",
+		description: "indicates effective BER threshold exceeded",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_EFFECTIVE_BER_THRESHOLD,
+			MessageFormat: "Detected effective BER %.2e exceeds minimum threshold on GPU %u's NVLink.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_FALLEN_OFF_BUS: {
+		name:        "DCGM_FR_FALLEN_OFF_BUS",
+		description: "GPU has fallen off the bus",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FALLEN_OFF_BUS,
+			MessageFormat: "GPU %d has fallen off the bus",
+			Suggestion:    "Please re-seat the GPU, check for thermal and power issues, and verify that there is no outstanding bug against your driver or BIOS versions. If the issue persists, please run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_HARDWARE_PCIE,
+		},
+	},
+	DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD: {
+		name:        "DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD",
+		description: "indicates symbol BER threshold exceeded",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NVLINK_SYMBOL_BER_THRESHOLD,
+			MessageFormat: "Detected symbol BER %.2e exceeds minimum threshold on GPU %u's NVLink.",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_NVLINK,
+		},
+	},
+	DCGM_FR_IMEX_UNHEALTHY: {
+		name:        "DCGM_FR_IMEX_UNHEALTHY",
+		description: "IMEX domain or daemon status is unhealthy",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_IMEX_UNHEALTHY,
+			MessageFormat: "IMEX %s status is %s (%s)",
+			Suggestion:    "Check IMEX installation, configuration, domain and daemon status, and network connectivity.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_FABRIC_PROBE_STATE: {
+		name:        "DCGM_FR_FABRIC_PROBE_STATE",
+		description: "Fabric probe state error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_FABRIC_PROBE_STATE,
+			MessageFormat: "GPU %u: Fabric State is %s (%lld).",
+			Suggestion:    "Ensure that the FabricManager is running without errors.",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_HARDWARE_NVSWITCH,
+		},
+	},
+	DCGM_FR_BINARY_PERMISSIONS: {
+		name:        "DCGM_FR_BINARY_PERMISSIONS",
+		description: "Binary permissions are incorrect",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_BINARY_PERMISSIONS,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_CONFIG,
+			Category:      DCGM_FR_EC_SOFTWARE_CONFIG,
+		},
+	},
+	DCGM_FR_GPU_RECOVERY_RESET: {
+		name:        "DCGM_FR_GPU_RECOVERY_RESET",
+		description: "GPU requires reset to recover from a fault",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_RECOVERY_RESET,
+			MessageFormat: "GPU %u requires a reset to recover from a fault. Recovery action: %ld (GPU_RESET).",
+			Suggestion:    "Terminate all GPU processes and reset the GPU.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_GPU_RECOVERY_REBOOT: {
+		name:        "DCGM_FR_GPU_RECOVERY_REBOOT",
+		description: "Node requires reboot due to GPU fault",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_RECOVERY_REBOOT,
+			MessageFormat: "GPU %u fault may have left the OS in an inconsistent state. Recovery action: %ld (NODE_REBOOT).",
+			Suggestion:    "Reboot the operating system to restore a consistent state.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_GPU_RECOVERY_DRAIN_P2P: {
+		name:        "DCGM_FR_GPU_RECOVERY_DRAIN_P2P",
+		description: "Peer-to-peer traffic must be drained",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_RECOVERY_DRAIN_P2P,
+			MessageFormat: "GPU %u requires peer-to-peer traffic to be quiesced. Recovery action: %ld (DRAIN_P2P).",
+			Suggestion:    "Terminate GPU processes conducting peer-to-peer traffic and disable UVM persistence mode. Check GPU health status again after draining.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_GPU_RECOVERY_DRAIN_RESET: {
+		name:        "DCGM_FR_GPU_RECOVERY_DRAIN_RESET",
+		description: "GPU operating at reduced capacity, drain and reset required",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_GPU_RECOVERY_DRAIN_RESET,
+			MessageFormat: "GPU %u operating at reduced capacity due to a fault. Recovery action: %ld (DRAIN_AND_RESET).",
+			Suggestion:    "Do not schedule new work on this GPU. Reset the GPU after existing work has drained.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_HARDWARE_OTHER,
+		},
+	},
+	DCGM_FR_NCCL_ERROR: {
+		name:        "DCGM_FR_NCCL_ERROR",
+		description: "Detected a NCCL error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_NCCL_ERROR,
+			MessageFormat: "Detected NCCL error: %s Recovery action: %ld (DRAIN_AND_RESET).",
+			Suggestion:    "Attempt to reset the GPUs and reboot the machines if that fails.",
+			Severity:      DCGM_ERROR_RESET,
+			Category:      DCGM_FR_EC_SOFTWARE_OTHER,
+		},
+	},
+	DCGM_FR_RETEST_REQUESTED: {
+		name:        "DCGM_FR_RETEST_REQUESTED",
+		description: "Retest requested before providing results",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_RETEST_REQUESTED,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_NONE,
+			Category:      DCGM_FR_EC_NONE,
+		},
+	},
+	DCGM_FR_CONTAINED_ERROR: {
+		name:        "DCGM_FR_CONTAINED_ERROR",
+		description: "GPU contained error",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CONTAINED_ERROR,
+			MessageFormat: "GPU had a contained error.",
+			Suggestion:    "Restart the application that encountered the error. Other applications on the GPU can continue running. GPU reset can be deferred until a convenient time.",
+			Severity:      DCGM_ERROR_MONITOR,
+			Category:      DCGM_FR_EC_SOFTWARE_XID,
+		},
+	},
+	DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT: {
+		name:        "DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT",
+		description: "Uncorrectable row remap threshold exceeded",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_UNCORRECTABLE_ROW_REMAP_LIMIT,
+			MessageFormat: "GPU %u exceeded uncorrectable row remap limit with %u remapped rows",
+			Suggestion:    "Run a field diagnostic on the GPU.",
+			Severity:      DCGM_ERROR_TRIAGE,
+			Category:      DCGM_FR_EC_HARDWARE_MEMORY,
+		},
+	},
+	DCGM_FR_CPU_SDC_TEST_FAILED: {
+		name:        "DCGM_FR_CPU_SDC_TEST_FAILED",
+		description: "SDC test failed",
+		meta: ErrorMeta{
+			ErrorID:       DCGM_FR_CPU_SDC_TEST_FAILED,
+			MessageFormat: "",
+			Suggestion:    "",
+			Severity:      DCGM_ERROR_ISOLATE,
+			Category:      DCGM_FR_EC_SOFTWARE_CPU_SDC,
+		},
+	},
+}
+
+// String returns the DCGM_FR_* name of the error code
+func (c HealthCheckErrorCode) String() string {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.name
+	}
+	if c == DCGM_FR_ERROR_SENTINEL {
+		return "DCGM_FR_ERROR_SENTINEL"
+	}
+	return "HealthCheckErrorCode(" + strconv.FormatUint(uint64(c), 10) + ")"
+}
+
+// Description returns the one-line description of the error code from dcgm_errors.h
+func (c HealthCheckErrorCode) Description() string {
+	return healthCheckErrors[c].description
+}
+
+// MessageFormat returns the printf-style format DCGM uses to report the error
+func (c HealthCheckErrorCode) MessageFormat() string {
+	return healthCheckErrors[c].meta.MessageFormat
+}
+
+// Suggestion returns the suggested next step for resolving the error
+func (c HealthCheckErrorCode) Suggestion() string {
+	return healthCheckErrors[c].meta.Suggestion
+}
+
+// Severity returns the action required for the error, or DCGM_ERROR_UNKNOWN for an unknown code
+func (c HealthCheckErrorCode) Severity() ErrorSeverity {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.meta.Severity
+	}
+	return DCGM_ERROR_UNKNOWN
+}
+
+// Category returns the subsystem of the error, or DCGM_FR_EC_NONE for an unknown code
+func (c HealthCheckErrorCode) Category() ErrorCategory {
+	if e, ok := healthCheckErrors[c]; ok {
+		return e.meta.Category
+	}
+	return DCGM_FR_EC_NONE
+}
+
+// StaticErrorMeta returns the metadata of an error code and whether it is known
+// Unlike GetErrorMeta it does not require libdcgm to be loaded
+func StaticErrorMeta(code HealthCheckErrorCode) (ErrorMeta, bool) {
+	e, ok := healthCheckErrors[code]
+	return e.meta, ok
+}
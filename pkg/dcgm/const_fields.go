@@ -3141,6 +3141,5878 @@ var legacyDCGMFields = map[string]Short{
 	"dcgm_xid_errors":                                              230,
 }
 
+// fieldInfos holds the static metadata of every canonical field, keyed by ID
+var fieldInfos = map[Short]FieldInfo{
+	1: {
+		ID:          1,
+		Name:        "DCGM_FI_SYSTEM_DRIVER_VERSION",
+		Description: "Driver Version",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	2: {
+		ID:          2,
+		Name:        "DCGM_FI_SYSTEM_NVML_VERSION",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	3: {
+		ID:          3,
+		Name:        "DCGM_FI_SYSTEM_PROCESS_NAME",
+		Description: "Process Name",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	4: {
+		ID:          4,
+		Name:        "DCGM_FI_SYSTEM_GPU_QUANTITY",
+		Description: "Number of Devices on the node",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindGauge,
+	},
+	5: {
+		ID:          5,
+		Name:        "DCGM_FI_CUDA_DRIVER_VERSION",
+		Description: "Cuda Driver Version Retrieves a number with the major value in the thousands place and the minor value in the hundreds place. CUDA 11.1 = 11100",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindGauge,
+	},
+	6: {
+		ID:          6,
+		Name:        "DCGM_FI_SYSTEM_GPU_BIND_EVENT",
+		Description: "GPU bind/unbind event notification Values: SystemReinitializing=1, SystemReinitializationCompleted=2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindGauge,
+	},
+	50: {
+		ID:          50,
+		Name:        "DCGM_FI_DEV_GPU_NAME",
+		Description: "Name of the GPU device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	51: {
+		ID:          51,
+		Name:        "DCGM_FI_DEV_GPU_BRAND",
+		Description: "Device Brand",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	52: {
+		ID:          52,
+		Name:        "DCGM_FI_DEV_NVML_INDEX",
+		Description: "NVML index of this GPU",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	53: {
+		ID:          53,
+		Name:        "DCGM_FI_DEV_BOARD_SERIAL",
+		Description: "Device Serial Number",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	54: {
+		ID:          54,
+		Name:        "DCGM_FI_DEV_GPU_UUID",
+		Description: "UUID corresponding to the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	55: {
+		ID:          55,
+		Name:        "DCGM_FI_DEV_GPU_MINOR_NUMBER",
+		Description: "Device node minor number /dev/nvidia#",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	56: {
+		ID:          56,
+		Name:        "DCGM_FI_DEV_INFOROM_OEM_VERSION",
+		Description: "OEM inforom version",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	57: {
+		ID:          57,
+		Name:        "DCGM_FI_DEV_PCI_BUS_ID",
+		Description: "PCI attributes for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	58: {
+		ID:          58,
+		Name:        "DCGM_FI_DEV_PCI_COMBINED_ID",
+		Description: "The combined 16-bit device id and 16-bit vendor id",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	59: {
+		ID:          59,
+		Name:        "DCGM_FI_DEV_PCI_SUBSYS_ID",
+		Description: "The 32-bit Sub System Device ID",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	60: {
+		ID:          60,
+		Name:        "DCGM_FI_SYSTEM_PCI_TOPOLOGY",
+		Description: "Topology of all GPUs on the system via PCI (static)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	61: {
+		ID:          61,
+		Name:        "DCGM_FI_SYSTEM_NVLINK_TOPOLOGY",
+		Description: "Topology of all GPUs on the system via NVLINK (static)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	62: {
+		ID:          62,
+		Name:        "DCGM_FI_SYSTEM_GPU_AFFINITY",
+		Description: "Affinity of all GPUs on the system (static)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	63: {
+		ID:          63,
+		Name:        "DCGM_FI_CUDA_GPU_COMPUTE_CAPABILITY",
+		Description: "Cuda compute capability for the device. The major version is the upper 32 bits and the minor version is the lower 32 bits.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	64: {
+		ID:          64,
+		Name:        "DCGM_FI_DEV_NVLINK_P2P_STATUS",
+		Description: "A bitmap of the P2P NVLINK status from this GPU to others on this host.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	65: {
+		ID:          65,
+		Name:        "DCGM_FI_DEV_GPU_COMPUTE_MODE",
+		Description: "Compute mode for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	66: {
+		ID:          66,
+		Name:        "DCGM_FI_DEV_GPU_PERSISTENCE_MODE",
+		Description: "Persistence mode for the device Boolean: 0 is disabled, 1 is enabled",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	67: {
+		ID:          67,
+		Name:        "DCGM_FI_DEV_MIG_MODE",
+		Description: "MIG mode for the device Boolean: 0 is disabled, 1 is enabled",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	68: {
+		ID:          68,
+		Name:        "DCGM_FI_CUDA_GPU_VISIBLE_DEVICES",
+		Description: "The string that CUDA_VISIBLE_DEVICES should be set to for this entity (including MIG)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	69: {
+		ID:          69,
+		Name:        "DCGM_FI_DEV_MIG_MAX_SLICES",
+		Description: "The maximum number of MIG slices supported by this GPU",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	70: {
+		ID:          70,
+		Name:        "DCGM_FI_DEV_CPU_AFFINITY_0",
+		Description: "Device CPU affinity. part 1/8 = cpus 0 - 63",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	71: {
+		ID:          71,
+		Name:        "DCGM_FI_DEV_CPU_AFFINITY_1",
+		Description: "Device CPU affinity. part 1/8 = cpus 64 - 127",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	72: {
+		ID:          72,
+		Name:        "DCGM_FI_DEV_CPU_AFFINITY_2",
+		Description: "Device CPU affinity. part 2/8 = cpus 128 - 191",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	73: {
+		ID:          73,
+		Name:        "DCGM_FI_DEV_CPU_AFFINITY_3",
+		Description: "Device CPU affinity. part 3/8 = cpus 192 - 255",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	74: {
+		ID:          74,
+		Name:        "DCGM_FI_DEV_CC_MODE",
+		Description: "ConfidentialCompute/AmpereProtectedMemory status for this system 0 = disabled 1 = enabled",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	75: {
+		ID:          75,
+		Name:        "DCGM_FI_DEV_MIG_ATTRIBUTES",
+		Description: "Attributes for the given MIG device handles",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	76: {
+		ID:          76,
+		Name:        "DCGM_FI_DEV_MIG_GI_INFO",
+		Description: "GPU instance profile information",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	77: {
+		ID:          77,
+		Name:        "DCGM_FI_DEV_MIG_CI_INFO",
+		Description: "Compute instance profile information",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	80: {
+		ID:          80,
+		Name:        "DCGM_FI_DEV_INFOROM_ECC_VERSION",
+		Description: "ECC inforom version",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	81: {
+		ID:          81,
+		Name:        "DCGM_FI_DEV_INFOROM_POWER_VERSION",
+		Description: "Power management object inforom version",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	82: {
+		ID:          82,
+		Name:        "DCGM_FI_DEV_INFOROM_IMAGE_VERSION",
+		Description: "Inforom image version",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	83: {
+		ID:          83,
+		Name:        "DCGM_FI_DEV_INFOROM_CHECKSUM",
+		Description: "Inforom configuration checksum",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	84: {
+		ID:          84,
+		Name:        "DCGM_FI_DEV_INFOROM_VALID",
+		Description: "Reads the infoROM from the flash and verifies the checksums",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	85: {
+		ID:          85,
+		Name:        "DCGM_FI_DEV_VBIOS_VERSION",
+		Description: "VBIOS version of the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	86: {
+		ID:          86,
+		Name:        "DCGM_FI_DEV_MEMORY_AFFINITY_0",
+		Description: "Device Memory node affinity, 0-63",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	87: {
+		ID:          87,
+		Name:        "DCGM_FI_DEV_MEMORY_AFFINITY_1",
+		Description: "Device Memory node affinity, 64-127",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	88: {
+		ID:          88,
+		Name:        "DCGM_FI_DEV_MEMORY_AFFINITY_2",
+		Description: "Device Memory node affinity, 128-191",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	89: {
+		ID:          89,
+		Name:        "DCGM_FI_DEV_MEMORY_AFFINITY_3",
+		Description: "Device Memory node affinity, 192-255",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	90: {
+		ID:          90,
+		Name:        "DCGM_FI_DEV_BAR1_TOTAL",
+		Description: "Total BAR1 of the GPU in MB Deprecated: Use DCGM_FI_DEV_BAR1_CAPACITY_BYTES instead.",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	91: {
+		ID:          91,
+		Name:        "DCGM_FI_SYSTEM_GPU_SYNC_BOOST",
+		Description: "Deprecated - Sync boost settings on the node",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindInfo,
+	},
+	92: {
+		ID:          92,
+		Name:        "DCGM_FI_DEV_BAR1_USED",
+		Description: "Used BAR1 of the GPU in MB Deprecated: Use DCGM_FI_DEV_BAR1_USED_BYTES instead.",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	93: {
+		ID:          93,
+		Name:        "DCGM_FI_DEV_BAR1_FREE",
+		Description: "Free BAR1 of the GPU in MB Deprecated: Use DCGM_FI_DEV_BAR1_FREE_BYTES instead.",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	94: {
+		ID:          94,
+		Name:        "DCGM_FI_DEV_GPM_SUPPORT",
+		Description: "GPM support for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	100: {
+		ID:          100,
+		Name:        "DCGM_FI_DEV_SM_CLOCK",
+		Description: "SM clock for the device Deprecated: Use DCGM_FI_DEV_SM_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	101: {
+		ID:          101,
+		Name:        "DCGM_FI_DEV_MEM_CLOCK",
+		Description: "Memory clock for the device Deprecated: Use DCGM_FI_DEV_MEMORY_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	102: {
+		ID:          102,
+		Name:        "DCGM_FI_DEV_VIDEO_CLOCK",
+		Description: "Video encoder/decoder clock for the device Deprecated: Use DCGM_FI_DEV_VIDEO_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	110: {
+		ID:          110,
+		Name:        "DCGM_FI_DEV_APP_SM_CLOCK",
+		Description: "SM Application clocks Deprecated: Use DCGM_FI_DEV_SM_APP_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	111: {
+		ID:          111,
+		Name:        "DCGM_FI_DEV_APP_MEM_CLOCK",
+		Description: "Memory Application clocks Deprecated: Use DCGM_FI_DEV_MEMORY_APP_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	112: {
+		ID:          112,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASONS",
+		Description: "Current clock event reasons (bitmask of DCGM_CLOCKS_EVENT_REASON_*)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	113: {
+		ID:          113,
+		Name:        "DCGM_FI_DEV_MAX_SM_CLOCK",
+		Description: "Maximum supported SM clock for the device Deprecated: Use DCGM_FI_DEV_SM_MAX_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	114: {
+		ID:          114,
+		Name:        "DCGM_FI_DEV_MAX_MEM_CLOCK",
+		Description: "Maximum supported Memory clock for the device Deprecated: Use DCGM_FI_DEV_MEMORY_MAX_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	115: {
+		ID:          115,
+		Name:        "DCGM_FI_DEV_MAX_VIDEO_CLOCK",
+		Description: "Maximum supported Video encoder/decoder clock for the device Deprecated: Use DCGM_FI_DEV_VIDEO_MAX_CLOCK_HERTZ instead.",
+		Unit:        UnitMegahertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	120: {
+		ID:          120,
+		Name:        "DCGM_FI_DEV_CLOCKS_AUTOBOOST_MODE",
+		Description: "Auto-boost for the device (1 = enabled. 0 = disabled)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	130: {
+		ID:          130,
+		Name:        "DCGM_FI_DEV_CLOCKS_SUPPORTED",
+		Description: "Supported clocks for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	140: {
+		ID:          140,
+		Name:        "DCGM_FI_DEV_MEMORY_TEMP_CELSIUS",
+		Description: "Memory temperature for the device",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	150: {
+		ID:          150,
+		Name:        "DCGM_FI_DEV_GPU_TEMP_CELSIUS",
+		Description: "Current temperature readings for the device, in degrees C",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	151: {
+		ID:          151,
+		Name:        "DCGM_FI_DEV_MEMORY_MAX_OP_TEMP_CELSIUS",
+		Description: "Maximum operating temperature for the memory of this GPU. Above this temperature slowdown will occur.",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	152: {
+		ID:          152,
+		Name:        "DCGM_FI_DEV_GPU_MAX_OP_TEMP_CELSIUS",
+		Description: "Maximum operating temperature for this GPU",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	153: {
+		ID:          153,
+		Name:        "DCGM_FI_DEV_GPU_TEMP_MARGIN_CELSIUS",
+		Description: "Thermal margin temperature (distance to nearest slowdown threshold) for this GPU",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	155: {
+		ID:          155,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_WATTS",
+		Description: "Power usage for the device in Watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	156: {
+		ID:          156,
+		Name:        "DCGM_FI_DEV_TOTAL_ENERGY_CONSUMPTION",
+		Description: "Total energy consumption for the GPU in mJ since the driver was last reloaded Deprecated: Use DCGM_FI_DEV_GPU_ENERGY_JOULES_TOTAL instead.",
+		Unit:        UnitMillijoules,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	157: {
+		ID:          157,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_RAW_WATTS",
+		Description: "Current instantaneous power usage of the device in Watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	158: {
+		ID:          158,
+		Name:        "DCGM_FI_DEV_GPU_TEMP_SLOWDOWN_CELSIUS",
+		Description: "Slowdown temperature for the device",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	159: {
+		ID:          159,
+		Name:        "DCGM_FI_DEV_GPU_TEMP_SHUTDOWN_CELSIUS",
+		Description: "Shutdown temperature for the device",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	160: {
+		ID:          160,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_LIMIT_REQUESTED_WATTS",
+		Description: "Current Power limit for the device",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	161: {
+		ID:          161,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_LIMIT_MIN_WATTS",
+		Description: "Minimum power management limit for the device",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	162: {
+		ID:          162,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_LIMIT_MAX_WATTS",
+		Description: "Maximum power management limit for the device",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	163: {
+		ID:          163,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_LIMIT_DEFAULT_WATTS",
+		Description: "Default power management limit for the device",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	164: {
+		ID:          164,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_LIMIT_ENFORCED_WATTS",
+		Description: "Effective power limit that the driver enforces after taking into account all limiters",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	165: {
+		ID:          165,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_PROFILE_REQUESTED_MASK",
+		Description: "Requested workload power profile mask(Blackwell and newer)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	166: {
+		ID:          166,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_PROFILE_ENFORCED_MASK",
+		Description: "Enforced workload power profile mask(Blackwell and newer)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	167: {
+		ID:          167,
+		Name:        "DCGM_FI_DEV_BOARD_POWER_PROFILE_SUPPORTED_MASK",
+		Description: "Supported workload power profile mask(Blackwell and newer)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	170: {
+		ID:          170,
+		Name:        "DCGM_FI_DEV_FABRIC_MANAGER_STATUS",
+		Description: "The status of the fabric manager - a value from dcgmFabricManagerStatus_t.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	171: {
+		ID:          171,
+		Name:        "DCGM_FI_DEV_FABRIC_MANAGER_ERROR",
+		Description: "The failure that happened while starting the Fabric Manager, if any NOTE: this is not populated unless the fabric manager completed startup",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	172: {
+		ID:          172,
+		Name:        "DCGM_FI_DEV_FABRIC_CLUSTER_UUID",
+		Description: "The uuid of the cluster to which this GPU belongs",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	173: {
+		ID:          173,
+		Name:        "DCGM_FI_DEV_FABRIC_CLIQUE_ID",
+		Description: "The ID of the fabric clique to which this GPU belongs",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	174: {
+		ID:          174,
+		Name:        "DCGM_FI_DEV_FABRIC_HEALTH_MASK",
+		Description: "GPU Fabric health Status Mask. Use DCGM_GPU_FABRIC_HEALTH_TEST macro to check the different health statuses. Use DCGM_GPU_FABRIC_HEALTH_GET macro to get the different health statuses.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	175: {
+		ID:          175,
+		Name:        "DCGM_FI_DEV_FABRIC_HEALTH_SUMMARY",
+		Description: "GPU Fabric health Summary. Represents the overall health of the GPU Fabric. Returns one of: - NVML_GPU_FABRIC_HEALTH_SUMMARY_NOT_SUPPORTED (0) - NVML_GPU_FABRIC_HEALTH_SUMMARY_HEALTHY (1) - NVML_GPU_FABRIC_HEALTH_SUMMARY_UNHEALTHY (2) - NVML_GPU_FABRIC_HEALTH_SUMMARY_LIMITED_CAPACITY (3)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	190: {
+		ID:          190,
+		Name:        "DCGM_FI_DEV_GPU_PSTATE",
+		Description: "Performance state (P-State) 0-15. 0=highest",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	191: {
+		ID:          191,
+		Name:        "DCGM_FI_DEV_FAN_SPEED",
+		Description: "Fan speed for the device in percent 0-100 (may exceed 100) Deprecated: Use DCGM_FI_DEV_FAN_SPEED_RATIO instead.",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	200: {
+		ID:          200,
+		Name:        "DCGM_FI_DEV_PCIE_TX_THROUGHPUT",
+		Description: "PCIe Tx utilization information Deprecated: Use DCGM_FI_PROF_PCIE_TX_BYTES instead.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	201: {
+		ID:          201,
+		Name:        "DCGM_FI_DEV_PCIE_RX_THROUGHPUT",
+		Description: "PCIe Rx utilization information Deprecated: Use DCGM_FI_PROF_PCIE_RX_BYTES instead.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	202: {
+		ID:          202,
+		Name:        "DCGM_FI_DEV_PCIE_REPLAY_TOTAL",
+		Description: "PCIe replay counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	203: {
+		ID:          203,
+		Name:        "DCGM_FI_DEV_GPU_UTIL_RATIO",
+		Description: "GPU Utilization",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	204: {
+		ID:          204,
+		Name:        "DCGM_FI_DEV_MEM_COPY_UTIL",
+		Description: "Memory Utilization",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	205: {
+		ID:          205,
+		Name:        "DCGM_FI_DEV_PROCESS_ACCOUNTING_STATS",
+		Description: "Process accounting stats. This field is only supported when the host engine is running as root unless you enable accounting ahead of time. Accounting mode can be enabled by running \"nvidia-smi -am 1\" as root on the same node the host engine is running on.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	206: {
+		ID:          206,
+		Name:        "DCGM_FI_DEV_ENC_UTIL",
+		Description: "Encoder Utilization",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	207: {
+		ID:          207,
+		Name:        "DCGM_FI_DEV_DEC_UTIL",
+		Description: "Decoder Utilization",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	230: {
+		ID:          230,
+		Name:        "DCGM_FI_DEV_XID_ERROR",
+		Description: "XID errors. The value is the specific XID error",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	235: {
+		ID:          235,
+		Name:        "DCGM_FI_DEV_PCIE_MAX_LINK_GEN",
+		Description: "PCIe Max Link Generation",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	236: {
+		ID:          236,
+		Name:        "DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH",
+		Description: "PCIe Max Link Width",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	237: {
+		ID:          237,
+		Name:        "DCGM_FI_DEV_PCIE_LINK_GEN",
+		Description: "PCIe Current Link Generation",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	238: {
+		ID:          238,
+		Name:        "DCGM_FI_DEV_PCIE_LINK_WIDTH",
+		Description: "PCIe Current Link Width",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	240: {
+		ID:          240,
+		Name:        "DCGM_FI_DEV_POWER_VIOLATION",
+		Description: "Power Violation time in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	241: {
+		ID:          241,
+		Name:        "DCGM_FI_DEV_THERMAL_VIOLATION",
+		Description: "Thermal Violation time in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	242: {
+		ID:          242,
+		Name:        "DCGM_FI_DEV_SYNC_BOOST_VIOLATION",
+		Description: "Sync Boost Violation time in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	243: {
+		ID:          243,
+		Name:        "DCGM_FI_DEV_BOARD_LIMIT_VIOLATION",
+		Description: "Board violation limit.",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	244: {
+		ID:          244,
+		Name:        "DCGM_FI_DEV_LOW_UTIL_VIOLATION",
+		Description: "Low utilisation violation limit.",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	245: {
+		ID:          245,
+		Name:        "DCGM_FI_DEV_RELIABILITY_VIOLATION",
+		Description: "Reliability violation limit.",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	246: {
+		ID:          246,
+		Name:        "DCGM_FI_DEV_TOTAL_APP_CLOCKS_VIOLATION",
+		Description: "App clock violation limit.",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	247: {
+		ID:          247,
+		Name:        "DCGM_FI_DEV_TOTAL_BASE_CLOCKS_VIOLATION",
+		Description: "Base clock violation limit.",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	250: {
+		ID:          250,
+		Name:        "DCGM_FI_DEV_FB_TOTAL",
+		Description: "Total Frame Buffer of the GPU in MB",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	251: {
+		ID:          251,
+		Name:        "DCGM_FI_DEV_FB_FREE",
+		Description: "Free Frame Buffer in MB",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	252: {
+		ID:          252,
+		Name:        "DCGM_FI_DEV_FB_USED",
+		Description: "Used Frame Buffer in MB",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	253: {
+		ID:          253,
+		Name:        "DCGM_FI_DEV_FB_RESERVED",
+		Description: "Reserved Frame Buffer in MB",
+		Unit:        UnitMebibytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	254: {
+		ID:          254,
+		Name:        "DCGM_FI_DEV_FB_USED_RATIO",
+		Description: "Ratio of Frame Buffer used: 'Used/(Total - Reserved)'. Range 0.0-1.0",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	285: {
+		ID:          285,
+		Name:        "DCGM_FI_DEV_C2C_LINK_QUANTITY",
+		Description: "C2C Link Quantity",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	286: {
+		ID:          286,
+		Name:        "DCGM_FI_DEV_C2C_LINK_STATUS",
+		Description: "C2C Link Status The value of 0 the link is INACTIVE. The value of 1 the link is ACTIVE.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	287: {
+		ID:          287,
+		Name:        "DCGM_FI_DEV_C2C_MAX_BANDWIDTH",
+		Description: "C2C Max Bandwidth The value indicates the link speed in MB/s.",
+		Unit:        UnitMebibytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	300: {
+		ID:          300,
+		Name:        "DCGM_FI_DEV_ECC_MODE",
+		Description: "Current ECC mode for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	301: {
+		ID:          301,
+		Name:        "DCGM_FI_DEV_ECC_PENDING",
+		Description: "Pending ECC mode for the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	310: {
+		ID:          310,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_TOTAL",
+		Description: "Total single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	311: {
+		ID:          311,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_TOTAL",
+		Description: "Total double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	312: {
+		ID:          312,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_TOTAL",
+		Description: "Total single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	313: {
+		ID:          313,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_TOTAL",
+		Description: "Total double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	314: {
+		ID:          314,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_L1",
+		Description: "L1 cache single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	315: {
+		ID:          315,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_L1",
+		Description: "L1 cache double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	316: {
+		ID:          316,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_L2",
+		Description: "L2 cache single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	317: {
+		ID:          317,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_L2",
+		Description: "L2 cache double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	318: {
+		ID:          318,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_DEV",
+		Description: "Device memory single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	319: {
+		ID:          319,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_DEV",
+		Description: "Device memory double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	320: {
+		ID:          320,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_REG",
+		Description: "Register file single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	321: {
+		ID:          321,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_REG",
+		Description: "Register file double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	322: {
+		ID:          322,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_TEX",
+		Description: "Texture memory single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	323: {
+		ID:          323,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_TEX",
+		Description: "Texture memory double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	324: {
+		ID:          324,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_L1",
+		Description: "L1 cache single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	325: {
+		ID:          325,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_L1",
+		Description: "L1 cache double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	326: {
+		ID:          326,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_L2",
+		Description: "L2 cache single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	327: {
+		ID:          327,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_L2",
+		Description: "L2 cache double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	328: {
+		ID:          328,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_DEV",
+		Description: "Device memory single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	329: {
+		ID:          329,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_DEV",
+		Description: "Device memory double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	330: {
+		ID:          330,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_REG",
+		Description: "Register File single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	331: {
+		ID:          331,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_REG",
+		Description: "Register File double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	332: {
+		ID:          332,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_TEX",
+		Description: "Texture memory single bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	333: {
+		ID:          333,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_TEX",
+		Description: "Texture memory double bit aggregate (persistent) ECC errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	334: {
+		ID:          334,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_SHM",
+		Description: "Texture SHM single bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	335: {
+		ID:          335,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_SHM",
+		Description: "Texture SHM double bit volatile ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	336: {
+		ID:          336,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_CBU",
+		Description: "CBU single bit ECC volatile errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	337: {
+		ID:          337,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_CBU",
+		Description: "CBU double bit ECC volatile errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	338: {
+		ID:          338,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_SHM",
+		Description: "Texture SHM single bit aggregate ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	339: {
+		ID:          339,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_SHM",
+		Description: "Texture SHM double bit aggregate ECC errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	340: {
+		ID:          340,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_CBU",
+		Description: "CBU single bit ECC aggregate errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	341: {
+		ID:          341,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_CBU",
+		Description: "CBU double bit ECC aggregate errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	342: {
+		ID:          342,
+		Name:        "DCGM_FI_DEV_ECC_SBE_VOL_SRM",
+		Description: "SRAM single bit ECC volatile errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	343: {
+		ID:          343,
+		Name:        "DCGM_FI_DEV_ECC_DBE_VOL_SRM",
+		Description: "SRAM double bit ECC volatile errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	344: {
+		ID:          344,
+		Name:        "DCGM_FI_DEV_ECC_SBE_AGG_SRM",
+		Description: "SRAM single bit ECC aggregate errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	345: {
+		ID:          345,
+		Name:        "DCGM_FI_DEV_ECC_DBE_AGG_SRM",
+		Description: "SRAM double bit ECC aggregate errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	346: {
+		ID:          346,
+		Name:        "DCGM_FI_DEV_SRAM_EXCEEDED",
+		Description: "SRAM Threshold Exceeded boolean (1=true)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	350: {
+		ID:          350,
+		Name:        "DCGM_FI_DEV_DIAG_MEMORY_RESULT",
+		Description: "Result of the GPU Memory test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	351: {
+		ID:          351,
+		Name:        "DCGM_FI_DEV_DIAG_DIAGNOSTIC_RESULT",
+		Description: "Result of the Diagnostics test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	352: {
+		ID:          352,
+		Name:        "DCGM_FI_DEV_DIAG_PCIE_RESULT",
+		Description: "Result of the PCIe + NVLink test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	353: {
+		ID:          353,
+		Name:        "DCGM_FI_DEV_DIAG_TARGETED_STRESS_RESULT",
+		Description: "Result of the Targeted Stress test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	354: {
+		ID:          354,
+		Name:        "DCGM_FI_DEV_DIAG_TARGETED_POWER_RESULT",
+		Description: "Result of the Targeted Power test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	355: {
+		ID:          355,
+		Name:        "DCGM_FI_DEV_DIAG_MEMORY_BANDWIDTH_RESULT",
+		Description: "Result of the Memory Bandwidth test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	356: {
+		ID:          356,
+		Name:        "DCGM_FI_DEV_DIAG_MEMTEST_RESULT",
+		Description: "Result of the Memory Stress test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	357: {
+		ID:          357,
+		Name:        "DCGM_FI_DEV_DIAG_PULSE_TEST_RESULT",
+		Description: "Result of the Input Energy Delayed Product power (EDPp) test (a.k.a. the pulse test) Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	358: {
+		ID:          358,
+		Name:        "DCGM_FI_DEV_DIAG_EUD_RESULT",
+		Description: "Result of the Extended Utility Diagnostics (EUD) test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	359: {
+		ID:          359,
+		Name:        "DCGM_FI_DEV_DIAG_CPU_EUD_RESULT",
+		Description: "Result of the CPU Extended Utility Diagnostics (CPU EUD) test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	360: {
+		ID:          360,
+		Name:        "DCGM_FI_DEV_DIAG_SOFTWARE_RESULT",
+		Description: "Result of the Software test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	361: {
+		ID:          361,
+		Name:        "DCGM_FI_DEV_DIAG_NVBANDWIDTH_RESULT",
+		Description: "Result of the NVBandwidth test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	362: {
+		ID:          362,
+		Name:        "DCGM_FI_DEV_DIAG_STATUS",
+		Description: "Status of the current diag run Refers to a binary blob of a `dcgmDiagStatus_t` struct",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	363: {
+		ID:          363,
+		Name:        "DCGM_FI_DEV_DIAG_NCCL_TESTS_RESULT",
+		Description: "Result of the nccl-tests test Refers to a `int64_t` storing a value drawn from `dcgmError_t` enumeration",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	385: {
+		ID:          385,
+		Name:        "DCGM_FI_DEV_BANK_REMAP_AVAIL_MAX",
+		Description: "Historical max available spare memory rows per memory bank",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	386: {
+		ID:          386,
+		Name:        "DCGM_FI_DEV_BANK_REMAP_AVAIL_HIGH",
+		Description: "Historical high mark of available spare memory rows per memory bank",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	387: {
+		ID:          387,
+		Name:        "DCGM_FI_DEV_BANK_REMAP_AVAIL_PARTIAL",
+		Description: "Historical mark of partial available spare memory rows per memory bank",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	388: {
+		ID:          388,
+		Name:        "DCGM_FI_DEV_BANK_REMAP_AVAIL_LOW",
+		Description: "Historical low mark of available spare memory rows per memory bank",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	389: {
+		ID:          389,
+		Name:        "DCGM_FI_DEV_BANK_REMAP_AVAIL_NONE",
+		Description: "Historical marker of memory banks with no available spare memory rows",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	390: {
+		ID:          390,
+		Name:        "DCGM_FI_DEV_PAGE_RETIRED_SBE_TOTAL",
+		Description: "Number of retired pages because of single bit errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	391: {
+		ID:          391,
+		Name:        "DCGM_FI_DEV_PAGE_RETIRED_DBE_TOTAL",
+		Description: "Number of retired pages because of double bit errors Note: monotonically increasing",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	392: {
+		ID:          392,
+		Name:        "DCGM_FI_DEV_PAGE_RETIRED_PENDING",
+		Description: "Whether any pages are pending retirement (1=yes, 0=no)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	393: {
+		ID:          393,
+		Name:        "DCGM_FI_DEV_ROW_REMAP_UNCORRECTABLE_TOTAL",
+		Description: "Number of remapped rows for uncorrectable errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	394: {
+		ID:          394,
+		Name:        "DCGM_FI_DEV_ROW_REMAP_CORRECTABLE_TOTAL",
+		Description: "Number of remapped rows for correctable errors",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	395: {
+		ID:          395,
+		Name:        "DCGM_FI_DEV_ROW_REMAP_FAILED",
+		Description: "Whether remapping of rows has failed",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	396: {
+		ID:          396,
+		Name:        "DCGM_FI_DEV_ROW_REMAP_PENDING",
+		Description: "Whether remapping of rows is pending",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	400: {
+		ID:          400,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L0_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	401: {
+		ID:          401,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L1_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	402: {
+		ID:          402,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L2_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	403: {
+		ID:          403,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L3_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	404: {
+		ID:          404,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L4_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	405: {
+		ID:          405,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L5_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	406: {
+		ID:          406,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L12_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	407: {
+		ID:          407,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L13_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	408: {
+		ID:          408,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L14_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	409: {
+		ID:          409,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_TOTAL",
+		Description: "NV Link flow control CRC Error Counter total for all Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	410: {
+		ID:          410,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L0_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	411: {
+		ID:          411,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L1_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	412: {
+		ID:          412,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L2_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	413: {
+		ID:          413,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L3_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	414: {
+		ID:          414,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L4_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	415: {
+		ID:          415,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L5_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	416: {
+		ID:          416,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L12_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	417: {
+		ID:          417,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L13_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	418: {
+		ID:          418,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L14_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	419: {
+		ID:          419,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_TOTAL",
+		Description: "NV Link data CRC Error Counter total for all Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	420: {
+		ID:          420,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L0_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	421: {
+		ID:          421,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L1_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	422: {
+		ID:          422,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L2_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	423: {
+		ID:          423,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L3_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	424: {
+		ID:          424,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L4_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	425: {
+		ID:          425,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L5_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	426: {
+		ID:          426,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L12_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	427: {
+		ID:          427,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L13_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	428: {
+		ID:          428,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L14_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	429: {
+		ID:          429,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_TOTAL",
+		Description: "NV Link Replay Error Counter total for all Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	430: {
+		ID:          430,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L0_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	431: {
+		ID:          431,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L1_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	432: {
+		ID:          432,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L2_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	433: {
+		ID:          433,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L3_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	434: {
+		ID:          434,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L4_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	435: {
+		ID:          435,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L5_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	436: {
+		ID:          436,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L12_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	437: {
+		ID:          437,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L13_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	438: {
+		ID:          438,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L14_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	439: {
+		ID:          439,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_TOTAL",
+		Description: "NV Link Recovery Error Counter total for all Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	440: {
+		ID:          440,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L0",
+		Description: "NV Link Throughput for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	441: {
+		ID:          441,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L1",
+		Description: "NV Link Throughput for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	442: {
+		ID:          442,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L2",
+		Description: "NV Link Throughput for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	443: {
+		ID:          443,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L3",
+		Description: "NV Link Throughput for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	444: {
+		ID:          444,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L4",
+		Description: "NV Link Throughput for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	445: {
+		ID:          445,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L5",
+		Description: "NV Link Throughput for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	446: {
+		ID:          446,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L12",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	447: {
+		ID:          447,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L13",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	448: {
+		ID:          448,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L14",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	449: {
+		ID:          449,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_TOTAL",
+		Description: "NV Link Throughput total for all Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	450: {
+		ID:          450,
+		Name:        "DCGM_FI_DEV_NVLINK_ERROR",
+		Description: "GPU NVLink error information",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	451: {
+		ID:          451,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L6_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	452: {
+		ID:          452,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L7_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	453: {
+		ID:          453,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L8_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	454: {
+		ID:          454,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L9_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	455: {
+		ID:          455,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L10_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	456: {
+		ID:          456,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L11_TOTAL",
+		Description: "NV Link flow control CRC Error Counter for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	457: {
+		ID:          457,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L6_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	458: {
+		ID:          458,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L7_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	459: {
+		ID:          459,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L8_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	460: {
+		ID:          460,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L9_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	461: {
+		ID:          461,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L10_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	462: {
+		ID:          462,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L11_TOTAL",
+		Description: "NV Link data CRC Error Counter for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	463: {
+		ID:          463,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L6_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	464: {
+		ID:          464,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L7_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	465: {
+		ID:          465,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L8_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	466: {
+		ID:          466,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L9_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	467: {
+		ID:          467,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L10_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	468: {
+		ID:          468,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L11_TOTAL",
+		Description: "NV Link Replay Error Counter for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	469: {
+		ID:          469,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L6_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	470: {
+		ID:          470,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L7_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	471: {
+		ID:          471,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L8_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	472: {
+		ID:          472,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L9_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	473: {
+		ID:          473,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L10_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	474: {
+		ID:          474,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L11_TOTAL",
+		Description: "NV Link Recovery Error Counter for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	475: {
+		ID:          475,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L6",
+		Description: "NV Link Throughput for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	476: {
+		ID:          476,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L7",
+		Description: "NV Link Throughput for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	477: {
+		ID:          477,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L8",
+		Description: "NV Link Throughput for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	478: {
+		ID:          478,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L9",
+		Description: "NV Link Throughput for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	479: {
+		ID:          479,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L10",
+		Description: "NV Link Throughput for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	480: {
+		ID:          480,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L11",
+		Description: "NV Link Throughput for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	481: {
+		ID:          481,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L15_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	482: {
+		ID:          482,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L16_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	483: {
+		ID:          483,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_L17_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	484: {
+		ID:          484,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L15_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	485: {
+		ID:          485,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L16_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	486: {
+		ID:          486,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_L17_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	487: {
+		ID:          487,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L15_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	488: {
+		ID:          488,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L16_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	489: {
+		ID:          489,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_L17_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	491: {
+		ID:          491,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L15_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	492: {
+		ID:          492,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L16_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	493: {
+		ID:          493,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_L17_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	494: {
+		ID:          494,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L15",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	495: {
+		ID:          495,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L16",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	496: {
+		ID:          496,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_L17",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	497: {
+		ID:          497,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_ERROR_TOTAL",
+		Description: "NVLink CRC Error Counter Total",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	498: {
+		ID:          498,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_TOTAL",
+		Description: "NVLink Recovery Error Counter Total",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	499: {
+		ID:          499,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_TOTAL",
+		Description: "NVLink Replay Error Counter Total",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	500: {
+		ID:          500,
+		Name:        "DCGM_FI_DEV_GPU_VIRTUAL_MODE",
+		Description: "Virtualization Mode corresponding to the GPU. One of DCGM_GPU_VIRTUALIZATION_MODE_* constants.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	501: {
+		ID:          501,
+		Name:        "DCGM_FI_DEV_VGPU_SUPPORTED_INFO",
+		Description: "Includes Count and Static info of vGPU types supported on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	502: {
+		ID:          502,
+		Name:        "DCGM_FI_DEV_VGPU_CREATABLE_IDS",
+		Description: "Includes Count and currently Creatable vGPU types on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	503: {
+		ID:          503,
+		Name:        "DCGM_FI_DEV_VGPU_INSTANCE_INFO",
+		Description: "Includes Count and currently Active vGPU Instances on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	504: {
+		ID:          504,
+		Name:        "DCGM_FI_DEV_VGPU_UTIL_INFO",
+		Description: "Utilization values for vGPUs running on the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	505: {
+		ID:          505,
+		Name:        "DCGM_FI_DEV_VGPU_PROCESS_UTIL_INFO",
+		Description: "Utilization values for processes running within vGPU VMs using the device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	506: {
+		ID:          506,
+		Name:        "DCGM_FI_DEV_ENC_STATS",
+		Description: "Current encoder statistics for a given device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	507: {
+		ID:          507,
+		Name:        "DCGM_FI_DEV_FBC_STATS",
+		Description: "Statistics of current active frame buffer capture sessions on a given device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	508: {
+		ID:          508,
+		Name:        "DCGM_FI_DEV_FBC_SESSIONS_INFO",
+		Description: "Information about active frame buffer capture sessions on a target device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	509: {
+		ID:          509,
+		Name:        "DCGM_FI_DEV_VGPU_SUPPORTED_IDS",
+		Description: "Includes Count and currently Supported vGPU types on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	510: {
+		ID:          510,
+		Name:        "DCGM_FI_DEV_VGPU_TYPE_INFO",
+		Description: "Includes Static info of vGPU types supported on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	511: {
+		ID:          511,
+		Name:        "DCGM_FI_DEV_VGPU_TYPE_NAME",
+		Description: "Includes the name of a vGPU type supported on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	512: {
+		ID:          512,
+		Name:        "DCGM_FI_DEV_VGPU_TYPE_CLASS",
+		Description: "Includes the class of a vGPU type supported on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	513: {
+		ID:          513,
+		Name:        "DCGM_FI_DEV_VGPU_TYPE_LICENSE",
+		Description: "Includes the license info for a vGPU type supported on a device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	520: {
+		ID:          520,
+		Name:        "DCGM_FI_DEV_VGPU_VM_ID",
+		Description: "VM ID of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	521: {
+		ID:          521,
+		Name:        "DCGM_FI_DEV_VGPU_VM_NAME",
+		Description: "VM name of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	522: {
+		ID:          522,
+		Name:        "DCGM_FI_DEV_VGPU_TYPE",
+		Description: "vGPU type of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	523: {
+		ID:          523,
+		Name:        "DCGM_FI_DEV_VGPU_UUID",
+		Description: "UUID of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	524: {
+		ID:          524,
+		Name:        "DCGM_FI_DEV_VGPU_DRIVER_VERSION",
+		Description: "Driver version of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	525: {
+		ID:          525,
+		Name:        "DCGM_FI_DEV_VGPU_MEMORY_USAGE",
+		Description: "Memory usage of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	526: {
+		ID:          526,
+		Name:        "DCGM_FI_DEV_VGPU_LICENSE_STATUS",
+		Description: "License status of the vGPU 0 = vgpu is not licensed 1 = vgpu is licensed",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	527: {
+		ID:          527,
+		Name:        "DCGM_FI_DEV_VGPU_FRAME_RATE_LIMIT",
+		Description: "Frame rate limit of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	528: {
+		ID:          528,
+		Name:        "DCGM_FI_DEV_VGPU_ENC_STATS",
+		Description: "Current encoder statistics of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	529: {
+		ID:          529,
+		Name:        "DCGM_FI_DEV_VGPU_ENC_SESSIONS_INFO",
+		Description: "Information about all active encoder sessions on the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	530: {
+		ID:          530,
+		Name:        "DCGM_FI_DEV_VGPU_FBC_STATS",
+		Description: "Statistics of current active frame buffer capture sessions on the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	531: {
+		ID:          531,
+		Name:        "DCGM_FI_DEV_VGPU_FBC_SESSIONS_INFO",
+		Description: "Information about active frame buffer capture sessions on the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_BINARY,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	532: {
+		ID:          532,
+		Name:        "DCGM_FI_DEV_VGPU_INSTANCE_LICENSE_STATUS",
+		Description: "License state information of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	533: {
+		ID:          533,
+		Name:        "DCGM_FI_DEV_VGPU_PCI_ID",
+		Description: "PCI Id of the vGPU instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindInfo,
+	},
+	534: {
+		ID:          534,
+		Name:        "DCGM_FI_DEV_VGPU_GPU_INSTANCE_ID",
+		Description: "GPU Instance ID for the given vGPU Instance",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_VGPU,
+		Kind:        FieldKindGauge,
+	},
+	571: {
+		ID:          571,
+		Name:        "DCGM_FI_DEV_PLATFORM_INFINIBAND_GUID",
+		Description: "Infiniband GUID string with format 0xXXXXXXXXXXXXXXXX for the specified GPU.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	572: {
+		ID:          572,
+		Name:        "DCGM_FI_DEV_PLATFORM_CHASSIS_SERIAL_NUMBER",
+		Description: "Serial number of the chassis containing this GPU",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindInfo,
+	},
+	573: {
+		ID:          573,
+		Name:        "DCGM_FI_DEV_PLATFORM_CHASSIS_SLOT_NUMBER",
+		Description: "Slot number in the rack containing the GPU (includes switches)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	574: {
+		ID:          574,
+		Name:        "DCGM_FI_DEV_PLATFORM_TRAY_INDEX",
+		Description: "Tray index within the compute slots in the chassis containing this GPU (does not include switches)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	575: {
+		ID:          575,
+		Name:        "DCGM_FI_DEV_PLATFORM_HOST_ID",
+		Description: "Index of the node within the slot containing the GPU",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	576: {
+		ID:          576,
+		Name:        "DCGM_FI_DEV_PLATFORM_PEER_TYPE",
+		Description: "Platform indicated NVLink-peer type (e.g. switch present or not)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	577: {
+		ID:          577,
+		Name:        "DCGM_FI_DEV_PLATFORM_MODULE_ID",
+		Description: "ID of the GPU within the node",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	580: {
+		ID:          580,
+		Name:        "DCGM_FI_DEV_NVLINK_PPRM_OPER_RECOVERY",
+		Description: "PPRM recovery operation status",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	581: {
+		ID:          581,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_RECOVERY_TIME_SINCE_LAST",
+		Description: "Time in seconds since last PRM recovery",
+		Unit:        UnitSeconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	582: {
+		ID:          582,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_RECOVERY_TIME_BETWEEN_LAST_TWO",
+		Description: "Time in milliseconds between last two recoveries",
+		Unit:        UnitMilliseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	583: {
+		ID:          583,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_RECOVERY_SUCCESSFUL_TOTAL",
+		Description: "Total successful recovery events counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	584: {
+		ID:          584,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PHYSICAL_RECOVERY_SUCCESSFUL_TOTAL",
+		Description: "Physical layer successful recovery events",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	585: {
+		ID:          585,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PHYSICAL_LINK_DOWN_TOTAL",
+		Description: "Physical layer link down counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	586: {
+		ID:          586,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_RX_CODE_TOTAL",
+		Description: "PLR received codewords counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	587: {
+		ID:          587,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_RX_CODE_ERROR_TOTAL",
+		Description: "PLR received code error counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	588: {
+		ID:          588,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_RX_CODE_UNCORRECTABLE_TOTAL",
+		Description: "PLR received uncorrectable codes counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	589: {
+		ID:          589,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_TX_CODE_TOTAL",
+		Description: "PLR transmitted codewords counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	590: {
+		ID:          590,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_TX_RETRY_CODE_TOTAL",
+		Description: "PLR transmitted retry codes counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	591: {
+		ID:          591,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_TX_RETRY_EVENT_TOTAL",
+		Description: "PLR transmitted retry events counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	592: {
+		ID:          592,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_PLR_SYNC_EVENT_TOTAL",
+		Description: "PLR sync events counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	701: {
+		ID:          701,
+		Name:        "DCGM_FI_DEV_NVSWITCH_VOLTAGE_MVOLT",
+		Description: "NvSwitch voltage",
+		Unit:        UnitMillivolts,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	702: {
+		ID:          702,
+		Name:        "DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ",
+		Description: "NvSwitch Current IDDQ",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	703: {
+		ID:          703,
+		Name:        "DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ_REV",
+		Description: "NvSwitch Current IDDQ Rev",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	704: {
+		ID:          704,
+		Name:        "DCGM_FI_DEV_NVSWITCH_CURRENT_IDDQ_DVDD",
+		Description: "NvSwitch Current IDDQ Rev DVDD",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	705: {
+		ID:          705,
+		Name:        "DCGM_FI_DEV_NVSWITCH_POWER_VDD_WATTS",
+		Description: "NvSwitch Power VDD in watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	706: {
+		ID:          706,
+		Name:        "DCGM_FI_DEV_NVSWITCH_POWER_DVDD_WATTS",
+		Description: "NvSwitch Power DVDD in watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	707: {
+		ID:          707,
+		Name:        "DCGM_FI_DEV_NVSWITCH_POWER_HVDD_WATTS",
+		Description: "NvSwitch Power HVDD in watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	780: {
+		ID:          780,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX",
+		Description: "NVSwitch Tx Throughput Counter for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	781: {
+		ID:          781,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_RX",
+		Description: "NVSwitch Rx Throughput Counter for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	782: {
+		ID:          782,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS",
+		Description: "NvSwitch fatal_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	783: {
+		ID:          783,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS",
+		Description: "NvSwitch non_fatal_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	784: {
+		ID:          784,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERROR_TOTAL",
+		Description: "NvSwitch replay_count_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	785: {
+		ID:          785,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERROR_TOTAL",
+		Description: "NvSwitch recovery_count_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	786: {
+		ID:          786,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERROR_TOTAL",
+		Description: "NvSwitch filt_err_count_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	787: {
+		ID:          787,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_TOTAL",
+		Description: "NvLink lane_crs_err_count_aggregate_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	788: {
+		ID:          788,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_TOTAL",
+		Description: "NvLink lane ecc_err_count_aggregate_errors for ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	789: {
+		ID:          789,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC0",
+		Description: "Nvlink lane latency low lane0 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	790: {
+		ID:          790,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC1",
+		Description: "Nvlink lane latency low lane1 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	791: {
+		ID:          791,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC2",
+		Description: "Nvlink lane latency low lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	792: {
+		ID:          792,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_LOW_VC3",
+		Description: "Nvlink lane latency low lane3 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	793: {
+		ID:          793,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC0",
+		Description: "Nvlink lane latency medium lane0 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	794: {
+		ID:          794,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC1",
+		Description: "Nvlink lane latency medium lane1 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	795: {
+		ID:          795,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC2",
+		Description: "Nvlink lane latency medium lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	796: {
+		ID:          796,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_MEDIUM_VC3",
+		Description: "Nvlink lane latency medium lane3 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	797: {
+		ID:          797,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC0",
+		Description: "Nvlink lane latency high lane0 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	798: {
+		ID:          798,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC1",
+		Description: "Nvlink lane latency high lane1 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	799: {
+		ID:          799,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC2",
+		Description: "Nvlink lane latency high lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	800: {
+		ID:          800,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_HIGH_VC3",
+		Description: "Nvlink lane latency high lane3 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	801: {
+		ID:          801,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC0",
+		Description: "Nvlink lane latency panic lane0 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	802: {
+		ID:          802,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC1",
+		Description: "Nvlink lane latency panic lane1 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	803: {
+		ID:          803,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC2",
+		Description: "Nvlink lane latency panic lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	804: {
+		ID:          804,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_PANIC_VC3",
+		Description: "Nvlink lane latency panic lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	805: {
+		ID:          805,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_SAMPLE_VC0_TOTAL",
+		Description: "Nvlink lane latency count lane0 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	806: {
+		ID:          806,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_SAMPLE_VC1_TOTAL",
+		Description: "Nvlink lane latency count lane1 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	807: {
+		ID:          807,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_SAMPLE_VC2_TOTAL",
+		Description: "Nvlink lane latency count lane2 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	808: {
+		ID:          808,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_LATENCY_SAMPLE_VC3_TOTAL",
+		Description: "Nvlink lane latency count lane3 counter.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	809: {
+		ID:          809,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L0_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 0 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	810: {
+		ID:          810,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L1_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 1 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	811: {
+		ID:          811,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L2_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 2 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	812: {
+		ID:          812,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L3_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 3 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	813: {
+		ID:          813,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L0_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 0 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	814: {
+		ID:          814,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L1_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 1 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	815: {
+		ID:          815,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L2_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 2 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	816: {
+		ID:          816,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L3_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 3 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	817: {
+		ID:          817,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L4_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 4 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	818: {
+		ID:          818,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L5_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 5 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	819: {
+		ID:          819,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L6_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 6 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	820: {
+		ID:          820,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_L7_TOTAL",
+		Description: "NvLink lane crc_err_count for lane 7 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	821: {
+		ID:          821,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L4_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 4 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	822: {
+		ID:          822,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L5_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 5 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	823: {
+		ID:          823,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L6_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 6 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	824: {
+		ID:          824,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ECC_ERROR_L7_TOTAL",
+		Description: "NvLink lane ecc_err_count for lane 7 on ports 0-17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	825: {
+		ID:          825,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L0",
+		Description: "NV Link TX Throughput for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	826: {
+		ID:          826,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L1",
+		Description: "NV Link TX Throughput for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	827: {
+		ID:          827,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L2",
+		Description: "NV Link TX Throughput for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	828: {
+		ID:          828,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L3",
+		Description: "NV Link TX Throughput for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	829: {
+		ID:          829,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L4",
+		Description: "NV Link TX Throughput for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	830: {
+		ID:          830,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L5",
+		Description: "NV Link TX Throughput for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	831: {
+		ID:          831,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L6",
+		Description: "NV Link TX Throughput for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	832: {
+		ID:          832,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L7",
+		Description: "NV Link TX Throughput for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	833: {
+		ID:          833,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L8",
+		Description: "NV Link TX Throughput for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	834: {
+		ID:          834,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L9",
+		Description: "NV Link TX Throughput for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	835: {
+		ID:          835,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L10",
+		Description: "NV Link TX Throughput for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	836: {
+		ID:          836,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L11",
+		Description: "NV Link TX Throughput for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	837: {
+		ID:          837,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L12",
+		Description: "NV Link TX Throughput for Lane 12",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	838: {
+		ID:          838,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L13",
+		Description: "NV Link TX Throughput for Lane 13",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	839: {
+		ID:          839,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L14",
+		Description: "NV Link TX Throughput for Lane 14",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	840: {
+		ID:          840,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L15",
+		Description: "NV Link TX Throughput for Lane 15",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	841: {
+		ID:          841,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L16",
+		Description: "NV Link TX Throughput for Lane 16",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	842: {
+		ID:          842,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_L17",
+		Description: "NV Link TX Throughput for Lane 17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	843: {
+		ID:          843,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_TOTAL",
+		Description: "NV Link Throughput total for all TX Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	856: {
+		ID:          856,
+		Name:        "DCGM_FI_DEV_SXID_FATAL_ERROR",
+		Description: "NVSwitch fatal error information. Note: value field indicates the specific SXid reported",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	857: {
+		ID:          857,
+		Name:        "DCGM_FI_DEV_SXID_NON_FATAL_ERROR",
+		Description: "NVSwitch non fatal error information. Note: value field indicates the specific SXid reported",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	858: {
+		ID:          858,
+		Name:        "DCGM_FI_DEV_NVSWITCH_TEMP_CELSIUS",
+		Description: "NVSwitch current temperature.",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	859: {
+		ID:          859,
+		Name:        "DCGM_FI_DEV_NVSWITCH_TEMP_SLOWDOWN_CELSIUS",
+		Description: "NVSwitch limit slowdown temperature.",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	860: {
+		ID:          860,
+		Name:        "DCGM_FI_DEV_NVSWITCH_TEMP_SHUTDOWN_CELSIUS",
+		Description: "NVSwitch limit shutdown temperature.",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	861: {
+		ID:          861,
+		Name:        "DCGM_FI_DEV_NVSWITCH_THROUGHPUT_TX",
+		Description: "NVSwitch throughput Tx.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindCounter,
+	},
+	862: {
+		ID:          862,
+		Name:        "DCGM_FI_DEV_NVSWITCH_THROUGHPUT_RX",
+		Description: "NVSwitch throughput Rx.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindCounter,
+	},
+	863: {
+		ID:          863,
+		Name:        "DCGM_FI_DEV_NVSWITCH_PHYSICAL_ID",
+		Description: "NVSwitch Physical ID.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	864: {
+		ID:          864,
+		Name:        "DCGM_FI_DEV_NVSWITCH_RESET_REQUIRED",
+		Description: "NVSwitch reset required.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	865: {
+		ID:          865,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_ID",
+		Description: "NvSwitch NvLink ID",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	866: {
+		ID:          866,
+		Name:        "DCGM_FI_DEV_NVSWITCH_PCIE_DOMAIN",
+		Description: "NvSwitch PCIE domain",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	867: {
+		ID:          867,
+		Name:        "DCGM_FI_DEV_NVSWITCH_PCIE_BUS",
+		Description: "NvSwitch PCIE bus",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	868: {
+		ID:          868,
+		Name:        "DCGM_FI_DEV_NVSWITCH_PCIE_DEVICE",
+		Description: "NvSwitch PCIE device",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	869: {
+		ID:          869,
+		Name:        "DCGM_FI_DEV_NVSWITCH_PCIE_FUNCTION",
+		Description: "NvSwitch PCIE function",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindGauge,
+	},
+	870: {
+		ID:          870,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_STATUS",
+		Description: "NvLink status. UNKNOWN:-1 OFF:0 SAFE:1 ACTIVE:2 ERROR:3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	871: {
+		ID:          871,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_TYPE",
+		Description: "NvLink device type (NSCQ: GPU=1, Switch=2; NVSDM: CA=1, Switch=2, GPU=5)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	872: {
+		ID:          872,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN",
+		Description: "NvLink device pcie domain.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	873: {
+		ID:          873,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS",
+		Description: "NvLink device pcie bus.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	874: {
+		ID:          874,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE",
+		Description: "NvLink device pcie device.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	875: {
+		ID:          875,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_FUNCTION",
+		Description: "NvLink device pcie function.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	876: {
+		ID:          876,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_LINK_ID",
+		Description: "NvLink device link ID",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	877: {
+		ID:          877,
+		Name:        "DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_LINK_SID",
+		Description: "NvLink device SID.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	878: {
+		ID:          878,
+		Name:        "DCGM_FI_DEV_NVSWITCH_UUID",
+		Description: "NvLink device switch/link uid.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindInfo,
+	},
+	879: {
+		ID:          879,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L0",
+		Description: "NV Link RX Throughput for Lane 0",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	880: {
+		ID:          880,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L1",
+		Description: "NV Link RX Throughput for Lane 1",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	881: {
+		ID:          881,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L2",
+		Description: "NV Link RX Throughput for Lane 2",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	882: {
+		ID:          882,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L3",
+		Description: "NV Link RX Throughput for Lane 3",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	883: {
+		ID:          883,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L4",
+		Description: "NV Link RX Throughput for Lane 4",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	884: {
+		ID:          884,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L5",
+		Description: "NV Link RX Throughput for Lane 5",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	885: {
+		ID:          885,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L6",
+		Description: "NV Link RX Throughput for Lane 6",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	886: {
+		ID:          886,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L7",
+		Description: "NV Link RX Throughput for Lane 7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	887: {
+		ID:          887,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L8",
+		Description: "NV Link RX Throughput for Lane 8",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	888: {
+		ID:          888,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L9",
+		Description: "NV Link RX Throughput for Lane 9",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	889: {
+		ID:          889,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L10",
+		Description: "NV Link RX Throughput for Lane 10",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	890: {
+		ID:          890,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L11",
+		Description: "NV Link RX Throughput for Lane 11",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	891: {
+		ID:          891,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L12",
+		Description: "NV Link RX Throughput for Lane 12",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	892: {
+		ID:          892,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L13",
+		Description: "NV Link RX Throughput for Lane 13",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	893: {
+		ID:          893,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L14",
+		Description: "NV Link RX Throughput for Lane 14",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	894: {
+		ID:          894,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L15",
+		Description: "NV Link RX Throughput for Lane 15",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	895: {
+		ID:          895,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L16",
+		Description: "NV Link RX Throughput for Lane 16",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	896: {
+		ID:          896,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_L17",
+		Description: "NV Link RX Throughput for Lane 17",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	897: {
+		ID:          897,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_TOTAL",
+		Description: "NV Link Throughput total for all RX Lanes",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1001: {
+		ID:          1001,
+		Name:        "DCGM_FI_PROF_GR_ENGINE_UTIL_RATIO",
+		Description: "Ratio of time the graphics engine is active. The graphics engine is active if a graphics/compute context is bound and the graphics pipe or compute pipe is busy.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1002: {
+		ID:          1002,
+		Name:        "DCGM_FI_PROF_SM_UTIL_RATIO",
+		Description: "The ratio of cycles an SM has at least 1 warp assigned (computed from the number of cycles and elapsed cycles)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1003: {
+		ID:          1003,
+		Name:        "DCGM_FI_PROF_SM_OCCUPANCY_RATIO",
+		Description: "The ratio of number of warps resident on an SM. (number of resident as a ratio of the theoretical maximum number of warps per elapsed cycle)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1004: {
+		ID:          1004,
+		Name:        "DCGM_FI_PROF_TENSOR_UTIL_RATIO",
+		Description: "The ratio of cycles the any tensor pipe is active (off the peak sustained elapsed cycles)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1005: {
+		ID:          1005,
+		Name:        "DCGM_FI_PROF_DRAM_UTIL_RATIO",
+		Description: "The ratio of cycles the device memory interface is active sending or receiving data.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1006: {
+		ID:          1006,
+		Name:        "DCGM_FI_PROF_FP64_UTIL_RATIO",
+		Description: "Ratio of cycles the fp64 pipe is active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1007: {
+		ID:          1007,
+		Name:        "DCGM_FI_PROF_FP32_UTIL_RATIO",
+		Description: "Ratio of cycles the fp32 pipe is active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1008: {
+		ID:          1008,
+		Name:        "DCGM_FI_PROF_FP16_UTIL_RATIO",
+		Description: "Ratio of cycles the fp16 pipe is active. This does not include HMMA.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1009: {
+		ID:          1009,
+		Name:        "DCGM_FI_PROF_PCIE_TX_BYTES",
+		Description: "The number of bytes of active PCIe tx (transmit) data including both header and payload. Note that this is from the perspective of the GPU, so copying data from device to host (DtoH) would be reflected in this metric.",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1010: {
+		ID:          1010,
+		Name:        "DCGM_FI_PROF_PCIE_RX_BYTES",
+		Description: "The number of bytes of active PCIe rx (read) data including both header and payload. Note that this is from the perspective of the GPU, so copying data from host to device (HtoD) would be reflected in this metric.",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1011: {
+		ID:          1011,
+		Name:        "DCGM_FI_PROF_NVLINK_TX_BYTES",
+		Description: "The total number of bytes of active NvLink tx (transmit) data including both header and payload. Per-link fields are available below",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1012: {
+		ID:          1012,
+		Name:        "DCGM_FI_PROF_NVLINK_RX_BYTES",
+		Description: "The total number of bytes of active NvLink rx (read) data including both header and payload. Per-link fields are available below",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1013: {
+		ID:          1013,
+		Name:        "DCGM_FI_PROF_IMMA_UTIL_RATIO",
+		Description: "The ratio of cycles the tensor (IMMA) pipe is active (off the peak sustained elapsed cycles)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1014: {
+		ID:          1014,
+		Name:        "DCGM_FI_PROF_HMMA_UTIL_RATIO",
+		Description: "The ratio of cycles the tensor (HMMA) pipe is active (off the peak sustained elapsed cycles)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1015: {
+		ID:          1015,
+		Name:        "DCGM_FI_PROF_DFMA_UTIL_RATIO",
+		Description: "The ratio of cycles the tensor (DFMA) pipe is active (off the peak sustained elapsed cycles)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1016: {
+		ID:          1016,
+		Name:        "DCGM_FI_PROF_INT_UTIL_RATIO",
+		Description: "Ratio of cycles the integer pipe is active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1017: {
+		ID:          1017,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_0_RATIO",
+		Description: "Ratio of cycles each of the NVDEC engines are active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1018: {
+		ID:          1018,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_1_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1019: {
+		ID:          1019,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_2_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1020: {
+		ID:          1020,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_3_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1021: {
+		ID:          1021,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_4_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1022: {
+		ID:          1022,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_5_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1023: {
+		ID:          1023,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_6_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1024: {
+		ID:          1024,
+		Name:        "DCGM_FI_PROF_NVDEC_UTIL_7_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1025: {
+		ID:          1025,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_0_RATIO",
+		Description: "Ratio of cycles each of the NVJPG engines are active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1026: {
+		ID:          1026,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_1_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1027: {
+		ID:          1027,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_2_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1028: {
+		ID:          1028,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_3_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1029: {
+		ID:          1029,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_4_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1030: {
+		ID:          1030,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_5_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1031: {
+		ID:          1031,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_6_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1032: {
+		ID:          1032,
+		Name:        "DCGM_FI_PROF_NVJPG_UTIL_7_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1033: {
+		ID:          1033,
+		Name:        "DCGM_FI_PROF_NVOFA_UTIL_0_RATIO",
+		Description: "Ratio of cycles each of the NVOFA engines are active.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1034: {
+		ID:          1034,
+		Name:        "DCGM_FI_PROF_NVOFA_UTIL_1_RATIO",
+		Description: "",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1040: {
+		ID:          1040,
+		Name:        "DCGM_FI_PROF_NVLINK_L0_TX_BYTES",
+		Description: "The per-link number of bytes of active NvLink TX (transmit) or RX (transmit) data including both header and payload. For example: DCGM_FI_PROF_NVLINK_L0_TX_BYTES -> L0 TX To get the bandwidth for a link, add the RX and TX value together like total = DCGM_FI_PROF_NVLINK_L0_TX_BYTES + DCGM_FI_PROF_NVLINK_L0_RX_BYTES",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1041: {
+		ID:          1041,
+		Name:        "DCGM_FI_PROF_NVLINK_L0_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1042: {
+		ID:          1042,
+		Name:        "DCGM_FI_PROF_NVLINK_L1_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1043: {
+		ID:          1043,
+		Name:        "DCGM_FI_PROF_NVLINK_L1_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1044: {
+		ID:          1044,
+		Name:        "DCGM_FI_PROF_NVLINK_L2_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1045: {
+		ID:          1045,
+		Name:        "DCGM_FI_PROF_NVLINK_L2_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1046: {
+		ID:          1046,
+		Name:        "DCGM_FI_PROF_NVLINK_L3_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1047: {
+		ID:          1047,
+		Name:        "DCGM_FI_PROF_NVLINK_L3_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1048: {
+		ID:          1048,
+		Name:        "DCGM_FI_PROF_NVLINK_L4_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1049: {
+		ID:          1049,
+		Name:        "DCGM_FI_PROF_NVLINK_L4_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1050: {
+		ID:          1050,
+		Name:        "DCGM_FI_PROF_NVLINK_L5_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1051: {
+		ID:          1051,
+		Name:        "DCGM_FI_PROF_NVLINK_L5_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1052: {
+		ID:          1052,
+		Name:        "DCGM_FI_PROF_NVLINK_L6_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1053: {
+		ID:          1053,
+		Name:        "DCGM_FI_PROF_NVLINK_L6_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1054: {
+		ID:          1054,
+		Name:        "DCGM_FI_PROF_NVLINK_L7_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1055: {
+		ID:          1055,
+		Name:        "DCGM_FI_PROF_NVLINK_L7_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1056: {
+		ID:          1056,
+		Name:        "DCGM_FI_PROF_NVLINK_L8_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1057: {
+		ID:          1057,
+		Name:        "DCGM_FI_PROF_NVLINK_L8_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1058: {
+		ID:          1058,
+		Name:        "DCGM_FI_PROF_NVLINK_L9_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1059: {
+		ID:          1059,
+		Name:        "DCGM_FI_PROF_NVLINK_L9_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1060: {
+		ID:          1060,
+		Name:        "DCGM_FI_PROF_NVLINK_L10_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1061: {
+		ID:          1061,
+		Name:        "DCGM_FI_PROF_NVLINK_L10_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1062: {
+		ID:          1062,
+		Name:        "DCGM_FI_PROF_NVLINK_L11_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1063: {
+		ID:          1063,
+		Name:        "DCGM_FI_PROF_NVLINK_L11_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1064: {
+		ID:          1064,
+		Name:        "DCGM_FI_PROF_NVLINK_L12_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1065: {
+		ID:          1065,
+		Name:        "DCGM_FI_PROF_NVLINK_L12_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1066: {
+		ID:          1066,
+		Name:        "DCGM_FI_PROF_NVLINK_L13_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1067: {
+		ID:          1067,
+		Name:        "DCGM_FI_PROF_NVLINK_L13_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1068: {
+		ID:          1068,
+		Name:        "DCGM_FI_PROF_NVLINK_L14_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1069: {
+		ID:          1069,
+		Name:        "DCGM_FI_PROF_NVLINK_L14_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1070: {
+		ID:          1070,
+		Name:        "DCGM_FI_PROF_NVLINK_L15_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1071: {
+		ID:          1071,
+		Name:        "DCGM_FI_PROF_NVLINK_L15_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1072: {
+		ID:          1072,
+		Name:        "DCGM_FI_PROF_NVLINK_L16_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1073: {
+		ID:          1073,
+		Name:        "DCGM_FI_PROF_NVLINK_L16_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1074: {
+		ID:          1074,
+		Name:        "DCGM_FI_PROF_NVLINK_L17_TX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1075: {
+		ID:          1075,
+		Name:        "DCGM_FI_PROF_NVLINK_L17_RX_BYTES",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1076: {
+		ID:          1076,
+		Name:        "DCGM_FI_PROF_C2C_TX_ALL_BYTES",
+		Description: "The total number of bytes transmitted over the C2C (Chip-to-Chip) interface, including both header and payload data",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1077: {
+		ID:          1077,
+		Name:        "DCGM_FI_PROF_C2C_TX_DATA_BYTES",
+		Description: "The number of data-only bytes transmitted over the C2C (Chip-to-Chip) interface",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1078: {
+		ID:          1078,
+		Name:        "DCGM_FI_PROF_C2C_RX_ALL_BYTES",
+		Description: "The total number of bytes received over the C2C (Chip-to-Chip) interface, including both header and payload data",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1079: {
+		ID:          1079,
+		Name:        "DCGM_FI_PROF_C2C_RX_DATA_BYTES",
+		Description: "The number of data-only bytes received over the C2C (Chip-to-Chip) interface",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1080: {
+		ID:          1080,
+		Name:        "DCGM_FI_PROF_HOSTMEM_CACHE_HIT",
+		Description: "Host Memory Cache Hit Percentage of requests to Host Memory that were served from cache",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1081: {
+		ID:          1081,
+		Name:        "DCGM_FI_PROF_HOSTMEM_CACHE_MISS",
+		Description: "Host Memory Cache Miss Percentage of requests to Host Memory that were cache misses",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1082: {
+		ID:          1082,
+		Name:        "DCGM_FI_PROF_PEERMEM_CACHE_HIT",
+		Description: "Peer Memory Cache Hit Percentage of requests to Peer Memory that were served from cache",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1083: {
+		ID:          1083,
+		Name:        "DCGM_FI_PROF_PEERMEM_CACHE_MISS",
+		Description: "Peer Memory Cache Miss Percentage of requests to Peer Memory that were cache misses",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1084: {
+		ID:          1084,
+		Name:        "DCGM_FI_PROF_SM_CYCLES_ELAPSED_TOTAL",
+		Description: "SM Cycles Elapsed Raw monotonic counter of GPU SM cycles elapsed.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1085: {
+		ID:          1085,
+		Name:        "DCGM_FI_PROF_SM_CYCLES_ACTIVE_TOTAL",
+		Description: "SM Cycles Active Raw monotonic counter of GPU SM cycles where at least one warp was active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1086: {
+		ID:          1086,
+		Name:        "DCGM_FI_PROF_MMA_CYCLES_ACTIVE_TOTAL",
+		Description: "MMA Cycles Active Raw monotonic counter of GPU SM MMA (any tensor) cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1087: {
+		ID:          1087,
+		Name:        "DCGM_FI_PROF_DMMA_CYCLES_ACTIVE_TOTAL",
+		Description: "DMMA Cycles Active Raw monotonic counter of GPU SM DMMA tensor cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1088: {
+		ID:          1088,
+		Name:        "DCGM_FI_PROF_HMMA_CYCLES_ACTIVE_TOTAL",
+		Description: "HMMA Cycles Active Raw monotonic counter of GPU SM HMMA tensor cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1089: {
+		ID:          1089,
+		Name:        "DCGM_FI_PROF_IMMA_CYCLES_ACTIVE_TOTAL",
+		Description: "IMMA Cycles Active Raw monotonic counter of GPU SM IMMA tensor cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1090: {
+		ID:          1090,
+		Name:        "DCGM_FI_PROF_DFMA_CYCLES_ACTIVE_TOTAL",
+		Description: "DFMA Cycles Active Raw monotonic counter of GPU SM DFMA tensor cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1091: {
+		ID:          1091,
+		Name:        "DCGM_FI_PROF_PCIE_TX_BYTES_TOTAL",
+		Description: "PCIe TX Bytes Total Cumulative counter of PCIe TX traffic in bytes.",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1092: {
+		ID:          1092,
+		Name:        "DCGM_FI_PROF_PCIE_RX_BYTES_TOTAL",
+		Description: "PCIe RX Bytes Total Cumulative counter of PCIe RX traffic in bytes.",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1093: {
+		ID:          1093,
+		Name:        "DCGM_FI_PROF_INT_CYCLES_ACTIVE_TOTAL",
+		Description: "Integer Cycles Active Raw monotonic counter of GPU SM integer pipe cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1094: {
+		ID:          1094,
+		Name:        "DCGM_FI_PROF_FP64_CYCLES_ACTIVE_TOTAL",
+		Description: "FP64 Cycles Active Raw monotonic counter of GPU SM FP64 pipe cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1095: {
+		ID:          1095,
+		Name:        "DCGM_FI_PROF_FP32_CYCLES_ACTIVE_TOTAL",
+		Description: "FP32 Cycles Active Raw monotonic counter of GPU SM FP32 pipe cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1096: {
+		ID:          1096,
+		Name:        "DCGM_FI_PROF_FP16_CYCLES_ACTIVE_TOTAL",
+		Description: "FP16 Cycles Active Raw monotonic counter of GPU SM FP16 pipe cycles active.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1100: {
+		ID:          1100,
+		Name:        "DCGM_FI_DEV_CPU_UTIL_RATIO",
+		Description: "CPU Utilization, total",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1101: {
+		ID:          1101,
+		Name:        "DCGM_FI_DEV_CPU_UTIL_USER_RATIO",
+		Description: "CPU Utilization, user",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1102: {
+		ID:          1102,
+		Name:        "DCGM_FI_DEV_CPU_UTIL_NICE_RATIO",
+		Description: "CPU Utilization, nice",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1103: {
+		ID:          1103,
+		Name:        "DCGM_FI_DEV_CPU_UTIL_SYS_RATIO",
+		Description: "CPU Utilization, system time",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1104: {
+		ID:          1104,
+		Name:        "DCGM_FI_DEV_CPU_UTIL_IRQ_RATIO",
+		Description: "CPU Utilization, interrupt servicing",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1110: {
+		ID:          1110,
+		Name:        "DCGM_FI_DEV_CPU_TEMP_CELSIUS",
+		Description: "CPU temperature",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1111: {
+		ID:          1111,
+		Name:        "DCGM_FI_DEV_CPU_TEMP_WARNING_CELSIUS",
+		Description: "CPU Warning Temperature",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1112: {
+		ID:          1112,
+		Name:        "DCGM_FI_DEV_CPU_TEMP_CRITICAL_CELSIUS",
+		Description: "CPU Critical Temperature",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1120: {
+		ID:          1120,
+		Name:        "DCGM_FI_DEV_CPU_CLOCK_CURRENT",
+		Description: "CPU instantaneous clock speed",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CPU_CORE,
+		Kind:        FieldKindGauge,
+	},
+	1130: {
+		ID:          1130,
+		Name:        "DCGM_FI_DEV_CPU_POWER_WATTS",
+		Description: "CPU current power usage",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1131: {
+		ID:          1131,
+		Name:        "DCGM_FI_DEV_CPU_POWER_LIMIT_WATTS",
+		Description: "CPU power limit",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1132: {
+		ID:          1132,
+		Name:        "DCGM_FI_DEV_SYSIO_POWER_WATTS",
+		Description: "SoC current power usage",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1133: {
+		ID:          1133,
+		Name:        "DCGM_FI_DEV_MODULE_POWER_WATTS",
+		Description: "Module current power usage",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindGauge,
+	},
+	1140: {
+		ID:          1140,
+		Name:        "DCGM_FI_DEV_CPU_VENDOR",
+		Description: "CPU vendor name",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindInfo,
+	},
+	1141: {
+		ID:          1141,
+		Name:        "DCGM_FI_DEV_CPU_MODEL",
+		Description: "CPU model name",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_CPU,
+		Kind:        FieldKindInfo,
+	},
+	1200: {
+		ID:          1200,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_PACKET_TOTAL",
+		Description: "Total Tx packets on the link in NVLink5 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1201: {
+		ID:          1201,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_BYTES_TOTAL",
+		Description: "Total Tx bytes on the link in NVLink5 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1202: {
+		ID:          1202,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_PACKET_TOTAL",
+		Description: "Total Rx packets on the link in NVLink5 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1203: {
+		ID:          1203,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_BYTES_TOTAL",
+		Description: "Total Rx bytes on the link in NVLink5 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1204: {
+		ID:          1204,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_PACKET_MALFORMED_TOTAL",
+		Description: "Number of packets Rx on a link where packets are malformed Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1205: {
+		ID:          1205,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_PACKET_DROPPED_TOTAL",
+		Description: "Number of packets that were discarded on Rx due to buffer overrun Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1206: {
+		ID:          1206,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_ERROR_TOTAL",
+		Description: "Total number of packets with errors Rx on a link Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1207: {
+		ID:          1207,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_REMOTE_ERROR_TOTAL",
+		Description: "Total number of packets Rx - stomp/EBP marker Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1208: {
+		ID:          1208,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_GENERAL_ERROR_TOTAL",
+		Description: "Total number of packets Rx with header mismatch Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1209: {
+		ID:          1209,
+		Name:        "DCGM_FI_DEV_NVLINK_INTEGRITY_ERROR_TOTAL",
+		Description: "Total number of times that the count of local errors exceeded a threshold Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1210: {
+		ID:          1210,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_DISCARD_TOTAL",
+		Description: "Total number of tx error packets that were discarded Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1211: {
+		ID:          1211,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_SUCCESSFUL_TOTAL",
+		Description: "Number of times link went from Up to recovery, succeeded and link came back up Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1212: {
+		ID:          1212,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_FAILED_TOTAL",
+		Description: "Number of times link went from Up to recovery, failed and link was declared down Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1213: {
+		ID:          1213,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_EVENT_TOTAL",
+		Description: "Number of times link went from Up to recovery, irrespective of the result Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1214: {
+		ID:          1214,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_SYMBOL_ERROR_TOTAL",
+		Description: "Number of errors in rx symbols Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1215: {
+		ID:          1215,
+		Name:        "DCGM_FI_DEV_NVLINK_SYMBOL_BER_RAW",
+		Description: "BER for symbol errors - raw value Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1216: {
+		ID:          1216,
+		Name:        "DCGM_FI_DEV_NVLINK_SYMBOL_BER_RATIO",
+		Description: "BER for symbol errors - decoded float (derived from DCGM_FI_DEV_NVLINK_SYMBOL_BER_RAW) Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1217: {
+		ID:          1217,
+		Name:        "DCGM_FI_DEV_NVLINK_EFFECTIVE_BER_RAW",
+		Description: "Effective BER for effective errors - raw value Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1218: {
+		ID:          1218,
+		Name:        "DCGM_FI_DEV_NVLINK_EFFECTIVE_BER_RATIO",
+		Description: "Effective BER for effective errors - decoded float (derived from DCGM_FI_DEV_NVLINK_EFFECTIVE_BER_RAW) Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1219: {
+		ID:          1219,
+		Name:        "DCGM_FI_DEV_NVLINK_EFFECTIVE_ERROR_TOTAL",
+		Description: "Sum of the number of errors in each Nvlink packet Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1220: {
+		ID:          1220,
+		Name:        "DCGM_FI_DEV_NVLINK_ECC_ERROR_TOTAL",
+		Description: "NVLink ECC Data Error Counter total for all Links",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1300: {
+		ID:          1300,
+		Name:        "DCGM_FI_DEV_CONNECTX_HEALTH",
+		Description: "Health state of ConnectX",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1301: {
+		ID:          1301,
+		Name:        "DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_WIDTH",
+		Description: "Active PCIe link width",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1302: {
+		ID:          1302,
+		Name:        "DCGM_FI_DEV_CONNECTX_ACTIVE_PCIE_LINK_SPEED",
+		Description: "Active PCIe link speed",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1303: {
+		ID:          1303,
+		Name:        "DCGM_FI_DEV_CONNECTX_EXPECT_PCIE_LINK_WIDTH",
+		Description: "Expect PCIe link width",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1304: {
+		ID:          1304,
+		Name:        "DCGM_FI_DEV_CONNECTX_EXPECT_PCIE_LINK_SPEED",
+		Description: "Expect PCIe link speed",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1305: {
+		ID:          1305,
+		Name:        "DCGM_FI_DEV_CONNECTX_CORRECTABLE_ERROR_STATUS",
+		Description: "Correctable error status",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1306: {
+		ID:          1306,
+		Name:        "DCGM_FI_DEV_CONNECTX_CORRECTABLE_ERROR_MASK",
+		Description: "Correctable error mask",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1307: {
+		ID:          1307,
+		Name:        "DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERROR_STATUS",
+		Description: "Uncorrectable error status",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1308: {
+		ID:          1308,
+		Name:        "DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERROR_MASK",
+		Description: "Uncorrectable error mask",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1309: {
+		ID:          1309,
+		Name:        "DCGM_FI_DEV_CONNECTX_UNCORRECTABLE_ERROR_SEVERITY",
+		Description: "Uncorrectable error severity",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1310: {
+		ID:          1310,
+		Name:        "DCGM_FI_DEV_CONNECTX_TEMP_CELSIUS",
+		Description: "Device temperature",
+		Unit:        UnitCelsius,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_CONNECTX,
+		Kind:        FieldKindGauge,
+	},
+	1400: {
+		ID:          1400,
+		Name:        "DCGM_FI_DEV_C2C_LINK_ERROR_TOTAL",
+		Description: "C2C Link CRC Error Counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1401: {
+		ID:          1401,
+		Name:        "DCGM_FI_DEV_C2C_LINK_REPLAY_ERROR_TOTAL",
+		Description: "C2C Link Replay Error Counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1402: {
+		ID:          1402,
+		Name:        "DCGM_FI_DEV_C2C_LINK_REPLAY_ERROR_B2B_TOTAL",
+		Description: "C2C Link Back to Back Replay Error Counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1403: {
+		ID:          1403,
+		Name:        "DCGM_FI_DEV_C2C_LINK_POWER_STATUS",
+		Description: "C2C Link Power state. See NVML_C2C_POWER_STATE_*",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1404: {
+		ID:          1404,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_0_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 0 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1405: {
+		ID:          1405,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_1_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 1 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1406: {
+		ID:          1406,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_2_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 2 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1407: {
+		ID:          1407,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_3_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 3 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1408: {
+		ID:          1408,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_4_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 4 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1409: {
+		ID:          1409,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_5_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 5 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1410: {
+		ID:          1410,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_6_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 6 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1411: {
+		ID:          1411,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_7_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 7 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1412: {
+		ID:          1412,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_8_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 8 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1413: {
+		ID:          1413,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_9_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 9 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1414: {
+		ID:          1414,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_10_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 10 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1415: {
+		ID:          1415,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_11_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 11 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1416: {
+		ID:          1416,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_12_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 12 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1417: {
+		ID:          1417,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_13_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 13 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1418: {
+		ID:          1418,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_14_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 14 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1419: {
+		ID:          1419,
+		Name:        "DCGM_FI_DEV_NVLINK_FEC_HISTORY_15_TOTAL",
+		Description: "Count of symbol errors that are corrected - bin 15 Note: NVLink5+ only. Returns aggregate value across all links. Not supported on NVLink4 and earlier.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1420: {
+		ID:          1420,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASON_SW_POWER_CAP_NS",
+		Description: "Throttling to not exceed currently set power limits in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1421: {
+		ID:          1421,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASON_SYNC_BOOST_NS",
+		Description: "Throttling to match minimum possible clock across Sync Boost Group in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1422: {
+		ID:          1422,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASON_SW_THERM_SLOWDOWN_NS",
+		Description: "Throttling to ensure ((GPU temp < GPU Max Operating Temp) && (Memory Temp < Memory Max Operating Temp)) in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1423: {
+		ID:          1423,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASON_HW_THERM_SLOWDOWN_NS",
+		Description: "Throttling due to temperature being too high (reducing core clocks by a factor of 2 or more) in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1424: {
+		ID:          1424,
+		Name:        "DCGM_FI_DEV_CLOCKS_EVENT_REASON_HW_POWER_BRAKE_SLOWDOWN_NS",
+		Description: "Throttling due to external power brake assertion trigger (reducing core clocks by a factor of 2 or more) in ns",
+		Unit:        UnitNanoseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1425: {
+		ID:          1425,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ENABLED",
+		Description: "Enablement (0/DISABLED or 1/ENABLED)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1426: {
+		ID:          1426,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_PRIV_LVL",
+		Description: "Current privilege level",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1427: {
+		ID:          1427,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_IMM_RAMP_DOWN_ENABLED",
+		Description: "Immediate ramp down enablement (0/DISABLED or 1/ENABLED)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1428: {
+		ID:          1428,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_APPLIED_TMP_CEIL_WATTS",
+		Description: "Applied TMP ceiling value in Watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1429: {
+		ID:          1429,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_APPLIED_TMP_FLOOR_WATTS",
+		Description: "Applied TMP floor value in Watts",
+		Unit:        UnitWatts,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1430: {
+		ID:          1430,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_MAX_PERCENT_TMP_FLOOR_SETTING",
+		Description: "Max % TMP Floor value",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1431: {
+		ID:          1431,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_MIN_PERCENT_TMP_FLOOR_SETTING",
+		Description: "Min % TMP Floor value",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1432: {
+		ID:          1432,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_HW_CIRCUITRY_PERCENT_LIFETIME_REMAINING",
+		Description: "HW Circuitry % lifetime remaining",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1433: {
+		ID:          1433,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_MAX_PRESET_PROFILES",
+		Description: "Max number of preset profiles",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1434: {
+		ID:          1434,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_PROFILE_PERCENT_TMP_FLOOR",
+		Description: "% TMP floor for a given profile",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1435: {
+		ID:          1435,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_PROFILE_RAMP_UP_RATE",
+		Description: "Ramp up rate in mW/s for a given profile",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1436: {
+		ID:          1436,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_PROFILE_RAMP_DOWN_RATE",
+		Description: "Ramp down rate in mW/s for a given profile",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1437: {
+		ID:          1437,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_PROFILE_RAMP_DOWN_HYST_VAL",
+		Description: "Ramp down hysteresis value in ms for a given profile",
+		Unit:        UnitMilliseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1438: {
+		ID:          1438,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ACTIVE_PRESET_PROFILE",
+		Description: "Active preset profile number",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1439: {
+		ID:          1439,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ADMIN_OVERRIDE_PERCENT_TMP_FLOOR",
+		Description: "% TMP floor for a given profile",
+		Unit:        UnitPercent,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1440: {
+		ID:          1440,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_UP_RATE",
+		Description: "Ramp up rate in mW/s for a given profile",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1441: {
+		ID:          1441,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_RATE",
+		Description: "Ramp down rate in mW/s for a given profile",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1442: {
+		ID:          1442,
+		Name:        "DCGM_FI_DEV_PWR_SMOOTHING_ADMIN_OVERRIDE_RAMP_DOWN_HYST_VAL",
+		Description: "Ramp down hysteresis value in ms for a given profile",
+		Unit:        UnitMilliseconds,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1501: {
+		ID:          1501,
+		Name:        "DCGM_FI_DEV_PCIE_CORRECTABLE_ERROR_TOTAL",
+		Description: "PCIe Correctable Errors Counter",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1502: {
+		ID:          1502,
+		Name:        "DCGM_FI_IMEX_DOMAIN_STATUS",
+		Description: "IMEX domain status (UP, DOWN, DEGRADED) Retrieved from nvidia-imex-ctl -N -j command",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindGauge,
+	},
+	1503: {
+		ID:          1503,
+		Name:        "DCGM_FI_IMEX_DAEMON_STATUS",
+		Description: "IMEX daemon status (0-7 numeric values) Retrieved from nvidia-imex-ctl -q command Values: INITIALIZING=0, STARTING_AUTH_SERVER=1, WAITING_FOR_PEERS=2, WAITING_FOR_RECOVERY=3, INIT_GPU=4, READY=5, SHUTTING_DOWN=6, UNAVAILABLE=7",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_NONE,
+		Kind:        FieldKindGauge,
+	},
+	1507: {
+		ID:          1507,
+		Name:        "DCGM_FI_DEV_MEMORY_UNREPAIRABLE",
+		Description: "Unrepairable memory flag indicating if memory has unrepairable errors 1=yes, 0=no",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1508: {
+		ID:          1508,
+		Name:        "DCGM_FI_DEV_NVLINK_LINK_STATUS",
+		Description: "NVLink State (see NVML_FI_DEV_NVLINK_GET_STATE for return values) This field expects a dcgm_link_t entity to specify the GPU and link index. Use DCGM_FE_LINK entity group when accessing this field.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	1509: {
+		ID:          1509,
+		Name:        "DCGM_FI_DEV_NVLINK_PPCNT_IBPC_TX_WAIT_TOTAL",
+		Description: "InfiniBand Port Counter: Port Transmit Wait (see NVML_PRM_COUNTER_ID_PPCNT_PORTCOUNTERS_PORT_XMIT_WAIT for details) This field expects a dcgm_link_t entity to specify the GPU and link index. Use DCGM_FE_LINK entity group when accessing this field.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1523: {
+		ID:          1523,
+		Name:        "DCGM_FI_DEV_GPU_RECOVERY_ACTION",
+		Description: "GPU Recovery Action (see nvmlDeviceGpuRecoveryAction_t for return values)",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1524: {
+		ID:          1524,
+		Name:        "DCGM_FI_DEV_NVSWITCH_FIRMWARE_VERSION",
+		Description: "NVSwitch firmware version string",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_STRING,
+		EntityLevel: FE_SWITCH,
+		Kind:        FieldKindInfo,
+	},
+	1525: {
+		ID:          1525,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_PER_LINK_TOTAL",
+		Description: "Per-link NVLink metrics keyed by a dcgm_link_t entity. Unlike the legacy DCGM_FI_DEV_NVLINK_*_L fields (one field id per link), a single field id below covers every link. The caller selects the GPU and link by passing a dcgm_link_t entity using the DCGM_FE_LINK entity group, so these fields scale to any number of links (e.g. 36 on Rubin) without new field ids. The legacy L0-L17 fields are retained as aliases for backward compatibility.",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1526: {
+		ID:          1526,
+		Name:        "DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_PER_LINK_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1527: {
+		ID:          1527,
+		Name:        "DCGM_FI_DEV_NVLINK_REPLAY_ERROR_PER_LINK_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1528: {
+		ID:          1528,
+		Name:        "DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_PER_LINK_TOTAL",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1529: {
+		ID:          1529,
+		Name:        "DCGM_FI_DEV_NVLINK_THROUGHPUT_PER_LINK",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1530: {
+		ID:          1530,
+		Name:        "DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_PER_LINK",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1531: {
+		ID:          1531,
+		Name:        "DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_PER_LINK",
+		Description: "",
+		Unit:        UnitNone,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindCounter,
+	},
+	1532: {
+		ID:          1532,
+		Name:        "DCGM_FI_PROF_NVLINK_TX_BYTES_PER_LINK",
+		Description: "Per-link NVLink GPM counters, keyed by a dcgm_link_t entity (DCGM_FE_LINK entity group). The link index carried in the entity selects the underlying NVML GPM per-link metric, so a single field id covers every link. Distinct from the GPU-wide DCGM_FI_PROF_NVLINK_TX/RX_BYTES.",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	1533: {
+		ID:          1533,
+		Name:        "DCGM_FI_PROF_NVLINK_RX_BYTES_PER_LINK",
+		Description: "",
+		Unit:        UnitBytesPerSecond,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_LINK,
+		Kind:        FieldKindGauge,
+	},
+	1600: {
+		ID:          1600,
+		Name:        "DCGM_FI_DEV_BAR1_CAPACITY_BYTES",
+		Description: "Total BAR1 capacity of the GPU in bytes",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1601: {
+		ID:          1601,
+		Name:        "DCGM_FI_DEV_BAR1_USED_BYTES",
+		Description: "Used BAR1 of the GPU in bytes",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1602: {
+		ID:          1602,
+		Name:        "DCGM_FI_DEV_BAR1_FREE_BYTES",
+		Description: "Free BAR1 of the GPU in bytes",
+		Unit:        UnitBytes,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1603: {
+		ID:          1603,
+		Name:        "DCGM_FI_DEV_SM_CLOCK_HERTZ",
+		Description: "SM clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1604: {
+		ID:          1604,
+		Name:        "DCGM_FI_DEV_MEMORY_CLOCK_HERTZ",
+		Description: "Memory clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1605: {
+		ID:          1605,
+		Name:        "DCGM_FI_DEV_VIDEO_CLOCK_HERTZ",
+		Description: "Video encoder/decoder clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1606: {
+		ID:          1606,
+		Name:        "DCGM_FI_DEV_SM_APP_CLOCK_HERTZ",
+		Description: "SM application clock target for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1607: {
+		ID:          1607,
+		Name:        "DCGM_FI_DEV_MEMORY_APP_CLOCK_HERTZ",
+		Description: "Memory application clock target for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1608: {
+		ID:          1608,
+		Name:        "DCGM_FI_DEV_SM_MAX_CLOCK_HERTZ",
+		Description: "Maximum supported SM clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1609: {
+		ID:          1609,
+		Name:        "DCGM_FI_DEV_MEMORY_MAX_CLOCK_HERTZ",
+		Description: "Maximum supported memory clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1610: {
+		ID:          1610,
+		Name:        "DCGM_FI_DEV_VIDEO_MAX_CLOCK_HERTZ",
+		Description: "Maximum supported video encoder/decoder clock for the device in hertz",
+		Unit:        UnitHertz,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+	1611: {
+		ID:          1611,
+		Name:        "DCGM_FI_DEV_GPU_ENERGY_JOULES_TOTAL",
+		Description: "Total GPU energy consumption in joules since the driver was last reloaded",
+		Unit:        UnitJoules,
+		Type:        DCGM_FT_INT64,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindCounter,
+	},
+	1612: {
+		ID:          1612,
+		Name:        "DCGM_FI_DEV_FAN_SPEED_RATIO",
+		Description: "Fan speed for the device as a ratio of maximum noise-tolerance fan speed (0.0-1.0+)",
+		Unit:        UnitRatio,
+		Type:        DCGM_FT_DOUBLE,
+		EntityLevel: FE_GPU,
+		Kind:        FieldKindGauge,
+	},
+}
+
 // GetFieldID returns the DCGM field ID for a given field name and whether it was found
 // It first checks the current field IDs, then falls back to legacy field IDs if not found
 func GetFieldID(fieldName string) (Short, bool) {
@@ -3178,3 +9050,20 @@ func IsCurrentField(fieldName string) bool {
 	_, ok := dcgmFields[fieldName]
 	return ok
 }
+
+// GetFieldInfo returns the static metadata for a field ID and whether it was found
+// It does not require libdcgm to be loaded
+func GetFieldInfo(fieldID Short) (FieldInfo, bool) {
+	info, ok := fieldInfos[fieldID]
+	return info, ok
+}
+
+// GetFieldInfoByName returns the static metadata for a field name and whether it was found
+// Legacy and deprecated names resolve to the metadata of the current field
+func GetFieldInfoByName(fieldName string) (FieldInfo, bool) {
+	fieldID, ok := GetFieldID(fieldName)
+	if !ok {
+		return FieldInfo{}, false
+	}
+	return GetFieldInfo(fieldID)
+}
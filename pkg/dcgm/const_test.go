@@ -4,6 +4,7 @@ import (
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -88,6 +89,58 @@ func TestSelectedErrorCategoryConstantsMatchCHeader(t *testing.T) {
 	}
 }
 
+func TestHealthCheckErrorTableMatchesCHeader(t *testing.T) {
+	for name, value := range dcgmErrorConstants(t) {
+		if strings.HasPrefix(name, "DCGM_FR_EC_") || name == "DCGM_FR_ERROR_SENTINEL" {
+			continue
+		}
+		code := HealthCheckErrorCode(value)
+		if got := code.String(); got != name {
+			t.Errorf("HealthCheckErrorCode(%d).String() = %q, want %q", value, got, name)
+		}
+		if meta, ok := StaticErrorMeta(code); !ok || meta.ErrorID != code {
+			t.Errorf("StaticErrorMeta(%s) = %+v, %v", name, meta, ok)
+		}
+	}
+}
+
+func TestHealthCheckErrorCodeMethods(t *testing.T) {
+	code := DCGM_FR_PCI_REPLAY_RATE
+	if got := code.String(); got != "DCGM_FR_PCI_REPLAY_RATE" {
+		t.Fatalf("String() = %q", got)
+	}
+	if got := code.Severity(); got != DCGM_ERROR_ISOLATE {
+		t.Fatalf("Severity() = %d, want %d", got, DCGM_ERROR_ISOLATE)
+	}
+	if got := code.Category(); got != DCGM_FR_EC_HARDWARE_PCIE {
+		t.Fatalf("Category() = %d, want %d", got, DCGM_FR_EC_HARDWARE_PCIE)
+	}
+	if got := code.MessageFormat(); got != "Detected more than %u PCIe replays per minute for GPU %u : %d" {
+		t.Fatalf("MessageFormat() = %q", got)
+	}
+	if got := code.Suggestion(); !strings.HasPrefix(got, "Reconnect PCIe card.") {
+		t.Fatalf("Suggestion() = %q", got)
+	}
+
+	if got := DCGM_FR_CLOCK_THROTTLE_THERMAL.String(); got != "DCGM_FR_CLOCKS_EVENT_THERMAL" {
+		t.Fatalf("deprecated alias String() = %q", got)
+	}
+
+	unknown := DCGM_FR_ERROR_SENTINEL + 1
+	if got := unknown.String(); got != "HealthCheckErrorCode(135)" {
+		t.Fatalf("unknown String() = %q", got)
+	}
+	if got := unknown.Severity(); got != DCGM_ERROR_UNKNOWN {
+		t.Fatalf("unknown Severity() = %d, want %d", got, DCGM_ERROR_UNKNOWN)
+	}
+	if got := unknown.Category(); got != DCGM_FR_EC_NONE {
+		t.Fatalf("unknown Category() = %d, want %d", got, DCGM_FR_EC_NONE)
+	}
+	if _, ok := StaticErrorMeta(DCGM_FR_ERROR_SENTINEL); ok {
+		t.Fatal("StaticErrorMeta(DCGM_FR_ERROR_SENTINEL) should not be found")
+	}
+}
+
 func dcgmStructsStatusCodes(t *testing.T) map[string]int {
 	t.Helper()
 
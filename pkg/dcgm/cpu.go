@@ -100,6 +100,11 @@ type CPUHierarchyCPU_v2 struct {
 	Serial string
 }
 
+// Cores returns the cores owned by the CPU
+func (c CPUHierarchyCPU_v2) Cores() CPUSet {
+	return cpuSetFromMask(c.OwnedCores)
+}
+
 // CPUHierarchy_v2 represents version 2 of the CPU hierarchy information.
 type CPUHierarchy_v2 struct {
 	// Version is the version number of the hierarchy structure
@@ -110,6 +115,17 @@ type CPUHierarchy_v2 struct {
 	CPUs [MAX_NUM_CPUS]CPUHierarchyCPU_v2
 }
 
+// Owners returns the CPUIDs of the CPUs owning any of the cores
+func (h CPUHierarchy_v2) Owners(cores CPUSet) []uint {
+	var owners []uint
+	for _, cpu := range h.CPUs[:min(h.NumCPUs, MAX_NUM_CPUS)] {
+		if cpu.Cores().Intersection(cores).Len() > 0 {
+			owners = append(owners, cpu.CPUID)
+		}
+	}
+	return owners
+}
+
 // GetCPUHierarchy_v2 retrieves version 2 CPU hierarchy information from DCGM.
 func GetCPUHierarchy_v2() (hierarchy CPUHierarchy_v2, err error) {
 	var cHierarchy C.dcgmCpuHierarchy_v2
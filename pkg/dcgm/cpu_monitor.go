@@ -0,0 +1,259 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+)
+
+// CPUHealthSystems are the health watch systems DCGM checks for CPUs
+const CPUHealthSystems = DCGM_HEALTH_WATCH_THERMAL | DCGM_HEALTH_WATCH_POWER
+
+// CPUInfo is a CPU managed by DCGM and the cores it owns
+type CPUInfo struct {
+	// CPU is the FE_CPU entity ID of the CPU
+	CPU uint
+	// Serial is the serial number of the CPU
+	Serial string
+	// Cores are the FE_CPU_CORE entity IDs of the cores owned by the CPU
+	Cores CPUSet
+}
+
+// Entities returns the FE_CPU entity of the CPU followed by the FE_CPU_CORE
+// entities of its cores
+func (c CPUInfo) Entities() []GroupEntityPair {
+	cores := c.Cores.List()
+	entities := make([]GroupEntityPair, 0, 1+len(cores))
+	entities = append(entities, GroupEntityPair{EntityGroupId: FE_CPU, EntityId: c.CPU})
+	for _, core := range cores {
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_CPU_CORE, EntityId: core})
+	}
+	return entities
+}
+
+// CPUSample is the status of the watched CPUs at one point in time, as sent
+// by WatchCPUs
+type CPUSample struct {
+	// Timestamp is when the values were read
+	Timestamp time.Time
+	// CPUs are the status of the CPUs, in the order they were watched
+	CPUs []CPUStatus
+	// Err is set when the values could not be read
+	Err error
+}
+
+// CPUHealth is the health of the CPUs
+type CPUHealth struct {
+	// Watches are the health watch systems enabled for the CPUs
+	Watches HealthSystem
+	// CPUs are the health reports of the CPUs, in the order they were checked
+	CPUs []EntityHealth
+}
+
+// getCPUs lists the CPUs of the CPU hierarchy
+func getCPUs() ([]CPUInfo, error) {
+	hierarchy, err := GetCPUHierarchy_v2()
+	if err != nil {
+		return nil, err
+	}
+	return cpuInfos(hierarchy), nil
+}
+
+func cpuInfos(hierarchy CPUHierarchy_v2) []CPUInfo {
+	cpus := hierarchy.CPUs[:min(hierarchy.NumCPUs, MAX_NUM_CPUS)]
+	infos := make([]CPUInfo, len(cpus))
+	for i, cpu := range cpus {
+		infos[i] = CPUInfo{CPU: cpu.CPUID, Serial: cpu.Serial, Cores: cpu.Cores()}
+	}
+	return infos
+}
+
+func cpuEntities(cpus []CPUInfo) []GroupEntityPair {
+	var entities []GroupEntityPair
+	for _, cpu := range cpus {
+		entities = append(entities, cpu.Entities()...)
+	}
+	return entities
+}
+
+// newCPUGroup creates a group of the CPUs and, when withCores is set, of their
+// cores
+func newCPUGroup(name string, cpus []CPUInfo, withCores bool) (GroupHandle, error) {
+	group, err := CreateGroup(fmt.Sprintf("%s%d", name, rand.Uint64()))
+	if err != nil {
+		return GroupHandle{}, err
+	}
+
+	for _, cpu := range cpus {
+		entities := cpu.Entities()
+		if !withCores {
+			entities = entities[:1]
+		}
+		for _, entity := range entities {
+			if err := AddEntityToGroup(group, entity.EntityGroupId, entity.EntityId); err != nil {
+				_ = DestroyGroup(group)
+				return GroupHandle{}, err
+			}
+		}
+	}
+	return group, nil
+}
+
+// watchCPUs watches the power, temperature, clock and utilization fields of
+// the CPUs and their cores, sampled every interval
+func watchCPUs(ctx context.Context, cpus []CPUInfo, interval time.Duration) (<-chan CPUSample, error) {
+	if interval <= 0 {
+		return nil, errors.New("CPU watch interval must be positive")
+	}
+	if len(cpus) == 0 {
+		return nil, errors.New("no CPU to watch")
+	}
+
+	fieldsID, err := FieldGroupCreate(fmt.Sprintf("cpuFields%d", rand.Uint64()), cpuStatusFields)
+	if err != nil {
+		return nil, err
+	}
+	group, err := newCPUGroup("cpus", cpus, true)
+	if err != nil {
+		_ = FieldGroupDestroy(fieldsID)
+		return nil, err
+	}
+	destroy := func() {
+		if ret := DestroyGroup(group); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+		if ret := FieldGroupDestroy(fieldsID); ret != nil {
+			log.Printf("error destroying field group: %v", ret)
+		}
+	}
+
+	if err := WatchFieldsWithGroupEx(fieldsID, group, interval.Microseconds(), defaultMaxKeepAge, defaultMaxKeepSamples); err != nil {
+		destroy()
+		return nil, err
+	}
+	// The watches stay on the entities after their group is destroyed, so
+	// they are removed first
+	cleanup := func() {
+		if ret := UnwatchFields(fieldsID, group); ret != nil {
+			log.Printf("error unwatching CPU fields: %v", ret)
+		}
+		destroy()
+	}
+
+	entities := cpuEntities(cpus)
+	read := func() ([]TypedValue, error) {
+		fieldValues, err := EntitiesGetLatestValues(entities, cpuStatusFields, 0)
+		if err != nil {
+			return nil, fmt.Errorf("error getting CPU status: %w", err)
+		}
+		values := make([]TypedValue, len(fieldValues))
+		for i, value := range fieldValues {
+			values[i] = value.TypedValue()
+		}
+		return values, nil
+	}
+
+	samples := make(chan CPUSample)
+	go func() {
+		defer cleanup()
+		sampleCPUs(ctx, samples, cpus, read, interval)
+	}()
+	return samples, nil
+}
+
+// sampleCPUs sends a sample of the CPUs every interval until the context is
+// canceled, then closes the channel
+func sampleCPUs(ctx context.Context, samples chan<- CPUSample, cpus []CPUInfo, read func() ([]TypedValue, error), interval time.Duration) {
+	defer close(samples)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		values, err := read()
+		sample := newCPUSample(cpus, values, err, time.Now())
+
+		select {
+		case samples <- sample:
+		case <-ctx.Done():
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func newCPUSample(cpus []CPUInfo, values []TypedValue, err error, now time.Time) CPUSample {
+	if err != nil {
+		return CPUSample{Timestamp: now, Err: err}
+	}
+	sample := CPUSample{Timestamp: now, CPUs: make([]CPUStatus, len(cpus))}
+	for i, cpu := range cpus {
+		sample.CPUs[i] = newCPUStatus(cpu.CPU, cpu.Cores.List(), values)
+	}
+	return sample
+}
+
+// enableCPUHealth creates a group of the CPUs and enables the CPU health
+// watches on it
+func enableCPUHealth(cpus []CPUInfo) (GroupHandle, error) {
+	if len(cpus) == 0 {
+		return GroupHandle{}, errors.New("no CPU to check")
+	}
+
+	group, err := newCPUGroup("cpuHealth", cpus, false)
+	if err != nil {
+		return GroupHandle{}, err
+	}
+	if err := HealthSet(group, CPUHealthSystems); err != nil {
+		if ret := DestroyGroup(group); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+		return GroupHandle{}, err
+	}
+	return group, nil
+}
+
+// checkCPUHealth checks the health watches of a group of the CPUs
+func checkCPUHealth(group GroupHandle, cpus []CPUInfo) (CPUHealth, error) {
+	watches, err := HealthGet(group)
+	if err != nil {
+		return CPUHealth{}, err
+	}
+	response, err := HealthCheck(group)
+	if err != nil {
+		return CPUHealth{}, err
+	}
+	return newCPUHealth(cpus, watches, response), nil
+}
+
+// newCPUHealth returns a report for every CPU, passing when DCGM reported no
+// incident for it
+func newCPUHealth(cpus []CPUInfo, watches HealthSystem, response HealthResponse) CPUHealth {
+	reports := make(map[uint]EntityHealth)
+	for _, report := range response.Reports() {
+		if report.Entity.EntityGroupId == FE_CPU {
+			reports[report.Entity.EntityId] = report
+		}
+	}
+
+	health := CPUHealth{Watches: watches, CPUs: make([]EntityHealth, len(cpus))}
+	for i, cpu := range cpus {
+		report, ok := reports[cpu.CPU]
+		if !ok {
+			report = EntityHealth{
+				Entity:  GroupEntityPair{EntityGroupId: FE_CPU, EntityId: cpu.CPU},
+				Overall: DCGM_HEALTH_RESULT_PASS,
+			}
+		}
+		health.CPUs[i] = report
+	}
+	return health
+}
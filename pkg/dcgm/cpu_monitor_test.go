@@ -0,0 +1,79 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUInfos(t *testing.T) {
+	hierarchy := CPUHierarchy_v2{NumCPUs: 2}
+	hierarchy.CPUs[0] = CPUHierarchyCPU_v2{CPUID: 0, OwnedCores: []uint64{0b111}, Serial: "0x1"}
+	hierarchy.CPUs[1] = CPUHierarchyCPU_v2{CPUID: 1, OwnedCores: []uint64{0, 0b11}, Serial: "0x2"}
+
+	cpus := cpuInfos(hierarchy)
+	require.Len(t, cpus, 2)
+	assert.Equal(t, "0-2", cpus[0].Cores.String())
+	assert.Equal(t, "64-65", cpus[1].Cores.String())
+	assert.Equal(t, "0x2", cpus[1].Serial)
+	assert.Equal(t, []GroupEntityPair{{FE_CPU, 1}, {FE_CPU_CORE, 64}, {FE_CPU_CORE, 65}}, cpus[1].Entities())
+	assert.Len(t, cpuEntities(cpus), 7)
+}
+
+func TestSampleCPUs(t *testing.T) {
+	cpus := []CPUInfo{{CPU: 0, Cores: NewCPUSet(0, 1)}}
+	power := entityTestValue(GroupEntityPair{FE_CPU, 0}, DCGM_FI_DEV_CPU_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(150))
+
+	reads := 0
+	read := func() ([]TypedValue, error) {
+		reads++
+		if reads == 2 {
+			return nil, errors.New("read failed")
+		}
+		return []TypedValue{power}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	samples := make(chan CPUSample)
+	go sampleCPUs(ctx, samples, cpus, read, time.Millisecond)
+
+	sample := <-samples
+	require.NoError(t, sample.Err)
+	require.Len(t, sample.CPUs, 1)
+	assert.InDelta(t, 150, sample.CPUs[0].Power, 0)
+	assert.Len(t, sample.CPUs[0].Cores, 2)
+
+	sample = <-samples
+	require.Error(t, sample.Err)
+	assert.Empty(t, sample.CPUs)
+
+	cancel()
+	for range samples {
+		// drain until the sampler closes the channel
+	}
+}
+
+func TestNewCPUHealth(t *testing.T) {
+	cpus := []CPUInfo{{CPU: 0}, {CPU: 1}}
+	response := HealthResponse{
+		OverallHealth: DCGM_HEALTH_RESULT_WARN,
+		Incidents: []Incident{{
+			System:     DCGM_HEALTH_WATCH_THERMAL,
+			Health:     DCGM_HEALTH_RESULT_WARN,
+			Error:      DiagErrorDetail{Message: "CPU 1 is too hot"},
+			EntityInfo: GroupEntityPair{FE_CPU, 1},
+		}},
+	}
+
+	health := newCPUHealth(cpus, CPUHealthSystems, response)
+	assert.Equal(t, "thermal|power", health.Watches.String())
+	require.Len(t, health.CPUs, 2)
+	assert.Equal(t, EntityHealth{Entity: GroupEntityPair{FE_CPU, 0}, Overall: DCGM_HEALTH_RESULT_PASS}, health.CPUs[0])
+	assert.Equal(t, DCGM_HEALTH_RESULT_WARN, health.CPUs[1].Overall)
+	require.Len(t, health.CPUs[1].Incidents, 1)
+	assert.Equal(t, "CPU 1 is too hot", health.CPUs[1].Incidents[0].Message)
+}
@@ -0,0 +1,153 @@
+package dcgm
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+// maxCPUSetCPU bounds the CPU numbers of a parsed cpulist
+const maxCPUSetCPU = 1 << 16
+
+// CPUSet is a set of CPU numbers, as used for CPU affinity and the cores owned
+// by a CPU. The zero value is an empty set.
+type CPUSet struct {
+	bits *bitset.BitSet
+}
+
+// NewCPUSet returns the set of the given CPUs
+func NewCPUSet(cpus ...uint) CPUSet {
+	bits := bitset.New(0)
+	for _, cpu := range cpus {
+		bits.Set(cpu)
+	}
+	return CPUSet{bits: bits}
+}
+
+// cpuSetFromMask returns the set of a bitmask of 64-bit words, CPU 0 being
+// the lowest bit of the first word
+func cpuSetFromMask(mask []uint64) CPUSet {
+	return CPUSet{bits: bitset.From(slices.Clone(mask))}
+}
+
+// ParseCPUList parses a Linux cpulist such as "0-15,32-47", as found in
+// /sys/devices/system/node/node0/cpulist. An empty list is an empty set.
+func ParseCPUList(list string) (CPUSet, error) {
+	set := NewCPUSet()
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return set, nil
+	}
+
+	for item := range strings.SplitSeq(list, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(item), "-")
+		from, err := parseCPU(first)
+		if err != nil {
+			return CPUSet{}, fmt.Errorf("invalid cpulist %q: %w", list, err)
+		}
+		to := from
+		if isRange {
+			to, err = parseCPU(last)
+			if err != nil {
+				return CPUSet{}, fmt.Errorf("invalid cpulist %q: %w", list, err)
+			}
+			if to < from {
+				return CPUSet{}, fmt.Errorf("invalid cpulist %q: range %s is reversed", list, item)
+			}
+		}
+		for cpu := from; cpu <= to; cpu++ {
+			set.bits.Set(cpu)
+		}
+	}
+	return set, nil
+}
+
+func parseCPU(s string) (uint, error) {
+	cpu, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid CPU %q", s)
+	}
+	if cpu >= maxCPUSetCPU {
+		return 0, fmt.Errorf("CPU %d exceeds the limit of %d CPUs", cpu, maxCPUSetCPU)
+	}
+	return uint(cpu), nil
+}
+
+// Contains reports whether the CPU is in the set
+func (s CPUSet) Contains(cpu uint) bool {
+	return s.bits != nil && s.bits.Test(cpu)
+}
+
+// Len returns the number of CPUs in the set
+func (s CPUSet) Len() int {
+	if s.bits == nil {
+		return 0
+	}
+	return int(s.bits.Count())
+}
+
+// List returns the CPUs of the set in increasing order
+func (s CPUSet) List() []uint {
+	if s.bits == nil {
+		return nil
+	}
+	list := make([]uint, 0, s.bits.Count())
+	for i, ok := s.bits.NextSet(0); ok; i, ok = s.bits.NextSet(i + 1) {
+		list = append(list, i)
+	}
+	return list
+}
+
+// Intersection returns the CPUs that are in both sets
+func (s CPUSet) Intersection(other CPUSet) CPUSet {
+	if s.bits == nil || other.bits == nil {
+		return NewCPUSet()
+	}
+	return CPUSet{bits: s.bits.Intersection(other.bits)}
+}
+
+// Equal reports whether both sets have the same CPUs
+func (s CPUSet) Equal(other CPUSet) bool {
+	return slices.Equal(s.List(), other.List())
+}
+
+// String formats the set as a Linux cpulist, e.g. "0-15,32-47"
+func (s CPUSet) String() string {
+	return formatCPUList(s.List())
+}
+
+// MarshalText encodes the set as a Linux cpulist
+func (s CPUSet) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a Linux cpulist
+func (s *CPUSet) UnmarshalText(text []byte) error {
+	set, err := ParseCPUList(string(text))
+	if err != nil {
+		return err
+	}
+	*s = set
+	return nil
+}
+
+// formatCPUList formats sorted CPU numbers as a Linux cpulist
+func formatCPUList(cpus []uint) string {
+	var ranges []string
+	for i := 0; i < len(cpus); {
+		j := i
+		for j+1 < len(cpus) && cpus[j+1] == cpus[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, strconv.FormatUint(uint64(cpus[i]), 10))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", cpus[i], cpus[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ",")
+}
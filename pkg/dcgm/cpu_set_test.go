@@ -0,0 +1,72 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUSet(t *testing.T) {
+	var empty CPUSet
+	assert.False(t, empty.Contains(0))
+	assert.Empty(t, empty.List())
+	assert.Empty(t, empty.String())
+	assert.Equal(t, 0, empty.Intersection(NewCPUSet(1)).Len())
+
+	set := NewCPUSet(7, 1, 3, 4)
+	assert.True(t, set.Contains(3))
+	assert.False(t, set.Contains(2))
+	assert.False(t, set.Contains(1000))
+	assert.Equal(t, 4, set.Len())
+	assert.Equal(t, []uint{1, 3, 4, 7}, set.List())
+	assert.Equal(t, "1,3-4,7", set.String())
+	assert.Equal(t, []uint{3, 7}, set.Intersection(NewCPUSet(0, 3, 7, 64)).List())
+	assert.True(t, set.Equal(NewCPUSet(1, 3, 4, 7)))
+	assert.False(t, set.Equal(empty))
+}
+
+func TestCPUSetFromMask(t *testing.T) {
+	set := cpuSetFromMask([]uint64{0xffff, 0, 0x3})
+	assert.Equal(t, "0-15,128-129", set.String())
+	assert.True(t, CPUHierarchyCPU_v2{OwnedCores: []uint64{0xffff}}.Cores().Contains(15))
+}
+
+func TestParseCPUList(t *testing.T) {
+	set, err := ParseCPUList(" 0-15,32-47 ")
+	require.NoError(t, err)
+	assert.Equal(t, 32, set.Len())
+	assert.True(t, set.Contains(32))
+	assert.False(t, set.Contains(16))
+	assert.Equal(t, "0-15,32-47", set.String())
+
+	set, err = ParseCPUList("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, set.Len())
+
+	for _, list := range []string{"a", "3-1", "1,,2", "0-", "-1", "70000"} {
+		_, err := ParseCPUList(list)
+		assert.Error(t, err, list)
+	}
+}
+
+func TestCPUSetJSON(t *testing.T) {
+	b, err := json.Marshal(struct{ CPUs CPUSet }{NewCPUSet(0, 1, 2, 8)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"CPUs":"0-2,8"}`, string(b))
+
+	var decoded struct{ CPUs CPUSet }
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, []uint{0, 1, 2, 8}, decoded.CPUs.List())
+}
+
+func TestCPUHierarchyOwners(t *testing.T) {
+	hierarchy := CPUHierarchy_v2{NumCPUs: 2}
+	hierarchy.CPUs[0] = CPUHierarchyCPU_v2{CPUID: 0, OwnedCores: []uint64{0xff}}
+	hierarchy.CPUs[1] = CPUHierarchyCPU_v2{CPUID: 1, OwnedCores: []uint64{0xff00}}
+
+	assert.Equal(t, []uint{1}, hierarchy.Owners(NewCPUSet(8, 9)))
+	assert.Equal(t, []uint{0, 1}, hierarchy.Owners(NewCPUSet(7, 8)))
+	assert.Empty(t, hierarchy.Owners(NewCPUSet(64)))
+}
@@ -11,8 +11,6 @@ import (
 	"log"
 	"math/rand"
 	"unsafe"
-
-	"github.com/bits-and-blooms/bitset"
 )
 
 // PCIInfo contains PCI bus related information for a GPU device
@@ -43,6 +41,8 @@ type Device struct {
 	Identifiers   DeviceIdentifiers
 	Topology      []P2PLink
 	CPUAffinity   string
+	// CPUs is CPUAffinity as a set
+	CPUs CPUSet
 }
 
 // NvLinkP2PStatus represents the state of NvLinks between the GPU pairs
@@ -141,6 +141,12 @@ func getPciBandwidth(gpuID uint) (int64, error) {
 	_ = FieldGroupDestroy(fieldsID)
 	_ = DestroyGroup(groupID)
 
+	return pciBandwidth(gen, width), nil
+}
+
+// pciBandwidth returns the bandwidth in MB/s of a PCIe link of the given
+// generation and width
+func pciBandwidth(gen, width int64) int64 {
 	genMap := map[int64]int64{
 		1: 250, // MB/s
 		2: 500,
@@ -148,30 +154,76 @@ func getPciBandwidth(gpuID uint) (int64, error) {
 		4: 1969,
 	}
 
-	bandwidth := genMap[gen] * width
-	return bandwidth, nil
+	return genMap[gen] * width
 }
 
-func getCPUAffinity(gpuID uint) (string, error) {
-	const (
-		affinity0 int = iota
-		affinity1
-		affinity2
-		affinity3
-		fieldsCount
-	)
+// GPUAffinity describes the CPUs and memory close to a GPU, e.g. to pin the
+// processes using the GPU next to it
+type GPUAffinity struct {
+	GPU uint
+	// CPUs are the CPUs close to the GPU. DCGM reports the affinity of the
+	// first 256 CPUs only.
+	CPUs CPUSet
+	// MemoryNodes are the NUMA nodes of the memory close to the GPU
+	MemoryNodes []uint
+	// NUMANode is the first of MemoryNodes, -1 when DCGM does not report the
+	// memory affinity
+	NUMANode int
+	// CPUIDs are the CPUs of GetCPUHierarchy_v2 owning the cores of CPUs. It
+	// is empty when DCGM does not manage the CPUs of the system.
+	CPUIDs []uint
+}
+
+func getGPUAffinity(gpuID uint) (GPUAffinity, error) {
+	cpus, memory, err := getAffinityMasks(gpuID)
+	if err != nil {
+		return GPUAffinity{}, err
+	}
 
-	affFields := make([]Short, fieldsCount)
-	affFields[affinity0] = C.DCGM_FI_DEV_CPU_AFFINITY_0
-	affFields[affinity1] = C.DCGM_FI_DEV_CPU_AFFINITY_1
-	affFields[affinity2] = C.DCGM_FI_DEV_CPU_AFFINITY_2
-	affFields[affinity3] = C.DCGM_FI_DEV_CPU_AFFINITY_3
+	var hierarchy *CPUHierarchy_v2
+	if h, err := GetCPUHierarchy_v2(); err == nil {
+		hierarchy = &h
+	}
+	return newGPUAffinity(gpuID, cpus, memory, hierarchy), nil
+}
 
+// newGPUAffinity correlates the affinity of a GPU with the CPU hierarchy, which
+// is nil when DCGM does not manage the CPUs
+func newGPUAffinity(gpuID uint, cpus, memory CPUSet, hierarchy *CPUHierarchy_v2) GPUAffinity {
+	affinity := GPUAffinity{
+		GPU:         gpuID,
+		CPUs:        cpus,
+		MemoryNodes: memory.List(),
+		NUMANode:    -1,
+	}
+	if len(affinity.MemoryNodes) > 0 {
+		affinity.NUMANode = int(affinity.MemoryNodes[0])
+	}
+	if hierarchy != nil {
+		affinity.CPUIDs = hierarchy.Owners(cpus)
+	}
+	return affinity
+}
+
+// affinityFields are the CPU and memory node affinity fields of a GPU
+var affinityFields = []Short{
+	DCGM_FI_DEV_CPU_AFFINITY_0,
+	DCGM_FI_DEV_CPU_AFFINITY_1,
+	DCGM_FI_DEV_CPU_AFFINITY_2,
+	DCGM_FI_DEV_CPU_AFFINITY_3,
+	DCGM_FI_DEV_MEMORY_AFFINITY_0,
+	DCGM_FI_DEV_MEMORY_AFFINITY_1,
+	DCGM_FI_DEV_MEMORY_AFFINITY_2,
+	DCGM_FI_DEV_MEMORY_AFFINITY_3,
+}
+
+// getAffinityMasks reads the CPU and memory node affinity masks of the GPU
+func getAffinityMasks(gpuID uint) (cpus, memory CPUSet, err error) {
 	fieldsName := fmt.Sprintf("cpuAffFields%d", rand.Uint64())
 
-	fieldsId, err := FieldGroupCreate(fieldsName, affFields)
+	fieldsId, err := FieldGroupCreate(fieldsName, affinityFields)
 	if err != nil {
-		return "N/A", err
+		return cpus, memory, err
 	}
 	defer func() {
 		ret := FieldGroupDestroy(fieldsId)
@@ -184,7 +236,7 @@ func getCPUAffinity(gpuID uint) (string, error) {
 	groupName := fmt.Sprintf("cpuAff%d", rand.Uint64())
 	groupID, err := WatchFields(gpuID, fieldsId, groupName)
 	if err != nil {
-		return "N/A", err
+		return cpus, memory, err
 	}
 	defer func() {
 		ret := DestroyGroup(groupID)
@@ -194,20 +246,32 @@ func getCPUAffinity(gpuID uint) (string, error) {
 		}
 	}()
 
-	values, err := GetLatestValuesForFields(gpuID, affFields)
+	values, err := GetLatestValuesForFields(gpuID, affinityFields)
 	if err != nil {
-		return "N/A", fmt.Errorf("error getting cpu affinity: %s", err)
+		return cpus, memory, fmt.Errorf("error getting cpu affinity: %s", err)
 	}
 
-	bits := make([]uint64, 4)
-	bits[0] = uint64(values[affinity0].Int64())
-	bits[1] = uint64(values[affinity1].Int64())
-	bits[2] = uint64(values[affinity2].Int64())
-	bits[3] = uint64(values[affinity3].Int64())
+	typed := make([]TypedValue, len(values))
+	for i := range values {
+		typed[i] = values[i].TypedValue()
+	}
+	cpus, memory = affinitySets(typed)
+	return cpus, memory, nil
+}
 
-	b := bitset.From(bits)
+// affinitySets decodes the values of the four CPU affinity fields followed by
+// the four memory affinity fields. Memory affinity is not reported on every
+// system; missing words are empty.
+func affinitySets(values []TypedValue) (cpus, memory CPUSet) {
+	const masksCount = 4
 
-	return b.String(), nil
+	masks := make([]uint64, 2*masksCount)
+	for i := range min(len(values), len(masks)) {
+		if word, ok := values[i].Int64(); ok {
+			masks[i] = uint64(word)
+		}
+	}
+	return cpuSetFromMask(masks[:masksCount]), cpuSetFromMask(masks[masksCount:])
 }
 
 func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
@@ -244,14 +308,16 @@ func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 		topology    []P2PLink
 		bandwidth   int64
 		cpuAffinity string
+		cpus        CPUSet
 	)
 
 	// get device topology and bandwidth only if its a DCGM supported device
 	if supported == "Yes" {
-		cpuAffinity, err = getCPUAffinity(gpuID)
+		cpus, _, err = getAffinityMasks(gpuID)
 		if err != nil {
 			return
 		}
+		cpuAffinity = cpus.bits.String()
 
 		topology, err = getDeviceTopology(gpuID)
 		if err != nil {
@@ -291,6 +357,7 @@ func getDeviceInfo(gpuID uint) (deviceInfo Device, err error) {
 		Identifiers:   identifiers,
 		Topology:      topology,
 		CPUAffinity:   cpuAffinity,
+		CPUs:          cpus,
 	}
 	return
 }
@@ -33,28 +33,38 @@ const (
 	EntityStatusDetached EntityStatus = 7
 )
 
+var entityStatusNames = newEnumNames("entity status", map[EntityStatus]string{
+	EntityStatusUnknown:      "Unknown",
+	EntityStatusOk:           "OK",
+	EntityStatusUnsupported:  "Unsupported",
+	EntityStatusInaccessible: "Inaccessible",
+	EntityStatusLost:         "Lost",
+	EntityStatusFake:         "Fake",
+	EntityStatusDisabled:     "Disabled",
+	EntityStatusDetached:     "Detached",
+})
+
 // String returns a string representation of the entity status
 func (e EntityStatus) String() string {
-	switch e {
-	case EntityStatusUnknown:
-		return "Unknown"
-	case EntityStatusOk:
-		return "OK"
-	case EntityStatusUnsupported:
-		return "Unsupported"
-	case EntityStatusInaccessible:
-		return "Inaccessible"
-	case EntityStatusLost:
-		return "Lost"
-	case EntityStatusFake:
-		return "Fake"
-	case EntityStatusDisabled:
-		return "Disabled"
-	case EntityStatusDetached:
-		return "Detached"
-	default:
-		return fmt.Sprintf("Unknown(%d)", e)
+	if name, ok := entityStatusNames.name(e); ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(%d)", e)
+}
+
+// MarshalText encodes the entity status as its name
+func (e EntityStatus) MarshalText() ([]byte, error) {
+	return entityStatusNames.text(e), nil
+}
+
+// UnmarshalText decodes an entity status name or number
+func (e *EntityStatus) UnmarshalText(text []byte) error {
+	status, err := entityStatusNames.parse(text)
+	if err != nil {
+		return err
 	}
+	*e = status
+	return nil
 }
 
 // PerfState represents the performance state (P-state) of a GPU
@@ -71,14 +81,45 @@ const (
 	PerfStateUnknown = 32
 )
 
+var perfStateNames = newEnumNames("performance state", newPerfStateNameMap())
+
+func newPerfStateNameMap() map[PerfState]string {
+	names := map[PerfState]string{PerfStateUnknown: "Unknown"}
+	for p := PerfState(PerfStateMax); p <= PerfStateMin; p++ {
+		names[p] = fmt.Sprintf("P%d", p)
+	}
+	return names
+}
+
 // String returns a string representation of the performance state
 func (p PerfState) String() string {
-	if p >= PerfStateMax && p <= PerfStateMin {
-		return fmt.Sprintf("P%d", p)
+	if name, ok := perfStateNames.name(p); ok {
+		return name
 	}
 	return "Unknown"
 }
 
+// MarshalText encodes the performance state as its name, such as "P0"
+func (p PerfState) MarshalText() ([]byte, error) {
+	return perfStateNames.text(p), nil
+}
+
+// UnmarshalText decodes a performance state name or number
+func (p *PerfState) UnmarshalText(text []byte) error {
+	state, err := perfStateNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*p = state
+	return nil
+}
+
+// Faster reports whether the performance state performs better than other.
+// P0 is the fastest state; unknown states are slower than every known state.
+func (p PerfState) Faster(other PerfState) bool {
+	return p <= PerfStateMin && p < other
+}
+
 // UtilizationInfo contains GPU utilization metrics
 type UtilizationInfo struct {
 	GPU     int64 // %
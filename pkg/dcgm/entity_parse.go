@@ -0,0 +1,160 @@
+package dcgm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxParsedEntities bounds the number of entities of a range of ParseEntities
+const maxParsedEntities = 1 << 16
+
+// entityPrefixes are the entity group prefixes of the dcgmi entity syntax
+var entityPrefixes = map[string]Field_Entity_Group{
+	"gpu":      FE_GPU,
+	"g":        FE_GPU,
+	"i":        FE_GPU_I,
+	"gpu_i":    FE_GPU_I,
+	"c":        FE_GPU_CI,
+	"gpu_ci":   FE_GPU_CI,
+	"n":        FE_SWITCH,
+	"nvswitch": FE_SWITCH,
+	"l":        FE_LINK,
+	"link":     FE_LINK,
+	"cpu":      FE_CPU,
+	"core":     FE_CPU_CORE,
+	"cx":       FE_CONNECTX,
+	"vgpu":     FE_VGPU,
+}
+
+// ParseEntity parses a single entity in the dcgmi syntax, e.g. "0" or "gpu:0"
+// for a GPU, "i:0" for a GPU instance, "c:1" for a compute instance, "n:0"
+// for an NVSwitch, "cpu:0", "core:3" or "cx:0". A range such as "{2}" must
+// name exactly one entity; use ParseEntities for lists and larger ranges.
+func ParseEntity(s string) (GroupEntityPair, error) {
+	entities, err := ParseEntities(s)
+	if err != nil {
+		return GroupEntityPair{}, err
+	}
+	if len(entities) != 1 {
+		return GroupEntityPair{}, fmt.Errorf("entity %q names %d entities", s, len(entities))
+	}
+	return entities[0], nil
+}
+
+// ParseEntities parses a comma separated list of entities in the dcgmi
+// syntax. The IDs of an entity may be a range such as "{0-3}" or a list such
+// as "{0,2}", e.g. "gpu:{0-3},i:{0,1}".
+func ParseEntities(s string) ([]GroupEntityPair, error) {
+	items, err := splitEntityList(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []GroupEntityPair
+	for _, item := range items {
+		group := FE_GPU
+		ids := item
+		if prefix, rest, ok := strings.Cut(item, ":"); ok {
+			group, ok = entityPrefixes[strings.ToLower(strings.TrimSpace(prefix))]
+			if !ok {
+				return nil, fmt.Errorf("invalid entity %q: unknown entity group %q", item, prefix)
+			}
+			ids = rest
+		}
+
+		parsed, err := parseEntityIDs(strings.TrimSpace(ids))
+		if err != nil {
+			return nil, fmt.Errorf("invalid entity %q: %w", item, err)
+		}
+		if len(entities)+len(parsed) > maxParsedEntities {
+			return nil, fmt.Errorf("entity list %q exceeds the limit of %d entities", s, maxParsedEntities)
+		}
+		for _, id := range parsed {
+			entities = append(entities, GroupEntityPair{EntityGroupId: group, EntityId: id})
+		}
+	}
+	return entities, nil
+}
+
+// splitEntityList splits a list of entities on the commas outside braces
+func splitEntityList(s string) ([]string, error) {
+	var items []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+		if depth < 0 || depth > 1 {
+			return nil, fmt.Errorf("invalid entity list %q: unbalanced braces", s)
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid entity list %q: unbalanced braces", s)
+	}
+	items = append(items, strings.TrimSpace(s[start:]))
+
+	for _, item := range items {
+		if item == "" {
+			return nil, fmt.Errorf("invalid entity list %q: empty entity", s)
+		}
+	}
+	return items, nil
+}
+
+// parseEntityIDs parses an entity ID or a braced list of IDs and ranges
+func parseEntityIDs(s string) ([]uint, error) {
+	inner, ok := strings.CutPrefix(s, "{")
+	if !ok {
+		id, err := parseEntityID(s)
+		if err != nil {
+			return nil, err
+		}
+		return []uint{id}, nil
+	}
+	inner, ok = strings.CutSuffix(inner, "}")
+	if !ok {
+		return nil, fmt.Errorf("unterminated range %q", s)
+	}
+
+	var ids []uint
+	for part := range strings.SplitSeq(inner, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		from, err := parseEntityID(first)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			if to, err = parseEntityID(last); err != nil {
+				return nil, err
+			}
+			if to < from {
+				return nil, fmt.Errorf("range %q is reversed", part)
+			}
+		}
+		if to-from >= maxParsedEntities {
+			return nil, fmt.Errorf("range %q exceeds the limit of %d entities", part, maxParsedEntities)
+		}
+		for id := from; id <= to; id++ {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func parseEntityID(s string) (uint, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid entity ID %q", s)
+	}
+	return uint(id), nil
+}
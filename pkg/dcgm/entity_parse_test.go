@@ -0,0 +1,47 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEntity(t *testing.T) {
+	for s, want := range map[string]GroupEntityPair{
+		"0":         {FE_GPU, 0},
+		"gpu:3":     {FE_GPU, 3},
+		"i:0":       {FE_GPU_I, 0},
+		"c:1":       {FE_GPU_CI, 1},
+		"n:2":       {FE_SWITCH, 2},
+		"cpu:1":     {FE_CPU, 1},
+		"core:17":   {FE_CPU_CORE, 17},
+		"cx:0":      {FE_CONNECTX, 0},
+		" GPU : 4 ": {FE_GPU, 4},
+		"i:{5}":     {FE_GPU_I, 5},
+	} {
+		entity, err := ParseEntity(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, entity, s)
+	}
+
+	for _, s := range []string{"", "x:0", "gpu:", "gpu:-1", "{0-3}", "0,1", "i:{1"} {
+		_, err := ParseEntity(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseEntities(t *testing.T) {
+	entities, err := ParseEntities("{0-2},i:{0,3},c:1")
+	require.NoError(t, err)
+	assert.Equal(t, []GroupEntityPair{
+		{FE_GPU, 0}, {FE_GPU, 1}, {FE_GPU, 2},
+		{FE_GPU_I, 0}, {FE_GPU_I, 3},
+		{FE_GPU_CI, 1},
+	}, entities)
+
+	for _, s := range []string{"0,,1", "{3-1}", "{0-1}}", "{{0}}", "gpu:{0-99999}"} {
+		_, err := ParseEntities(s)
+		require.Error(t, err, s)
+	}
+}
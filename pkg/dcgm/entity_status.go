@@ -0,0 +1,363 @@
+package dcgm
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+)
+
+// EntityStatusInfo is the current status of an entity, as returned by
+// GetEntityStatus. The concrete type depends on the entity group:
+//   - FE_GPU: GPUEntityStatus
+//   - FE_GPU_I and FE_GPU_CI: MigInstanceStatus
+//   - FE_SWITCH: NvSwitchStatus
+//   - FE_CPU: CPUStatus
+//   - FE_CPU_CORE: CPUCoreStatus
+type EntityStatusInfo interface {
+	// Entity returns the entity the status is about
+	Entity() GroupEntityPair
+}
+
+// GPUEntityStatus is the status of a GPU
+type GPUEntityStatus struct {
+	GPU uint
+	DeviceStatus
+}
+
+// Entity returns the FE_GPU entity of the status
+func (s GPUEntityStatus) Entity() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_GPU, EntityId: s.GPU}
+}
+
+// MigUtilizationInfo contains the profiling utilization of a MIG instance, as
+// ratios between 0 and 1. They are 0 when profiling is unavailable.
+type MigUtilizationInfo struct {
+	GraphicsEngine float64
+	SM             float64
+	DRAM           float64
+}
+
+// MigMemoryInfo contains the frame buffer usage of a MIG instance
+type MigMemoryInfo struct {
+	Used  int64 // MB
+	Free  int64 // MB
+	Total int64 // MB
+}
+
+// MigInstanceStatus is the status of a GPU instance or a compute instance
+type MigInstanceStatus struct {
+	// Instance is the FE_GPU_I or FE_GPU_CI entity
+	Instance    GroupEntityPair
+	Utilization MigUtilizationInfo
+	Memory      MigMemoryInfo
+}
+
+// Entity returns the MIG instance entity of the status
+func (s MigInstanceStatus) Entity() GroupEntityPair {
+	return s.Instance
+}
+
+// NvSwitchStatus is the status of an NVSwitch
+type NvSwitchStatus struct {
+	Switch           uint
+	Temperature      int64 // °C
+	SlowdownTemp     int64 // °C
+	ThroughputTx     int64
+	ThroughputRx     int64
+	PowerVDD         float64 // W
+	PowerDVDD        float64 // W
+	PowerHVDD        float64 // W
+	LastFatalSXid    int64   // 0 when none was reported
+	LastNonFatalSXid int64   // 0 when none was reported
+}
+
+// Entity returns the FE_SWITCH entity of the status
+func (s NvSwitchStatus) Entity() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: s.Switch}
+}
+
+// CPUUtilizationInfo contains the utilization of a CPU or core as ratios of
+// time between 0 and 1
+type CPUUtilizationInfo struct {
+	Total  float64
+	User   float64
+	Nice   float64
+	System float64
+	IRQ    float64
+}
+
+// CPUCoreStatus is the status of a CPU core
+type CPUCoreStatus struct {
+	Core        uint
+	Utilization CPUUtilizationInfo
+	// Clock is the instantaneous clock speed of the core
+	Clock int64
+}
+
+// Entity returns the FE_CPU_CORE entity of the status
+func (s CPUCoreStatus) Entity() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_CPU_CORE, EntityId: s.Core}
+}
+
+// CPUStatus is the status of a CPU and of its cores
+type CPUStatus struct {
+	CPU          uint
+	Power        float64 // W
+	PowerLimit   float64 // W
+	Temperature  float64 // °C
+	WarningTemp  float64 // °C
+	CriticalTemp float64 // °C
+	Utilization  CPUUtilizationInfo
+	// Cores are the cores owned by the CPU, ordered by core ID
+	Cores []CPUCoreStatus
+}
+
+// Entity returns the FE_CPU entity of the status
+func (s CPUStatus) Entity() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_CPU, EntityId: s.CPU}
+}
+
+var (
+	migMemoryFields = []Short{
+		DCGM_FI_DEV_FB_USED,
+		DCGM_FI_DEV_FB_FREE,
+		DCGM_FI_DEV_FB_TOTAL,
+	}
+
+	// migProfilingFields are watched in their own group, as they cannot be
+	// watched when profiling is unavailable
+	migProfilingFields = []Short{
+		DCGM_FI_PROF_GR_ENGINE_UTIL_RATIO,
+		DCGM_FI_PROF_SM_UTIL_RATIO,
+		DCGM_FI_PROF_DRAM_UTIL_RATIO,
+	}
+
+	nvSwitchStatusFields = []Short{
+		DCGM_FI_DEV_NVSWITCH_TEMP_CELSIUS,
+		DCGM_FI_DEV_NVSWITCH_TEMP_SLOWDOWN_CELSIUS,
+		DCGM_FI_DEV_NVSWITCH_THROUGHPUT_TX,
+		DCGM_FI_DEV_NVSWITCH_THROUGHPUT_RX,
+		DCGM_FI_DEV_NVSWITCH_POWER_VDD_WATTS,
+		DCGM_FI_DEV_NVSWITCH_POWER_DVDD_WATTS,
+		DCGM_FI_DEV_NVSWITCH_POWER_HVDD_WATTS,
+		DCGM_FI_DEV_SXID_FATAL_ERROR,
+		DCGM_FI_DEV_SXID_NON_FATAL_ERROR,
+	}
+
+	cpuUtilizationFields = []Short{
+		DCGM_FI_DEV_CPU_UTIL_RATIO,
+		DCGM_FI_DEV_CPU_UTIL_USER_RATIO,
+		DCGM_FI_DEV_CPU_UTIL_NICE_RATIO,
+		DCGM_FI_DEV_CPU_UTIL_SYS_RATIO,
+		DCGM_FI_DEV_CPU_UTIL_IRQ_RATIO,
+	}
+
+	cpuCoreStatusFields = append([]Short{DCGM_FI_DEV_CPU_CLOCK_CURRENT}, cpuUtilizationFields...)
+
+	// cpuStatusFields are read for a CPU and its cores at once
+	cpuStatusFields = append([]Short{
+		DCGM_FI_DEV_CPU_POWER_WATTS,
+		DCGM_FI_DEV_CPU_POWER_LIMIT_WATTS,
+		DCGM_FI_DEV_CPU_TEMP_CELSIUS,
+		DCGM_FI_DEV_CPU_TEMP_WARNING_CELSIUS,
+		DCGM_FI_DEV_CPU_TEMP_CRITICAL_CELSIUS,
+	}, cpuCoreStatusFields...)
+)
+
+func getEntityStatus(entity GroupEntityPair) (EntityStatusInfo, error) {
+	switch entity.EntityGroupId {
+	case FE_GPU:
+		status, err := latestValuesForDevice(entity.EntityId)
+		if err != nil {
+			return nil, err
+		}
+		return GPUEntityStatus{GPU: entity.EntityId, DeviceStatus: status}, nil
+	case FE_GPU_I, FE_GPU_CI:
+		values, err := entityStatusValues([]GroupEntityPair{entity}, migMemoryFields)
+		if err != nil {
+			return nil, err
+		}
+		// Without the profiling module, on SKUs without profiling or while
+		// profiling is paused, the utilization is left at 0
+		if profiling, err := entityStatusValues([]GroupEntityPair{entity}, migProfilingFields); err == nil {
+			values = append(values, profiling...)
+		}
+		return newMigInstanceStatus(entity, values), nil
+	case FE_SWITCH:
+		values, err := entityStatusValues([]GroupEntityPair{entity}, nvSwitchStatusFields)
+		if err != nil {
+			return nil, err
+		}
+		return newNvSwitchStatus(entity.EntityId, values), nil
+	case FE_CPU:
+		cpus, err := getCPUs()
+		if err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(cpus, func(cpu CPUInfo) bool { return cpu.CPU == entity.EntityId })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown CPU %d", entity.EntityId)
+		}
+
+		values, err := entityStatusValues(cpus[i].Entities(), cpuStatusFields)
+		if err != nil {
+			return nil, err
+		}
+		return newCPUStatus(entity.EntityId, cpus[i].Cores.List(), values), nil
+	case FE_CPU_CORE:
+		values, err := entityStatusValues([]GroupEntityPair{entity}, cpuCoreStatusFields)
+		if err != nil {
+			return nil, err
+		}
+		return newCPUCoreStatus(entity.EntityId, values), nil
+	default:
+		return nil, fmt.Errorf("entity group %s has no status", entity.EntityGroupId)
+	}
+}
+
+// entityStatusValues watches the fields of the entities with a single group
+// and reads their latest values
+func entityStatusValues(entities []GroupEntityPair, fields []Short) (entityValues, error) {
+	fieldsID, err := FieldGroupCreate(fmt.Sprintf("entityStatusFields%d", rand.Uint64()), fields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := FieldGroupDestroy(fieldsID); ret != nil {
+			log.Printf("error destroying field group: %v", ret)
+		}
+	}()
+
+	groupID, err := CreateGroup(fmt.Sprintf("entityStatus%d", rand.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := DestroyGroup(groupID); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+	}()
+
+	for _, entity := range entities {
+		if err := AddEntityToGroup(groupID, entity.EntityGroupId, entity.EntityId); err != nil {
+			return nil, err
+		}
+	}
+	if err := WatchFieldsWithGroup(fieldsID, groupID); err != nil {
+		return nil, err
+	}
+	// The watches stay on the entities after their group is destroyed
+	defer func() {
+		if ret := UnwatchFields(fieldsID, groupID); ret != nil {
+			log.Printf("error unwatching entity status fields: %v", ret)
+		}
+	}()
+
+	fieldValues, err := EntitiesGetLatestValues(entities, fields, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error getting entity status: %w", err)
+	}
+	values := make(entityValues, len(fieldValues))
+	for i, value := range fieldValues {
+		values[i] = value.TypedValue()
+	}
+	return values, nil
+}
+
+// entityValues are the values of the fields of several entities. Fields
+// without data read as 0.
+type entityValues []TypedValue
+
+func (v entityValues) value(entity GroupEntityPair, field Short) (TypedValue, bool) {
+	i := slices.IndexFunc(v, func(value TypedValue) bool {
+		return value.EntityGroupId == entity.EntityGroupId && value.EntityID == entity.EntityId && value.FieldID == field
+	})
+	if i < 0 {
+		return TypedValue{}, false
+	}
+	return v[i], true
+}
+
+func (v entityValues) number(entity GroupEntityPair, field Short) float64 {
+	value, _ := v.value(entity, field)
+	n, _ := value.Number()
+	return n
+}
+
+func (v entityValues) integer(entity GroupEntityPair, field Short) int64 {
+	value, _ := v.value(entity, field)
+	if n, ok := value.Int64(); ok {
+		return n
+	}
+	n, _ := value.Number()
+	return int64(n)
+}
+
+func (v entityValues) cpuUtilization(entity GroupEntityPair) CPUUtilizationInfo {
+	return CPUUtilizationInfo{
+		Total:  v.number(entity, DCGM_FI_DEV_CPU_UTIL_RATIO),
+		User:   v.number(entity, DCGM_FI_DEV_CPU_UTIL_USER_RATIO),
+		Nice:   v.number(entity, DCGM_FI_DEV_CPU_UTIL_NICE_RATIO),
+		System: v.number(entity, DCGM_FI_DEV_CPU_UTIL_SYS_RATIO),
+		IRQ:    v.number(entity, DCGM_FI_DEV_CPU_UTIL_IRQ_RATIO),
+	}
+}
+
+func newMigInstanceStatus(entity GroupEntityPair, values entityValues) MigInstanceStatus {
+	return MigInstanceStatus{
+		Instance: entity,
+		Utilization: MigUtilizationInfo{
+			GraphicsEngine: values.number(entity, DCGM_FI_PROF_GR_ENGINE_UTIL_RATIO),
+			SM:             values.number(entity, DCGM_FI_PROF_SM_UTIL_RATIO),
+			DRAM:           values.number(entity, DCGM_FI_PROF_DRAM_UTIL_RATIO),
+		},
+		Memory: MigMemoryInfo{
+			Used:  values.integer(entity, DCGM_FI_DEV_FB_USED),
+			Free:  values.integer(entity, DCGM_FI_DEV_FB_FREE),
+			Total: values.integer(entity, DCGM_FI_DEV_FB_TOTAL),
+		},
+	}
+}
+
+func newNvSwitchStatus(switchID uint, values entityValues) NvSwitchStatus {
+	entity := GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: switchID}
+	return NvSwitchStatus{
+		Switch:           switchID,
+		Temperature:      values.integer(entity, DCGM_FI_DEV_NVSWITCH_TEMP_CELSIUS),
+		SlowdownTemp:     values.integer(entity, DCGM_FI_DEV_NVSWITCH_TEMP_SLOWDOWN_CELSIUS),
+		ThroughputTx:     values.integer(entity, DCGM_FI_DEV_NVSWITCH_THROUGHPUT_TX),
+		ThroughputRx:     values.integer(entity, DCGM_FI_DEV_NVSWITCH_THROUGHPUT_RX),
+		PowerVDD:         values.number(entity, DCGM_FI_DEV_NVSWITCH_POWER_VDD_WATTS),
+		PowerDVDD:        values.number(entity, DCGM_FI_DEV_NVSWITCH_POWER_DVDD_WATTS),
+		PowerHVDD:        values.number(entity, DCGM_FI_DEV_NVSWITCH_POWER_HVDD_WATTS),
+		LastFatalSXid:    values.integer(entity, DCGM_FI_DEV_SXID_FATAL_ERROR),
+		LastNonFatalSXid: values.integer(entity, DCGM_FI_DEV_SXID_NON_FATAL_ERROR),
+	}
+}
+
+func newCPUCoreStatus(core uint, values entityValues) CPUCoreStatus {
+	entity := GroupEntityPair{EntityGroupId: FE_CPU_CORE, EntityId: core}
+	return CPUCoreStatus{
+		Core:        core,
+		Utilization: values.cpuUtilization(entity),
+		Clock:       values.integer(entity, DCGM_FI_DEV_CPU_CLOCK_CURRENT),
+	}
+}
+
+func newCPUStatus(cpuID uint, cores []uint, values entityValues) CPUStatus {
+	entity := GroupEntityPair{EntityGroupId: FE_CPU, EntityId: cpuID}
+	status := CPUStatus{
+		CPU:          cpuID,
+		Power:        values.number(entity, DCGM_FI_DEV_CPU_POWER_WATTS),
+		PowerLimit:   values.number(entity, DCGM_FI_DEV_CPU_POWER_LIMIT_WATTS),
+		Temperature:  values.number(entity, DCGM_FI_DEV_CPU_TEMP_CELSIUS),
+		WarningTemp:  values.number(entity, DCGM_FI_DEV_CPU_TEMP_WARNING_CELSIUS),
+		CriticalTemp: values.number(entity, DCGM_FI_DEV_CPU_TEMP_CRITICAL_CELSIUS),
+		Utilization:  values.cpuUtilization(entity),
+	}
+	for _, core := range cores {
+		status.Cores = append(status.Cores, newCPUCoreStatus(core, values))
+	}
+	return status
+}
@@ -0,0 +1,77 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func entityTestValue(entity GroupEntityPair, field Short, fieldType uint, payload []byte) TypedValue {
+	value := typedTestValue(field, fieldType, payload)
+	value.EntityGroupId, value.EntityID = entity.EntityGroupId, entity.EntityId
+	return value.TypedValue()
+}
+
+func TestCPUStatus(t *testing.T) {
+	cpu := GroupEntityPair{FE_CPU, 1}
+	core := GroupEntityPair{FE_CPU_CORE, 73}
+	values := entityValues{
+		entityTestValue(cpu, DCGM_FI_DEV_CPU_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(212.5)),
+		entityTestValue(cpu, DCGM_FI_DEV_CPU_TEMP_CELSIUS, DCGM_FT_DOUBLE, float64Bytes(48)),
+		entityTestValue(cpu, DCGM_FI_DEV_CPU_UTIL_RATIO, DCGM_FT_DOUBLE, float64Bytes(0.25)),
+		entityTestValue(core, DCGM_FI_DEV_CPU_UTIL_RATIO, DCGM_FT_DOUBLE, float64Bytes(0.5)),
+		entityTestValue(core, DCGM_FI_DEV_CPU_UTIL_USER_RATIO, DCGM_FT_DOUBLE, float64Bytes(0.375)),
+		entityTestValue(core, DCGM_FI_DEV_CPU_CLOCK_CURRENT, DCGM_FT_INT64, int64Bytes(3105000)),
+	}
+
+	var status EntityStatusInfo = newCPUStatus(1, []uint{72, 73}, values)
+	assert.Equal(t, cpu, status.Entity())
+
+	cpuStatus, ok := status.(CPUStatus)
+	require.True(t, ok)
+	assert.InDelta(t, 212.5, cpuStatus.Power, 0)
+	assert.InDelta(t, 48, cpuStatus.Temperature, 0)
+	assert.InDelta(t, 0.25, cpuStatus.Utilization.Total, 0)
+	require.Len(t, cpuStatus.Cores, 2)
+	assert.Equal(t, CPUCoreStatus{Core: 72}, cpuStatus.Cores[0], "cores without data read as 0")
+	assert.Equal(t, core, cpuStatus.Cores[1].Entity())
+	assert.Equal(t, CPUUtilizationInfo{Total: 0.5, User: 0.375}, cpuStatus.Cores[1].Utilization)
+	assert.Equal(t, int64(3105000), cpuStatus.Cores[1].Clock)
+}
+
+func TestNvSwitchStatus(t *testing.T) {
+	nvswitch := GroupEntityPair{FE_SWITCH, 2}
+	values := entityValues{
+		entityTestValue(nvswitch, DCGM_FI_DEV_NVSWITCH_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(41)),
+		entityTestValue(nvswitch, DCGM_FI_DEV_NVSWITCH_THROUGHPUT_TX, DCGM_FT_INT64, int64Bytes(1<<40)),
+		entityTestValue(nvswitch, DCGM_FI_DEV_SXID_FATAL_ERROR, DCGM_FT_INT64, int64Bytes(12028)),
+		entityTestValue(GroupEntityPair{FE_SWITCH, 3}, DCGM_FI_DEV_NVSWITCH_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(90)),
+	}
+
+	status := newNvSwitchStatus(2, values)
+	assert.Equal(t, nvswitch, status.Entity())
+	assert.Equal(t, int64(41), status.Temperature)
+	assert.Equal(t, int64(1<<40), status.ThroughputTx)
+	assert.Equal(t, int64(12028), status.LastFatalSXid)
+	assert.Zero(t, status.LastNonFatalSXid)
+}
+
+func TestMigInstanceStatus(t *testing.T) {
+	instance := GroupEntityPair{FE_GPU_CI, 4}
+	values := entityValues{
+		entityTestValue(instance, DCGM_FI_PROF_SM_UTIL_RATIO, DCGM_FT_DOUBLE, float64Bytes(0.75)),
+		entityTestValue(instance, DCGM_FI_DEV_FB_USED, DCGM_FT_INT64, int64Bytes(1024)),
+		entityTestValue(instance, DCGM_FI_DEV_FB_TOTAL, DCGM_FT_INT64, int64Bytes(9856)),
+	}
+
+	status := newMigInstanceStatus(instance, values)
+	assert.Equal(t, instance, status.Entity())
+	assert.Equal(t, MigUtilizationInfo{SM: 0.75}, status.Utilization)
+	assert.Equal(t, MigMemoryInfo{Used: 1024, Total: 9856}, status.Memory)
+
+	// Without profiling only the memory fields are read
+	memoryOnly := newMigInstanceStatus(instance, values[1:])
+	assert.Zero(t, memoryOnly.Utilization)
+	assert.Equal(t, status.Memory, memoryOnly.Memory)
+}
@@ -0,0 +1,53 @@
+package dcgm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// enumNames are the names of the values of an enum type. Every enum type with
+// a table uses it for String, MarshalText and UnmarshalText, so that values
+// without a name, e.g. added by a later DCGM release, still round-trip as
+// their decimal value.
+type enumNames[T ~int | ~uint] struct {
+	kind  string
+	names map[T]string
+}
+
+func newEnumNames[T ~int | ~uint](kind string, names map[T]string) enumNames[T] {
+	return enumNames[T]{kind: kind, names: names}
+}
+
+// name returns the name of the value
+func (e enumNames[T]) name(v T) (string, bool) {
+	name, ok := e.names[v]
+	return name, ok
+}
+
+// text returns the name of the value, or its decimal value when it has none
+func (e enumNames[T]) text(v T) []byte {
+	if name, ok := e.names[v]; ok {
+		return []byte(name)
+	}
+	if v < 0 {
+		return strconv.AppendInt(nil, int64(v), 10)
+	}
+	return strconv.AppendUint(nil, uint64(v), 10)
+}
+
+// parse decodes a name, compared case-insensitively, or a decimal value
+func (e enumNames[T]) parse(text []byte) (T, error) {
+	s := strings.TrimSpace(string(text))
+	for v, name := range e.names {
+		if strings.EqualFold(name, s) {
+			return v, nil
+		}
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if v := T(n); int64(v) == n && (v < 0) == (n < 0) {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid %s %q", e.kind, s)
+}
@@ -0,0 +1,135 @@
+package dcgm
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumText(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   encoding.TextMarshaler
+		decoded encoding.TextUnmarshaler
+		text    string
+	}{
+		{"link state", LS_UP, new(Link_State), "Up"},
+		{"link state not supported", LS_NOT_SUPPORTED, new(Link_State), "NotSupported"},
+		{"unknown link state", Link_State(9), new(Link_State), "9"},
+		{"PCIe link", P2PLinkSingleSwitch, new(P2PLinkType), "PIX"},
+		{"NVLink", nvLinkP2PLinkType(18), new(P2PLinkType), "NV18"},
+		{"unknown link", P2PLinkUnknown, new(P2PLinkType), "N/A"},
+		{"entity group", FE_GPU_CI, new(Field_Entity_Group), "GPU Compute Instance"},
+		{"no entity group", FE_NONE, new(Field_Entity_Group), "None"},
+		{"entity status", EntityStatusOk, new(EntityStatus), "OK"},
+		{"perf state", PerfState(2), new(PerfState), "P2"},
+		{"unknown perf state", PerfState(PerfStateUnknown), new(PerfState), "Unknown"},
+		{"health system", DCGM_HEALTH_WATCH_PCIE | DCGM_HEALTH_WATCH_MEM, new(HealthSystem), "pcie|mem"},
+		{"health result", DCGM_HEALTH_RESULT_WARN, new(HealthResult), "WARN"},
+		{"MIG profile", MigProfileComputeInstanceSlice1Rev1, new(MigProfile), "ComputeInstanceSlice1Rev1"},
+		{"unknown MIG profile", MigProfile(-1), new(MigProfile), "-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.value.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tt.text, string(text))
+
+			require.NoError(t, tt.decoded.UnmarshalText(text))
+			assert.Equal(t, tt.value, deref(tt.decoded))
+		})
+	}
+}
+
+func deref(v encoding.TextUnmarshaler) any {
+	switch v := v.(type) {
+	case *Link_State:
+		return *v
+	case *P2PLinkType:
+		return *v
+	case *Field_Entity_Group:
+		return *v
+	case *EntityStatus:
+		return *v
+	case *PerfState:
+		return *v
+	case *HealthSystem:
+		return *v
+	case *HealthResult:
+		return *v
+	case *MigProfile:
+		return *v
+	}
+	return nil
+}
+
+func TestEnumUnmarshalText(t *testing.T) {
+	var state Link_State
+	require.NoError(t, state.UnmarshalText([]byte("down")))
+	assert.Equal(t, LS_DOWN, state)
+	require.NoError(t, state.UnmarshalText([]byte("3")))
+	assert.Equal(t, LS_UP, state)
+	require.Error(t, state.UnmarshalText([]byte("sideways")))
+	require.Error(t, state.UnmarshalText([]byte("-1")))
+
+	var link P2PLinkType
+	require.NoError(t, link.UnmarshalText([]byte("nv4")))
+	assert.Equal(t, FourNVLINKLinks, link)
+	require.Error(t, link.UnmarshalText([]byte("NV37")))
+
+	var result HealthResult
+	require.NoError(t, result.UnmarshalText([]byte("fail")))
+	assert.Equal(t, DCGM_HEALTH_RESULT_FAIL, result)
+}
+
+func TestEnumString(t *testing.T) {
+	assert.Equal(t, "Up", LS_UP.String())
+	assert.Equal(t, "Link_State(9)", Link_State(9).String())
+	assert.Equal(t, "NV2", TwoNVLINKLinks.String())
+	assert.Equal(t, "N/A", P2PLinkType(100).String())
+	assert.Equal(t, "unknown", FE_COUNT.String())
+	assert.Equal(t, "Unknown(9)", EntityStatus(9).String())
+	assert.Equal(t, "Unknown", PerfState(20).String())
+	assert.Equal(t, "PASS", DCGM_HEALTH_RESULT_PASS.String())
+	assert.Equal(t, "HealthResult(5)", HealthResult(5).String())
+	assert.Equal(t, "None", MigProfileNone.String())
+	assert.Equal(t, "MigProfile(99)", MigProfile(99).String())
+}
+
+func TestEnumComparisons(t *testing.T) {
+	assert.True(t, TwoNVLINKLinks.IsNvLink())
+	assert.False(t, P2PLinkSameBoard.IsNvLink())
+	assert.False(t, P2PLinkType(100).IsNvLink())
+	assert.Equal(t, 0, P2PLinkType(100).BandwidthRank())
+	assert.True(t, SingleNVLINKLink.Faster(P2PLinkSameBoard))
+	assert.True(t, P2PLinkSingleSwitch.Faster(P2PLinkCrossCPU))
+	assert.False(t, P2PLinkUnknown.Faster(P2PLinkCrossCPU))
+
+	assert.True(t, PerfState(0).Faster(PerfState(8)))
+	assert.True(t, PerfState(15).Faster(PerfState(PerfStateUnknown)))
+	assert.False(t, PerfState(PerfStateUnknown).Faster(PerfState(3)))
+
+	assert.True(t, DCGM_HEALTH_RESULT_FAIL.Worse(DCGM_HEALTH_RESULT_WARN))
+	assert.False(t, DCGM_HEALTH_RESULT_PASS.Worse(DCGM_HEALTH_RESULT_PASS))
+}
+
+func TestEnumJSON(t *testing.T) {
+	type status struct {
+		State  Link_State
+		Link   P2PLinkType
+		Group  Field_Entity_Group
+		Health HealthResult
+	}
+	in := status{State: LS_DOWN, Link: ThreeNVLINKLinks, Group: FE_SWITCH, Health: DCGM_HEALTH_RESULT_FAIL}
+
+	b, err := json.Marshal(in)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"State":"Down","Link":"NV3","Group":"NvSwitch","Health":"FAIL"}`, string(b))
+
+	var out status
+	require.NoError(t, json.Unmarshal(b, &out))
+	assert.Equal(t, in, out)
+}
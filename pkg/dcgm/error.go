@@ -1,5 +1,7 @@
 package dcgm
 
+//go:generate go run ../../cmd/gen-errors --error-metadata error_metadata.csv dcgm_errors.h const_errors.go
+
 /*
 #include "dcgm_errors.h"
 */
@@ -43,6 +43,22 @@ func TestSelectedErrorMeta(t *testing.T) {
 	require.Nil(t, GetErrorMeta(HealthCheckErrorCode(math.MaxUint)))
 }
 
+func TestStaticErrorMetaMatchesLibrary(t *testing.T) {
+	cleanup, err := Init(Embedded)
+	require.NoError(t, err)
+	defer cleanup()
+
+	for code := DCGM_FR_OK; code < DCGM_FR_ERROR_SENTINEL; code++ {
+		meta := GetErrorMeta(code)
+		if meta == nil {
+			continue
+		}
+		static, ok := StaticErrorMeta(code)
+		require.True(t, ok, "%s missing from the generated table", code)
+		require.Equal(t, *meta, static, "%s differs from libdcgm; update error_metadata.csv", code)
+	}
+}
+
 func TestGoStringOrEmpty(t *testing.T) {
 	require.Empty(t, goStringOrEmpty(nil))
 }
@@ -0,0 +1,62 @@
+package dcgm
+
+import (
+	"fmt"
+	"sort"
+)
+
+// FieldKind describes how the values of a field behave over time.
+type FieldKind int
+
+const (
+	// FieldKindGauge is a value that can go up and down, such as a temperature
+	FieldKindGauge FieldKind = iota
+	// FieldKindCounter is a monotonically increasing total, such as an error count
+	FieldKindCounter
+	// FieldKindInfo is a descriptive string or binary value, such as a UUID
+	FieldKindInfo
+)
+
+// String returns the name of the field kind.
+func (k FieldKind) String() string {
+	switch k {
+	case FieldKindGauge:
+		return "gauge"
+	case FieldKindCounter:
+		return "counter"
+	case FieldKindInfo:
+		return "info"
+	default:
+		return fmt.Sprintf("FieldKind(%d)", int(k))
+	}
+}
+
+// FieldInfo is the static metadata of a DCGM field, generated from
+// dcgm_fields.h by cmd/gen-fields.
+//
+// Unit, Type, EntityLevel and Kind are inferred from the field name and
+// comment, with curated corrections in field_metadata.csv. FieldGetByID
+// remains the authoritative source for the type reported by a running
+// host engine, but FieldInfo is available without libdcgm loaded.
+type FieldInfo struct {
+	ID          Short
+	Name        string
+	Description string
+	Unit        Unit
+	// Type is one of the DCGM_FT_* field types
+	Type        uint
+	EntityLevel Field_Entity_Group
+	Kind        FieldKind
+}
+
+// FieldInfos returns the static metadata of every known field, ordered by ID.
+func FieldInfos() []FieldInfo {
+	infos := make([]FieldInfo, 0, len(fieldInfos))
+	for _, info := range fieldInfos {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
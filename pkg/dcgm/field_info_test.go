@@ -0,0 +1,49 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFieldInfo(t *testing.T) {
+	info, ok := GetFieldInfo(DCGM_FI_DEV_GPU_TEMP_CELSIUS)
+	require.True(t, ok)
+	assert.Equal(t, "DCGM_FI_DEV_GPU_TEMP_CELSIUS", info.Name)
+	assert.Equal(t, UnitCelsius, info.Unit)
+	assert.Equal(t, uint(DCGM_FT_INT64), info.Type)
+	assert.Equal(t, FE_GPU, info.EntityLevel)
+	assert.Equal(t, FieldKindGauge, info.Kind)
+	assert.NotEmpty(t, info.Description)
+
+	info, ok = GetFieldInfo(DCGM_FI_DEV_GPU_UUID)
+	require.True(t, ok)
+	assert.Equal(t, uint(DCGM_FT_STRING), info.Type)
+	assert.Equal(t, FieldKindInfo, info.Kind)
+
+	_, ok = GetFieldInfo(DCGM_FI_SYSTEM_FIELD_UNKNOWN)
+	assert.False(t, ok, "placeholder IDs have no metadata")
+}
+
+func TestGetFieldInfoByName(t *testing.T) {
+	byID, ok := GetFieldInfo(DCGM_FI_DEV_GPU_TEMP_CELSIUS)
+	require.True(t, ok)
+
+	for _, name := range []string{"DCGM_FI_DEV_GPU_TEMP_CELSIUS", "DCGM_FI_DEV_GPU_TEMP", "dcgm_gpu_temp"} {
+		info, ok := GetFieldInfoByName(name)
+		require.True(t, ok, name)
+		assert.Equal(t, byID, info, name)
+	}
+
+	_, ok = GetFieldInfoByName("DCGM_FI_DOES_NOT_EXIST")
+	assert.False(t, ok)
+}
+
+func TestFieldInfosOrderedByID(t *testing.T) {
+	infos := FieldInfos()
+	require.NotEmpty(t, infos)
+	for i := 1; i < len(infos); i++ {
+		assert.Less(t, infos[i-1].ID, infos[i].ID)
+	}
+}
@@ -1,6 +1,6 @@
 package dcgm
 
-//go:generate go run ../../cmd/gen-fields/main.go ../../cmd/gen-fields/template.go --legacy-fields legacy_fields.csv dcgm_fields.h const_fields.go
+//go:generate go run ../../cmd/gen-fields --legacy-fields legacy_fields.csv --field-metadata field_metadata.csv dcgm_fields.h const_fields.go
 
 /*
 #include "dcgm_agent.h"
@@ -291,12 +291,16 @@ func GetLatestValuesForFields(gpu uint, fields []Short) ([]FieldValue_v1, error)
 // fields is a slice of field IDs to retrieve.
 // Returns a slice of field values and any error encountered.
 func LinkGetLatestValues(index uint, parentType Field_Entity_Group, parentId uint, fields []Short) ([]FieldValue_v1, error) {
+	return EntityGetLatestValues(FE_LINK, linkEntityID(index, parentType, parentId), fields)
+}
+
+// linkEntityID returns the FE_LINK entity ID of a link, packed as dcgm_link_t
+func linkEntityID(index uint, parentType Field_Entity_Group, parentId uint) uint {
 	slice := make([]byte, 4)
 	slice[0] = uint8(parentType)
 	binary.LittleEndian.PutUint16(slice[1:3], uint16(index))
 	slice[3] = uint8(parentId)
-	entityId := binary.LittleEndian.Uint32(slice)
-	return EntityGetLatestValues(FE_LINK, uint(entityId), fields)
+	return uint(binary.LittleEndian.Uint32(slice))
 }
 
 // EntityGetLatestValues retrieves the latest values for specified fields of any entity.
@@ -24,7 +24,8 @@ import "C"
 
 import (
 	"fmt"
-	"math/rand"
+	"strconv"
+	"time"
 	"unsafe"
 )
 
@@ -58,6 +59,30 @@ func HealthSet(groupID GroupHandle, systems HealthSystem) (err error) {
 	return nil
 }
 
+// HealthSetWithParams enables the DCGM health check system for the given systems,
+// sampling the underlying health information every updateInterval and keeping it
+// for maxKeepAge. The update interval should match how often HealthCheck is
+// called, and maxKeepAge should be at least the longest time between two calls.
+func HealthSetWithParams(groupID GroupHandle, systems HealthSystem, updateInterval, maxKeepAge time.Duration) error {
+	if updateInterval <= 0 || maxKeepAge <= 0 {
+		return fmt.Errorf("invalid health watch parameters: update interval %v and max keep age %v must be positive",
+			updateInterval, maxKeepAge)
+	}
+
+	var params C.dcgmHealthSetParams_v2
+	params.version = makeVersion2(unsafe.Sizeof(params))
+	params.groupId = groupID.handle
+	params.systems = C.dcgmHealthSystems_t(systems)
+	params.updateInterval = C.longlong(updateInterval.Microseconds())
+	params.maxKeepAge = C.double(maxKeepAge.Seconds())
+
+	result := C.dcgmHealthSet_v2(handle.handle, &params)
+	if err := errorString(result); err != nil {
+		return fmt.Errorf("error setting health watches: %w", err)
+	}
+	return nil
+}
+
 // HealthGet retrieves the current state of the DCGM health check system.
 // It returns which health watch systems are currently enabled for the specified group.
 func HealthGet(groupID GroupHandle) (HealthSystem, error) {
@@ -140,52 +165,64 @@ func HealthCheck(groupID GroupHandle) (HealthResponse, error) {
 }
 
 func healthCheckByGpuId(gpuID uint) (deviceHealth DeviceHealth, err error) {
-	name := fmt.Sprintf("health%d", rand.Uint64())
-	groupID, err := CreateGroup(name)
+	report, err := HealthCheckEntity(GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID})
 	if err != nil {
 		return
 	}
-	defer func() {
-		_ = DestroyGroup(groupID)
-	}()
 
-	err = AddToGroup(groupID, gpuID)
-	if err != nil {
-		return
-	}
-
-	err = HealthSet(groupID, DCGM_HEALTH_WATCH_ALL)
-	if err != nil {
-		return
-	}
-
-	result, err := HealthCheck(groupID)
-	if err != nil {
-		return
-	}
-
-	status := healthStatus(result.OverallHealth)
-
-	// number of watches that encountered error/warning
-	incidents := len(result.Incidents)
-	watches := make([]SystemWatch, incidents)
-
-	for j := 0; j < incidents; j++ {
+	watches := make([]SystemWatch, len(report.Incidents))
+	for j, incident := range report.Incidents {
 		watches[j] = SystemWatch{
-			Type:   systemWatch(result.Incidents[j].System),
-			Status: healthStatus(result.Incidents[j].Health),
-			Error:  result.Incidents[j].Error.Message,
+			Type:   systemWatch(incident.System),
+			Status: healthStatus(incident.Health),
+			Error:  incident.Message,
 		}
 	}
 
 	deviceHealth = DeviceHealth{
 		GPU:     gpuID,
-		Status:  status,
+		Status:  healthStatus(report.Overall),
 		Watches: watches,
 	}
 	return
 }
 
+var healthResultNames = newEnumNames("health result", map[HealthResult]string{
+	DCGM_HEALTH_RESULT_PASS: "PASS",
+	DCGM_HEALTH_RESULT_WARN: "WARN",
+	DCGM_HEALTH_RESULT_FAIL: "FAIL",
+})
+
+// String returns "PASS", "WARN" or "FAIL"
+func (r HealthResult) String() string {
+	if name, ok := healthResultNames.name(r); ok {
+		return name
+	}
+	return "HealthResult(" + strconv.FormatUint(uint64(r), 10) + ")"
+}
+
+// MarshalText encodes the health result as its name
+func (r HealthResult) MarshalText() ([]byte, error) {
+	return healthResultNames.text(r), nil
+}
+
+// UnmarshalText decodes a health result name or number
+func (r *HealthResult) UnmarshalText(text []byte) error {
+	result, err := healthResultNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*r = result
+	return nil
+}
+
+// Worse reports whether the health result is more severe than other
+func (r HealthResult) Worse(other HealthResult) bool {
+	return r > other
+}
+
+// healthStatus is the DeviceHealth status of a health result. It is kept for
+// the legacy DeviceHealth fields; other names of health results use String.
 func healthStatus(status HealthResult) string {
 	switch status {
 	case 0:
@@ -198,6 +235,8 @@ func healthStatus(status HealthResult) string {
 	return "N/A"
 }
 
+// systemWatch is the SystemWatch type of a health system. It is kept for the
+// legacy DeviceHealth fields; other names of health systems use String.
 func systemWatch(watch HealthSystem) string {
 	switch watch {
 	case DCGM_HEALTH_WATCH_PCIE:
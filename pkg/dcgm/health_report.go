@@ -0,0 +1,165 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// HealthIncident is a health check incident together with the static
+// metadata of its error code.
+type HealthIncident struct {
+	// System is the health watch system that detected the incident
+	System HealthSystem
+	// Health is the severity of the incident
+	Health HealthResult
+	// Code identifies the error
+	Code HealthCheckErrorCode
+	// Message is the message reported by DCGM
+	Message string
+	// Meta is the static metadata of Code. It is zero when the code is unknown.
+	Meta ErrorMeta
+}
+
+// EntityHealth is the typed health report of a single entity
+type EntityHealth struct {
+	// Entity is the GPU, NVSwitch or CPU the report is for
+	Entity GroupEntityPair
+	// Overall is the worst result of the incidents, or DCGM_HEALTH_RESULT_PASS without incidents
+	Overall HealthResult
+	// Incidents lists the incidents reported for the entity
+	Incidents []HealthIncident
+}
+
+// newHealthIncident adds the static error metadata to an incident
+func newHealthIncident(incident Incident) HealthIncident {
+	meta, _ := StaticErrorMeta(incident.Error.Code)
+	return HealthIncident{
+		System:  incident.System,
+		Health:  incident.Health,
+		Code:    incident.Error.Code,
+		Message: incident.Error.Message,
+		Meta:    meta,
+	}
+}
+
+// Reports groups the incidents of the response by entity, ordered by entity
+// group and ID. Entities without incidents are not included.
+func (r HealthResponse) Reports() []EntityHealth {
+	reports := make(map[GroupEntityPair]*EntityHealth)
+	for _, incident := range r.Incidents {
+		report, ok := reports[incident.EntityInfo]
+		if !ok {
+			report = &EntityHealth{Entity: incident.EntityInfo, Overall: DCGM_HEALTH_RESULT_PASS}
+			reports[incident.EntityInfo] = report
+		}
+		report.Incidents = append(report.Incidents, newHealthIncident(incident))
+		report.Overall = max(report.Overall, incident.Health)
+	}
+
+	result := make([]EntityHealth, 0, len(reports))
+	for _, report := range reports {
+		result = append(result, *report)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Entity.EntityGroupId != result[j].Entity.EntityGroupId {
+			return result[i].Entity.EntityGroupId < result[j].Entity.EntityGroupId
+		}
+		return result[i].Entity.EntityId < result[j].Entity.EntityId
+	})
+	return result
+}
+
+// HealthCheckEntity enables all health watches on a GPU, NVSwitch or CPU and
+// returns its typed health report.
+func HealthCheckEntity(entity GroupEntityPair) (EntityHealth, error) {
+	switch entity.EntityGroupId {
+	case FE_GPU, FE_SWITCH, FE_CPU:
+	default:
+		return EntityHealth{}, fmt.Errorf("health reports are not supported for entity group %v", entity.EntityGroupId)
+	}
+
+	groupID, err := CreateGroup(fmt.Sprintf("health%d", rand.Uint64()))
+	if err != nil {
+		return EntityHealth{}, err
+	}
+	defer func() {
+		_ = DestroyGroup(groupID)
+	}()
+
+	if err = AddEntityToGroup(groupID, entity.EntityGroupId, entity.EntityId); err != nil {
+		return EntityHealth{}, err
+	}
+
+	if err = HealthSet(groupID, DCGM_HEALTH_WATCH_ALL); err != nil {
+		return EntityHealth{}, err
+	}
+
+	response, err := HealthCheck(groupID)
+	if err != nil {
+		return EntityHealth{}, err
+	}
+
+	report := EntityHealth{Entity: entity, Overall: response.OverallHealth}
+	for _, incident := range response.Incidents {
+		report.Incidents = append(report.Incidents, newHealthIncident(incident))
+	}
+	return report, nil
+}
+
+type healthIncidentJSON struct {
+	System     uint                 `json:"system"`
+	SystemName string               `json:"system_name"`
+	Health     uint                 `json:"health"`
+	HealthName string               `json:"health_name"`
+	Code       HealthCheckErrorCode `json:"code"`
+	CodeName   string               `json:"code_name"`
+	Message    string               `json:"message"`
+	Suggestion string               `json:"suggestion,omitempty"`
+	Severity   ErrorSeverity        `json:"severity"`
+	Category   ErrorCategory        `json:"category"`
+}
+
+// MarshalJSON encodes the incident with both the numeric codes and the names
+// of its system, health result and error code.
+func (i HealthIncident) MarshalJSON() ([]byte, error) {
+	return json.Marshal(healthIncidentJSON{
+		System:     uint(i.System),
+		SystemName: i.System.String(),
+		Health:     uint(i.Health),
+		HealthName: i.Health.String(),
+		Code:       i.Code,
+		CodeName:   i.Code.String(),
+		Message:    i.Message,
+		Suggestion: i.Meta.Suggestion,
+		Severity:   i.Meta.Severity,
+		Category:   i.Meta.Category,
+	})
+}
+
+type entityHealthJSON struct {
+	EntityGroup     uint             `json:"entity_group"`
+	EntityGroupName string           `json:"entity_group_name"`
+	EntityID        uint             `json:"entity_id"`
+	Overall         uint             `json:"overall"`
+	OverallName     string           `json:"overall_name"`
+	Incidents       []HealthIncident `json:"incidents"`
+}
+
+// MarshalJSON encodes the report with both the numeric codes and the names of
+// its entity group and overall result.
+func (h EntityHealth) MarshalJSON() ([]byte, error) {
+	incidents := h.Incidents
+	if incidents == nil {
+		incidents = []HealthIncident{}
+	}
+	return json.Marshal(entityHealthJSON{
+		EntityGroup:     uint(h.Entity.EntityGroupId),
+		EntityGroupName: h.Entity.EntityGroupId.String(),
+		EntityID:        h.Entity.EntityId,
+		Overall:         uint(h.Overall),
+		OverallName:     h.Overall.String(),
+		Incidents:       incidents,
+	})
+}
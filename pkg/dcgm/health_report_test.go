@@ -0,0 +1,101 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthResponseReports(t *testing.T) {
+	gpu1 := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 1}
+	gpu0 := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 0}
+	cpu0 := GroupEntityPair{EntityGroupId: FE_CPU, EntityId: 0}
+
+	response := HealthResponse{
+		OverallHealth: DCGM_HEALTH_RESULT_FAIL,
+		Incidents: []Incident{
+			{
+				System:     DCGM_HEALTH_WATCH_PCIE,
+				Health:     DCGM_HEALTH_RESULT_WARN,
+				Error:      DiagErrorDetail{Message: "replay rate", Code: DCGM_FR_PCI_REPLAY_RATE},
+				EntityInfo: gpu1,
+			},
+			{
+				System:     DCGM_HEALTH_WATCH_THERMAL,
+				Health:     DCGM_HEALTH_RESULT_WARN,
+				Error:      DiagErrorDetail{Code: DCGM_FR_TEMP_VIOLATION},
+				EntityInfo: cpu0,
+			},
+			{
+				System:     DCGM_HEALTH_WATCH_MEM,
+				Health:     DCGM_HEALTH_RESULT_FAIL,
+				Error:      DiagErrorDetail{Code: DCGM_FR_VOLATILE_DBE_DETECTED},
+				EntityInfo: gpu1,
+			},
+			{
+				System:     DCGM_HEALTH_WATCH_PCIE,
+				Health:     DCGM_HEALTH_RESULT_WARN,
+				Error:      DiagErrorDetail{Code: DCGM_FR_PCI_REPLAY_RATE},
+				EntityInfo: gpu0,
+			},
+		},
+	}
+
+	reports := response.Reports()
+	require.Len(t, reports, 3)
+	assert.Equal(t, gpu0, reports[0].Entity)
+	assert.Equal(t, gpu1, reports[1].Entity)
+	assert.Equal(t, cpu0, reports[2].Entity)
+
+	assert.Equal(t, DCGM_HEALTH_RESULT_FAIL, reports[1].Overall)
+	require.Len(t, reports[1].Incidents, 2)
+	assert.Equal(t, DCGM_FR_PCI_REPLAY_RATE, reports[1].Incidents[0].Code)
+	assert.Equal(t, "replay rate", reports[1].Incidents[0].Message)
+	assert.Equal(t, DCGM_FR_PCI_REPLAY_RATE, reports[1].Incidents[0].Meta.ErrorID)
+	assert.Equal(t, DCGM_FR_PCI_REPLAY_RATE.Severity(), reports[1].Incidents[0].Meta.Severity)
+	assert.Equal(t, DCGM_HEALTH_RESULT_WARN, reports[2].Overall)
+
+	assert.Empty(t, HealthResponse{}.Reports())
+}
+
+func TestEntityHealthMarshalJSON(t *testing.T) {
+	report := EntityHealth{
+		Entity:  GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 2},
+		Overall: DCGM_HEALTH_RESULT_WARN,
+		Incidents: []HealthIncident{
+			newHealthIncident(Incident{
+				System: DCGM_HEALTH_WATCH_PCIE,
+				Health: DCGM_HEALTH_RESULT_WARN,
+				Error:  DiagErrorDetail{Message: "replay rate", Code: DCGM_FR_PCI_REPLAY_RATE},
+			}),
+		},
+	}
+
+	data, err := json.Marshal(report)
+	require.NoError(t, err)
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "GPU", decoded["entity_group_name"])
+	assert.EqualValues(t, 2, decoded["entity_id"])
+	assert.EqualValues(t, DCGM_HEALTH_RESULT_WARN, decoded["overall"])
+	assert.Equal(t, "WARN", decoded["overall_name"])
+
+	incidents, ok := decoded["incidents"].([]any)
+	require.True(t, ok)
+	require.Len(t, incidents, 1)
+	incident := incidents[0].(map[string]any)
+	assert.EqualValues(t, DCGM_HEALTH_WATCH_PCIE, incident["system"])
+	assert.Equal(t, "pcie", incident["system_name"])
+	assert.Equal(t, "WARN", incident["health_name"])
+	assert.EqualValues(t, DCGM_FR_PCI_REPLAY_RATE, incident["code"])
+	assert.Equal(t, "DCGM_FR_PCI_REPLAY_RATE", incident["code_name"])
+	assert.Equal(t, "replay rate", incident["message"])
+	assert.EqualValues(t, DCGM_ERROR_ISOLATE, incident["severity"])
+
+	data, err = json.Marshal(EntityHealth{Entity: GroupEntityPair{EntityGroupId: FE_CPU}})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"incidents":[]`)
+}
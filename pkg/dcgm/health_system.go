@@ -0,0 +1,143 @@
+package dcgm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// healthSystemNames maps each individual health watch system to its name
+var healthSystemNames = []struct {
+	system HealthSystem
+	name   string
+}{
+	{DCGM_HEALTH_WATCH_PCIE, "pcie"},
+	{DCGM_HEALTH_WATCH_NVLINK, "nvlink"},
+	{DCGM_HEALTH_WATCH_PMU, "pmu"},
+	{DCGM_HEALTH_WATCH_MCU, "mcu"},
+	{DCGM_HEALTH_WATCH_MEM, "mem"},
+	{DCGM_HEALTH_WATCH_SM, "sm"},
+	{DCGM_HEALTH_WATCH_INFOROM, "inforom"},
+	{DCGM_HEALTH_WATCH_THERMAL, "thermal"},
+	{DCGM_HEALTH_WATCH_POWER, "power"},
+	{DCGM_HEALTH_WATCH_DRIVER, "driver"},
+	{DCGM_HEALTH_WATCH_NVSWITCH_NONFATAL, "nvswitch_nonfatal"},
+	{DCGM_HEALTH_WATCH_NVSWITCH_FATAL, "nvswitch_fatal"},
+	{DCGM_HEALTH_WATCH_CONNECTX, "connectx"},
+	{DCGM_HEALTH_WATCH_IMEX, "imex"},
+}
+
+// AllHealthSystems returns the mask of every health watch system known to this
+// package, without the excluded ones. Unlike DCGM_HEALTH_WATCH_ALL it does not
+// set bits for systems added in later DCGM releases.
+func AllHealthSystems(exclude ...HealthSystem) HealthSystem {
+	var mask HealthSystem
+	for _, s := range healthSystemNames {
+		mask |= s.system
+	}
+	for _, s := range exclude {
+		mask &^= s
+	}
+	return mask
+}
+
+// Systems splits a mask into its individual health watch systems, in bit order.
+// DCGM_HEALTH_WATCH_ALL yields the systems known to this package.
+func (s HealthSystem) Systems() []HealthSystem {
+	if s == DCGM_HEALTH_WATCH_ALL {
+		s = AllHealthSystems()
+	}
+	var systems []HealthSystem
+	for bit := HealthSystem(1); bit != 0 && bit <= s; bit <<= 1 {
+		if s&bit != 0 {
+			systems = append(systems, bit)
+		}
+	}
+	return systems
+}
+
+// String returns the name of the health watch system, such as "pcie". Masks of
+// several systems are joined with "|", DCGM_HEALTH_WATCH_ALL is "all" and an
+// empty mask is "none". Unknown bits are formatted in hexadecimal.
+func (s HealthSystem) String() string {
+	switch s {
+	case 0:
+		return "none"
+	case DCGM_HEALTH_WATCH_ALL:
+		return "all"
+	}
+
+	names := make([]string, 0, 1)
+	for _, system := range s.Systems() {
+		names = append(names, healthSystemName(system))
+	}
+	return strings.Join(names, "|")
+}
+
+func healthSystemName(system HealthSystem) string {
+	for _, s := range healthSystemNames {
+		if s.system == system {
+			return s.name
+		}
+	}
+	return "0x" + strconv.FormatUint(uint64(system), 16)
+}
+
+// ParseHealthSystem parses the names of one or more health watch systems,
+// separated by "|" or ",", into a mask. Names are case-insensitive and may be
+// given as returned by String ("nvlink"), or as the constant name
+// ("DCGM_HEALTH_WATCH_NVLINK"). "all" is DCGM_HEALTH_WATCH_ALL and "none" is
+// the empty mask.
+func ParseHealthSystem(name string) (HealthSystem, error) {
+	parts := strings.FieldsFunc(name, func(r rune) bool { return r == '|' || r == ',' })
+	if len(parts) == 0 {
+		return 0, fmt.Errorf("invalid health watch system %q", name)
+	}
+
+	var mask HealthSystem
+	for _, part := range parts {
+		system, err := parseHealthSystemName(part)
+		if err != nil {
+			return 0, err
+		}
+		mask |= system
+	}
+	return mask, nil
+}
+
+func parseHealthSystemName(name string) (HealthSystem, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "dcgm_health_watch_")
+	switch name {
+	case "all":
+		return DCGM_HEALTH_WATCH_ALL, nil
+	case "none":
+		return 0, nil
+	}
+	for _, s := range healthSystemNames {
+		if s.name == name {
+			return s.system, nil
+		}
+	}
+	if strings.HasPrefix(name, "0x") {
+		if v, err := strconv.ParseUint(name[2:], 16, 32); err == nil {
+			return HealthSystem(v), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid health watch system %q", name)
+}
+
+// MarshalText encodes the mask as returned by String
+func (s HealthSystem) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a mask as parsed by ParseHealthSystem
+func (s *HealthSystem) UnmarshalText(text []byte) error {
+	system, err := ParseHealthSystem(string(text))
+	if err != nil {
+		return err
+	}
+	*s = system
+	return nil
+}
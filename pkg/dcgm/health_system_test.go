@@ -0,0 +1,72 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthSystemString(t *testing.T) {
+	tests := []struct {
+		system HealthSystem
+		want   string
+	}{
+		{DCGM_HEALTH_WATCH_PCIE, "pcie"},
+		{DCGM_HEALTH_WATCH_NVSWITCH_NONFATAL, "nvswitch_nonfatal"},
+		{DCGM_HEALTH_WATCH_IMEX, "imex"},
+		{DCGM_HEALTH_WATCH_PCIE | DCGM_HEALTH_WATCH_MEM | DCGM_HEALTH_WATCH_NVLINK, "pcie|nvlink|mem"},
+		{DCGM_HEALTH_WATCH_ALL, "all"},
+		{0, "none"},
+		{DCGM_HEALTH_WATCH_THERMAL | 0x10000, "thermal|0x10000"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.system.String())
+	}
+}
+
+func TestParseHealthSystem(t *testing.T) {
+	tests := []struct {
+		name string
+		want HealthSystem
+	}{
+		{"pcie", DCGM_HEALTH_WATCH_PCIE},
+		{"NVLink", DCGM_HEALTH_WATCH_NVLINK},
+		{"DCGM_HEALTH_WATCH_NVSWITCH_FATAL", DCGM_HEALTH_WATCH_NVSWITCH_FATAL},
+		{"pcie|nvlink|mem", DCGM_HEALTH_WATCH_PCIE | DCGM_HEALTH_WATCH_NVLINK | DCGM_HEALTH_WATCH_MEM},
+		{"thermal, power", DCGM_HEALTH_WATCH_THERMAL | DCGM_HEALTH_WATCH_POWER},
+		{"all", DCGM_HEALTH_WATCH_ALL},
+		{"none", 0},
+		{"thermal|0x10000", DCGM_HEALTH_WATCH_THERMAL | 0x10000},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseHealthSystem(tt.name)
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, tt.want, got, tt.name)
+		roundTrip, err := ParseHealthSystem(got.String())
+		require.NoError(t, err, tt.name)
+		assert.Equal(t, got, roundTrip, tt.name)
+	}
+
+	for _, name := range []string{"", "|", "pcie|gpu", "0xzz"} {
+		_, err := ParseHealthSystem(name)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestAllHealthSystems(t *testing.T) {
+	all := AllHealthSystems()
+	assert.Equal(t, HealthSystem(0x3FFF), all)
+	assert.Equal(t, all, all&DCGM_HEALTH_WATCH_ALL)
+
+	withoutNVSwitch := AllHealthSystems(DCGM_HEALTH_WATCH_NVSWITCH_FATAL, DCGM_HEALTH_WATCH_NVSWITCH_NONFATAL)
+	assert.Zero(t, withoutNVSwitch&(DCGM_HEALTH_WATCH_NVSWITCH_FATAL|DCGM_HEALTH_WATCH_NVSWITCH_NONFATAL))
+	assert.Equal(t, DCGM_HEALTH_WATCH_PCIE, withoutNVSwitch&DCGM_HEALTH_WATCH_PCIE)
+
+	assert.Len(t, DCGM_HEALTH_WATCH_ALL.Systems(), 14)
+	assert.Equal(t, []HealthSystem{DCGM_HEALTH_WATCH_NVLINK, DCGM_HEALTH_WATCH_IMEX},
+		(DCGM_HEALTH_WATCH_IMEX | DCGM_HEALTH_WATCH_NVLINK).Systems())
+	assert.Empty(t, HealthSystem(0).Systems())
+}
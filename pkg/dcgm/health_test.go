@@ -157,6 +157,31 @@ func TestHealthWhenInvalidGroupID(t *testing.T) {
 	assert.Contains(t, err.Error(), "Setting not configured")
 }
 
+func TestHealthSetWithParams(t *testing.T) {
+	teardownTest := setupTest(t)
+	defer teardownTest(t)
+	runOnlyWithLiveGPUs(t)
+
+	gpus, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+
+	groupID, err := CreateGroup(fmt.Sprintf("health-params-%d", time.Now().UnixNano()))
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, DestroyGroup(groupID))
+	}()
+	require.NoError(t, AddEntityToGroup(groupID, FE_GPU, gpus[0]))
+
+	systems := DCGM_HEALTH_WATCH_PCIE | DCGM_HEALTH_WATCH_NVLINK
+	require.NoError(t, HealthSetWithParams(groupID, systems, time.Second, time.Minute))
+
+	enabled, err := HealthGet(groupID)
+	require.NoError(t, err)
+	assert.Equal(t, systems, enabled)
+
+	require.Error(t, HealthSetWithParams(groupID, systems, 0, time.Minute))
+}
+
 func TestHealthCheckPCIE(t *testing.T) {
 	teardownTest := setupTest(t)
 	defer teardownTest(t)
@@ -0,0 +1,194 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// HealthEventType identifies a transition in the lifecycle of a health incident
+type HealthEventType int
+
+const (
+	// HealthIncidentOpened indicates a new incident was reported
+	HealthIncidentOpened HealthEventType = iota
+	// HealthIncidentEscalated indicates an open incident went from warning to failure
+	HealthIncidentEscalated
+	// HealthIncidentCleared indicates an open incident is no longer reported
+	HealthIncidentCleared
+	// HealthCheckFailed indicates DCGM returned an error when checking health
+	HealthCheckFailed
+)
+
+// String returns the name of the event type
+func (t HealthEventType) String() string {
+	switch t {
+	case HealthIncidentOpened:
+		return "opened"
+	case HealthIncidentEscalated:
+		return "escalated"
+	case HealthIncidentCleared:
+		return "cleared"
+	case HealthCheckFailed:
+		return "check failed"
+	default:
+		return fmt.Sprintf("HealthEventType(%d)", int(t))
+	}
+}
+
+// HealthEvent is a transition of a health incident reported by WatchHealth
+type HealthEvent struct {
+	Type HealthEventType
+	// Entity is the entity the incident was reported for
+	Entity GroupEntityPair
+	// Incident is the incident as last reported. For HealthIncidentCleared it is the
+	// incident before it cleared.
+	Incident HealthIncident
+	// Previous is the health of the incident before it escalated
+	Previous HealthResult
+	// Timestamp is when the transition was observed
+	Timestamp time.Time
+	// Err is the error returned by HealthCheck for HealthCheckFailed events
+	Err error
+}
+
+// healthIncidentKey identifies an incident across health checks
+type healthIncidentKey struct {
+	entity GroupEntityPair
+	system HealthSystem
+	code   HealthCheckErrorCode
+}
+
+// healthTracker keeps the incidents that are open and turns successive health
+// check responses into transitions.
+type healthTracker struct {
+	open map[healthIncidentKey]HealthIncident
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{open: make(map[healthIncidentKey]HealthIncident)}
+}
+
+// update records the incidents of a health check and returns the transitions
+// since the previous one
+func (t *healthTracker) update(response HealthResponse, now time.Time) []HealthEvent {
+	// DCGM may report the same error more than once per check; keep the worst
+	current := make(map[healthIncidentKey]HealthIncident, len(response.Incidents))
+	var order []healthIncidentKey
+	for _, raw := range response.Incidents {
+		incident := newHealthIncident(raw)
+		key := healthIncidentKey{entity: raw.EntityInfo, system: incident.System, code: incident.Code}
+		existing, ok := current[key]
+		if !ok {
+			order = append(order, key)
+		}
+		if !ok || incident.Health > existing.Health {
+			current[key] = incident
+		}
+	}
+
+	var events []HealthEvent
+	for _, key := range order {
+		incident := current[key]
+		previous, ok := t.open[key]
+		t.open[key] = incident
+		switch {
+		case !ok:
+			events = append(events, HealthEvent{
+				Type: HealthIncidentOpened, Entity: key.entity, Incident: incident, Timestamp: now,
+			})
+		case previous.Health == DCGM_HEALTH_RESULT_WARN && incident.Health == DCGM_HEALTH_RESULT_FAIL:
+			events = append(events, HealthEvent{
+				Type: HealthIncidentEscalated, Entity: key.entity, Incident: incident, Previous: previous.Health,
+				Timestamp: now,
+			})
+		}
+	}
+
+	var cleared []healthIncidentKey
+	for key := range t.open {
+		if _, ok := current[key]; !ok {
+			cleared = append(cleared, key)
+		}
+	}
+	sort.Slice(cleared, func(i, j int) bool {
+		a, b := cleared[i], cleared[j]
+		if a.entity != b.entity {
+			if a.entity.EntityGroupId != b.entity.EntityGroupId {
+				return a.entity.EntityGroupId < b.entity.EntityGroupId
+			}
+			return a.entity.EntityId < b.entity.EntityId
+		}
+		if a.system != b.system {
+			return a.system < b.system
+		}
+		return a.code < b.code
+	})
+	for _, key := range cleared {
+		events = append(events, HealthEvent{
+			Type: HealthIncidentCleared, Entity: key.entity, Incident: t.open[key], Timestamp: now,
+		})
+		delete(t.open, key)
+	}
+
+	return events
+}
+
+// WatchHealth enables the health watch systems on the group and checks them
+// every interval, reporting only transitions: incidents that open, escalate
+// from warning to failure, or clear. Incidents are tracked per entity, system
+// and error code.
+//
+// The returned channel is closed when the context is canceled. Events are not
+// dropped; the watcher waits for the receiver before checking again. The
+// health watches remain enabled on the group after the context is canceled.
+func WatchHealth(ctx context.Context, group GroupHandle, systems HealthSystem, interval time.Duration) (<-chan HealthEvent, error) {
+	if interval <= 0 {
+		return nil, errors.New("health watch interval must be positive")
+	}
+	if err := HealthSet(group, systems); err != nil {
+		return nil, err
+	}
+
+	return watchHealth(ctx, func() (HealthResponse, error) { return HealthCheck(group) }, interval), nil
+}
+
+func watchHealth(ctx context.Context, check func() (HealthResponse, error), interval time.Duration) <-chan HealthEvent {
+	events := make(chan HealthEvent)
+
+	go func() {
+		defer close(events)
+
+		tracker := newHealthTracker()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			response, err := check()
+			var pending []HealthEvent
+			if err != nil {
+				pending = []HealthEvent{{Type: HealthCheckFailed, Timestamp: time.Now(), Err: err}}
+			} else {
+				pending = tracker.update(response, time.Now())
+			}
+
+			for _, event := range pending {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
@@ -0,0 +1,114 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func healthTestIncident(gpu uint, system HealthSystem, health HealthResult, code HealthCheckErrorCode) Incident {
+	return Incident{
+		System:     system,
+		Health:     health,
+		Error:      DiagErrorDetail{Code: code},
+		EntityInfo: GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu},
+	}
+}
+
+func eventTypes(events []HealthEvent) []HealthEventType {
+	types := make([]HealthEventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+func TestHealthTrackerTransitions(t *testing.T) {
+	tracker := newHealthTracker()
+	now := time.Now()
+	pcieWarn := healthTestIncident(0, DCGM_HEALTH_WATCH_PCIE, DCGM_HEALTH_RESULT_WARN, DCGM_FR_PCI_REPLAY_RATE)
+	pcieFail := healthTestIncident(0, DCGM_HEALTH_WATCH_PCIE, DCGM_HEALTH_RESULT_FAIL, DCGM_FR_PCI_REPLAY_RATE)
+	memFail := healthTestIncident(1, DCGM_HEALTH_WATCH_MEM, DCGM_HEALTH_RESULT_FAIL, DCGM_FR_VOLATILE_DBE_DETECTED)
+
+	events := tracker.update(HealthResponse{Incidents: []Incident{pcieWarn}}, now)
+	require.Len(t, events, 1)
+	assert.Equal(t, HealthIncidentOpened, events[0].Type)
+	assert.Equal(t, pcieWarn.EntityInfo, events[0].Entity)
+	assert.Equal(t, DCGM_FR_PCI_REPLAY_RATE, events[0].Incident.Code)
+	assert.Equal(t, now, events[0].Timestamp)
+
+	// An ongoing incident is not reported again
+	assert.Empty(t, tracker.update(HealthResponse{Incidents: []Incident{pcieWarn}}, now))
+
+	// Duplicates within a check collapse to the worst result
+	events = tracker.update(HealthResponse{Incidents: []Incident{pcieWarn, pcieFail, memFail}}, now)
+	assert.Equal(t, []HealthEventType{HealthIncidentEscalated, HealthIncidentOpened}, eventTypes(events))
+	assert.Equal(t, DCGM_HEALTH_RESULT_WARN, events[0].Previous)
+	assert.Equal(t, DCGM_HEALTH_RESULT_FAIL, events[0].Incident.Health)
+
+	// Improving from failure to warning is not a transition
+	assert.Empty(t, tracker.update(HealthResponse{Incidents: []Incident{pcieWarn, memFail}}, now))
+
+	events = tracker.update(HealthResponse{}, now)
+	assert.Equal(t, []HealthEventType{HealthIncidentCleared, HealthIncidentCleared}, eventTypes(events))
+	assert.Equal(t, uint(0), events[0].Entity.EntityId)
+	assert.Equal(t, DCGM_HEALTH_RESULT_WARN, events[0].Incident.Health)
+	assert.Equal(t, uint(1), events[1].Entity.EntityId)
+
+	// A cleared incident opens again
+	events = tracker.update(HealthResponse{Incidents: []Incident{pcieWarn}}, now)
+	assert.Equal(t, []HealthEventType{HealthIncidentOpened}, eventTypes(events))
+}
+
+func TestWatchHealthEmitsTransitions(t *testing.T) {
+	responses := []HealthResponse{
+		{},
+		{Incidents: []Incident{healthTestIncident(0, DCGM_HEALTH_WATCH_THERMAL, DCGM_HEALTH_RESULT_WARN, DCGM_FR_TEMP_VIOLATION)}},
+		{Incidents: []Incident{healthTestIncident(0, DCGM_HEALTH_WATCH_THERMAL, DCGM_HEALTH_RESULT_WARN, DCGM_FR_TEMP_VIOLATION)}},
+		{},
+	}
+	var mu sync.Mutex
+	calls := 0
+	check := func() (HealthResponse, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls > len(responses) {
+			return HealthResponse{}, errors.New("host engine unavailable")
+		}
+		return responses[calls-1], nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := watchHealth(ctx, check, time.Millisecond)
+
+	var got []HealthEvent
+	for event := range events {
+		got = append(got, event)
+		if event.Type == HealthCheckFailed {
+			cancel()
+		}
+	}
+
+	require.GreaterOrEqual(t, len(got), 3)
+	assert.Equal(t, []HealthEventType{HealthIncidentOpened, HealthIncidentCleared, HealthCheckFailed}, eventTypes(got[:3]))
+	assert.EqualError(t, got[2].Err, "host engine unavailable")
+}
+
+func TestWatchHealthRejectsInvalidInterval(t *testing.T) {
+	_, err := WatchHealth(context.Background(), GroupHandle{}, DCGM_HEALTH_WATCH_ALL, 0)
+	require.Error(t, err)
+}
+
+func TestHealthEventTypeString(t *testing.T) {
+	assert.Equal(t, "opened", HealthIncidentOpened.String())
+	assert.Equal(t, "escalated", HealthIncidentEscalated.String())
+	assert.Equal(t, "cleared", HealthIncidentCleared.String())
+	assert.Equal(t, "HealthEventType(42)", HealthEventType(42).String())
+}
@@ -33,6 +33,9 @@ DCGM_CASSERT(sizeof(dcgmEntitiesGetLatestValues_v4) <= ((4 * 1024 * 1024) - 24),
 import "C"
 
 import (
+	"errors"
+	"fmt"
+	"time"
 	"unsafe"
 )
 
@@ -127,3 +130,61 @@ func InjectFieldValue(gpu uint, fieldID Short, fieldType uint, status int, ts in
 
 	return nil
 }
+
+// policyInjectionFields are the fields whose values DCGM compares with each policy condition
+var policyInjectionFields = map[PolicyCondition]Short{
+	DbePolicy:     DCGM_FI_DEV_ECC_DBE_VOL_DEV,
+	PCIePolicy:    DCGM_FI_DEV_PCIE_REPLAY_TOTAL,
+	MaxRtPgPolicy: DCGM_FI_DEV_PAGE_RETIRED_DBE_TOTAL,
+	ThermalPolicy: DCGM_FI_DEV_GPU_TEMP_CELSIUS,
+	PowerPolicy:   DCGM_FI_DEV_BOARD_POWER_WATTS,
+	NvlinkPolicy:  DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_TOTAL,
+	XidPolicy:     DCGM_FI_DEV_XID_ERROR,
+}
+
+// InjectPolicyViolation injects field values that violate a policy condition on
+// a GPU and triggers policy evaluation, so the listeners of the condition receive
+// a violation. This function is intended for testing purposes only.
+//
+// The meaning of value depends on the condition:
+//   - ThermalPolicy: the temperature in Celsius
+//   - PowerPolicy: the power usage in watts
+//   - XidPolicy: the XID
+//   - MaxRtPgPolicy: the number of pages retired for both single- and double-bit errors
+//   - DbePolicy, PCIePolicy and NvlinkPolicy: the increase of the error counter
+//
+// The values are timestamped a minute ahead so they are newer than the values
+// sampled from the GPU. The violation is only reported when the value crosses
+// the threshold set for the condition.
+func InjectPolicyViolation(gpu uint, condition PolicyCondition, value int64) error {
+	fieldID, ok := policyInjectionFields[condition]
+	if !ok {
+		return fmt.Errorf("unknown policy condition: %s", condition)
+	}
+
+	ts := time.Now().Add(time.Minute)
+	var err error
+	switch condition {
+	case DbePolicy, PCIePolicy, NvlinkPolicy:
+		// Counter conditions are violated by an increase
+		err = errors.Join(
+			InjectFieldValue(gpu, fieldID, DCGM_FT_INT64, 0, ts.UnixMicro(), int64(0)),
+			InjectFieldValue(gpu, fieldID, DCGM_FT_INT64, 0, ts.Add(time.Second).UnixMicro(), value),
+		)
+	case MaxRtPgPolicy:
+		// A single-bit count is injected too so that DCGM gets past its internal checks
+		err = errors.Join(
+			InjectFieldValue(gpu, fieldID, DCGM_FT_INT64, 0, ts.UnixMicro(), value),
+			InjectFieldValue(gpu, DCGM_FI_DEV_PAGE_RETIRED_SBE_TOTAL, DCGM_FT_INT64, 0, ts.UnixMicro(), value),
+		)
+	case PowerPolicy:
+		err = InjectFieldValue(gpu, fieldID, DCGM_FT_DOUBLE, 0, ts.UnixMicro(), float64(value))
+	default:
+		err = InjectFieldValue(gpu, fieldID, DCGM_FT_INT64, 0, ts.UnixMicro(), value)
+	}
+	if err != nil {
+		return fmt.Errorf("error injecting %s violation: %w", condition, err)
+	}
+
+	return policyTrigger()
+}
@@ -0,0 +1,117 @@
+// Package fieldwatch discovers DCGM entities and watches fields on them for
+// the metric exporters in pkg/dcgm/prom and pkg/dcgm/otelbridge.
+package fieldwatch
+
+import (
+	"context"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Backend is the subset of the dcgm package used by the metric exporters.
+// DCGM returns the implementation backed by libdcgm; tests supply a fake.
+type Backend interface {
+	GetSupportedDevices() ([]uint, error)
+	GetDeviceInfo(gpuID uint) (dcgm.Device, error)
+	GetGPUInstanceHierarchy() (dcgm.MigHierarchy_v2, error)
+	GetCPUHierarchy_v2() (dcgm.CPUHierarchy_v2, error)
+	GetNvLinkLinkStatus() ([]dcgm.NvLinkStatus, error)
+	GetVersionInfo() (dcgm.VersionInfo, error)
+
+	CreateGroup(name string) (dcgm.GroupHandle, error)
+	AddEntityToGroup(group dcgm.GroupHandle, entityGroup dcgm.Field_Entity_Group, entityID uint) error
+	DestroyGroup(group dcgm.GroupHandle) error
+	FieldGroupCreate(name string, fields []dcgm.Short) (dcgm.FieldHandle, error)
+	FieldGroupDestroy(fieldGroup dcgm.FieldHandle) error
+	WatchFieldsWithGroupEx(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle, updateFreq int64,
+		maxKeepAge float64, maxKeepSamples int32) error
+	UnwatchFields(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle) error
+	EntitiesGetLatestValues(entities []dcgm.GroupEntityPair, fields []dcgm.Short, flags uint) ([]dcgm.FieldValue_v2, error)
+
+	HealthSet(group dcgm.GroupHandle, systems dcgm.HealthSystem) error
+	HealthCheck(group dcgm.GroupHandle) (dcgm.HealthResponse, error)
+	WatchPolicyViolationsForGroup(ctx context.Context, group dcgm.GroupHandle,
+		conditions ...dcgm.PolicyCondition) (<-chan dcgm.PolicyViolation, error)
+}
+
+// DCGM returns the Backend that calls the dcgm package directly.
+// dcgm.Init must have been called before the backend is used.
+func DCGM() Backend {
+	return dcgmBackend{}
+}
+
+type dcgmBackend struct{}
+
+func (dcgmBackend) GetSupportedDevices() ([]uint, error) {
+	return dcgm.GetSupportedDevices()
+}
+
+func (dcgmBackend) GetDeviceInfo(gpuID uint) (dcgm.Device, error) {
+	return dcgm.GetDeviceInfo(gpuID)
+}
+
+func (dcgmBackend) GetGPUInstanceHierarchy() (dcgm.MigHierarchy_v2, error) {
+	return dcgm.GetGPUInstanceHierarchy()
+}
+
+func (dcgmBackend) GetCPUHierarchy_v2() (dcgm.CPUHierarchy_v2, error) {
+	return dcgm.GetCPUHierarchy_v2()
+}
+
+func (dcgmBackend) GetNvLinkLinkStatus() ([]dcgm.NvLinkStatus, error) {
+	return dcgm.GetNvLinkLinkStatus()
+}
+
+func (dcgmBackend) GetVersionInfo() (dcgm.VersionInfo, error) {
+	return dcgm.GetVersionInfo()
+}
+
+func (dcgmBackend) CreateGroup(name string) (dcgm.GroupHandle, error) {
+	return dcgm.CreateGroup(name)
+}
+
+func (dcgmBackend) AddEntityToGroup(group dcgm.GroupHandle, entityGroup dcgm.Field_Entity_Group, entityID uint) error {
+	return dcgm.AddEntityToGroup(group, entityGroup, entityID)
+}
+
+func (dcgmBackend) DestroyGroup(group dcgm.GroupHandle) error {
+	return dcgm.DestroyGroup(group)
+}
+
+func (dcgmBackend) FieldGroupCreate(name string, fields []dcgm.Short) (dcgm.FieldHandle, error) {
+	return dcgm.FieldGroupCreate(name, fields)
+}
+
+func (dcgmBackend) FieldGroupDestroy(fieldGroup dcgm.FieldHandle) error {
+	return dcgm.FieldGroupDestroy(fieldGroup)
+}
+
+func (dcgmBackend) WatchFieldsWithGroupEx(
+	fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle, updateFreq int64, maxKeepAge float64, maxKeepSamples int32,
+) error {
+	return dcgm.WatchFieldsWithGroupEx(fieldGroup, group, updateFreq, maxKeepAge, maxKeepSamples)
+}
+
+func (dcgmBackend) UnwatchFields(fieldGroup dcgm.FieldHandle, group dcgm.GroupHandle) error {
+	return dcgm.UnwatchFields(fieldGroup, group)
+}
+
+func (dcgmBackend) EntitiesGetLatestValues(
+	entities []dcgm.GroupEntityPair, fields []dcgm.Short, flags uint,
+) ([]dcgm.FieldValue_v2, error) {
+	return dcgm.EntitiesGetLatestValues(entities, fields, flags)
+}
+
+func (dcgmBackend) HealthSet(group dcgm.GroupHandle, systems dcgm.HealthSystem) error {
+	return dcgm.HealthSet(group, systems)
+}
+
+func (dcgmBackend) HealthCheck(group dcgm.GroupHandle) (dcgm.HealthResponse, error) {
+	return dcgm.HealthCheck(group)
+}
+
+func (dcgmBackend) WatchPolicyViolationsForGroup(
+	ctx context.Context, group dcgm.GroupHandle, conditions ...dcgm.PolicyCondition,
+) (<-chan dcgm.PolicyViolation, error) {
+	return dcgm.WatchPolicyViolationsForGroup(ctx, group, conditions...)
+}
@@ -0,0 +1,216 @@
+package fieldwatch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"strconv"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Entity is a watched DCGM entity together with the identifiers exporters
+// attach to its values. Identifiers that do not apply to the entity are empty.
+type Entity struct {
+	dcgm.GroupEntityPair
+	// GPU is the DCGM ID of the GPU, or of the parent GPU of a MIG instance or NVLink
+	GPU string
+	// UUID is the UUID of the GPU
+	UUID string
+	// GPUInstance is the NVML ID of the GPU instance
+	GPUInstance string
+	// ComputeInstance is the NVML ID of the compute instance
+	ComputeInstance string
+	// Link is the index of the NVLink
+	Link string
+	// CPU is the ID of the CPU, or of the CPU owning a core
+	CPU string
+	// CPUCore is the ID of the CPU core
+	CPUCore string
+}
+
+// Discover enumerates the entities of the requested groups. Supported groups
+// are FE_GPU, FE_GPU_I, FE_GPU_CI, FE_LINK, FE_CPU and FE_CPU_CORE. Only NVLinks
+// of GPUs that are up are returned.
+func Discover(backend Backend, entityGroups []dcgm.Field_Entity_Group) ([]Entity, error) {
+	d := discovery{
+		backend: backend,
+		uuids:   make(map[uint]string),
+		seen:    make(map[dcgm.GroupEntityPair]bool),
+	}
+
+	for _, entityGroup := range entityGroups {
+		var err error
+		switch entityGroup {
+		case dcgm.FE_GPU:
+			err = d.gpus()
+		case dcgm.FE_GPU_I, dcgm.FE_GPU_CI:
+			err = d.instances(entityGroup)
+		case dcgm.FE_LINK:
+			err = d.links()
+		case dcgm.FE_CPU, dcgm.FE_CPU_CORE:
+			err = d.cpus(entityGroup)
+		default:
+			err = fmt.Errorf("unsupported entity group %v", entityGroup)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d.entities, nil
+}
+
+type discovery struct {
+	backend   Backend
+	uuids     map[uint]string
+	hierarchy *dcgm.MigHierarchy_v2
+	cpuList   *dcgm.CPUHierarchy_v2
+	seen      map[dcgm.GroupEntityPair]bool
+	entities  []Entity
+}
+
+func (d *discovery) add(entity Entity) {
+	if d.seen[entity.GroupEntityPair] {
+		return
+	}
+	d.seen[entity.GroupEntityPair] = true
+	d.entities = append(d.entities, entity)
+}
+
+func (d *discovery) uuid(gpu uint) (string, error) {
+	if u, ok := d.uuids[gpu]; ok {
+		return u, nil
+	}
+	device, err := d.backend.GetDeviceInfo(gpu)
+	if err != nil {
+		return "", fmt.Errorf("error getting info for GPU %d: %w", gpu, err)
+	}
+	d.uuids[gpu] = device.UUID
+	return device.UUID, nil
+}
+
+func (d *discovery) gpus() error {
+	gpus, err := d.backend.GetSupportedDevices()
+	if err != nil {
+		return fmt.Errorf("error getting GPUs: %w", err)
+	}
+	for _, gpu := range gpus {
+		u, err := d.uuid(gpu)
+		if err != nil {
+			return err
+		}
+		d.add(Entity{
+			GroupEntityPair: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: gpu},
+			GPU:             formatID(gpu),
+			UUID:            u,
+		})
+	}
+	return nil
+}
+
+func (d *discovery) instances(entityGroup dcgm.Field_Entity_Group) error {
+	if d.hierarchy == nil {
+		h, err := d.backend.GetGPUInstanceHierarchy()
+		if err != nil {
+			return fmt.Errorf("error getting GPU instance hierarchy: %w", err)
+		}
+		d.hierarchy = &h
+	}
+	entries := d.hierarchy.EntityList[:d.hierarchy.Count]
+
+	instanceGPU := make(map[uint]uint)
+	for _, entry := range entries {
+		if entry.Entity.EntityGroupId == dcgm.FE_GPU_I {
+			instanceGPU[entry.Entity.EntityId] = entry.Parent.EntityId
+		}
+	}
+
+	for _, entry := range entries {
+		if entry.Entity.EntityGroupId != entityGroup {
+			continue
+		}
+		entity := Entity{
+			GroupEntityPair: entry.Entity,
+			UUID:            entry.Info.GpuUuid,
+			GPUInstance:     formatID(entry.Info.NvmlInstanceId),
+		}
+		if entityGroup == dcgm.FE_GPU_CI {
+			entity.GPU = formatID(instanceGPU[entry.Parent.EntityId])
+			entity.ComputeInstance = formatID(entry.Info.NvmlComputeInstanceId)
+		} else {
+			entity.GPU = formatID(entry.Parent.EntityId)
+		}
+		d.add(entity)
+	}
+	return nil
+}
+
+func (d *discovery) links() error {
+	links, err := d.backend.GetNvLinkLinkStatus()
+	if err != nil {
+		return fmt.Errorf("error getting NVLink status: %w", err)
+	}
+	for _, link := range links {
+		if link.ParentType != dcgm.FE_GPU || link.State != dcgm.LS_UP {
+			continue
+		}
+		u, err := d.uuid(link.ParentId)
+		if err != nil {
+			return err
+		}
+		d.add(Entity{
+			GroupEntityPair: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_LINK, EntityId: LinkEntityID(link)},
+			GPU:             formatID(link.ParentId),
+			UUID:            u,
+			Link:            formatID(link.Index),
+		})
+	}
+	return nil
+}
+
+func (d *discovery) cpus(entityGroup dcgm.Field_Entity_Group) error {
+	if d.cpuList == nil {
+		h, err := d.backend.GetCPUHierarchy_v2()
+		if err != nil {
+			return fmt.Errorf("error getting CPU hierarchy: %w", err)
+		}
+		d.cpuList = &h
+	}
+
+	for _, cpu := range d.cpuList.CPUs[:d.cpuList.NumCPUs] {
+		if entityGroup == dcgm.FE_CPU {
+			d.add(Entity{
+				GroupEntityPair: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_CPU, EntityId: cpu.CPUID},
+				CPU:             formatID(cpu.CPUID),
+			})
+			continue
+		}
+		for word, mask := range cpu.OwnedCores {
+			for ; mask != 0; mask &= mask - 1 {
+				core := uint(word*64 + bits.TrailingZeros64(mask))
+				d.add(Entity{
+					GroupEntityPair: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_CPU_CORE, EntityId: core},
+					CPU:             formatID(cpu.CPUID),
+					CPUCore:         formatID(core),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+func formatID(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// LinkEntityID packs an NVLink into a FE_LINK entity ID the same way as
+// dcgm.AddLinkEntityToGroup.
+func LinkEntityID(link dcgm.NvLinkStatus) uint {
+	packed := make([]byte, 4)
+	packed[0] = uint8(link.ParentType)
+	binary.LittleEndian.PutUint16(packed[1:3], uint16(link.Index))
+	packed[3] = uint8(link.ParentId)
+
+	return uint(binary.LittleEndian.Uint32(packed))
+}
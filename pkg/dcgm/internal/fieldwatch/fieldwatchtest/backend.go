@@ -0,0 +1,166 @@
+// Package fieldwatchtest provides a fake fieldwatch.Backend for testing the
+// metric exporters without libdcgm.
+package fieldwatchtest
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Backend is an in-memory fieldwatch.Backend. The exported fields configure
+// the responses and record the calls made by the code under test.
+type Backend struct {
+	GPUs      []uint
+	Devices   map[uint]dcgm.Device
+	MIG       dcgm.MigHierarchy_v2
+	CPUs      dcgm.CPUHierarchy_v2
+	Links     []dcgm.NvLinkStatus
+	Values    []dcgm.FieldValue_v2
+	ValuesErr error
+	Health    dcgm.HealthResponse
+	WatchErr  error
+	Version   dcgm.VersionInfo
+
+	Violations chan dcgm.PolicyViolation
+
+	GroupEntities   []dcgm.GroupEntityPair
+	WatchedFields   []dcgm.Short
+	UpdateFreq      int64
+	HealthSystems   dcgm.HealthSystem
+	Conditions      []dcgm.PolicyCondition
+	GroupsDestroyed int
+	FieldsDestroyed int
+	Unwatched       int
+}
+
+func (f *Backend) GetSupportedDevices() ([]uint, error) { return f.GPUs, nil }
+
+func (f *Backend) GetDeviceInfo(gpuID uint) (dcgm.Device, error) {
+	device, ok := f.Devices[gpuID]
+	if !ok {
+		return dcgm.Device{}, errors.New("no such GPU")
+	}
+	return device, nil
+}
+
+func (f *Backend) GetGPUInstanceHierarchy() (dcgm.MigHierarchy_v2, error) { return f.MIG, nil }
+
+func (f *Backend) GetCPUHierarchy_v2() (dcgm.CPUHierarchy_v2, error) { return f.CPUs, nil }
+
+func (f *Backend) GetNvLinkLinkStatus() ([]dcgm.NvLinkStatus, error) { return f.Links, nil }
+
+func (f *Backend) GetVersionInfo() (dcgm.VersionInfo, error) { return f.Version, nil }
+
+func (f *Backend) CreateGroup(string) (dcgm.GroupHandle, error) {
+	var group dcgm.GroupHandle
+	group.SetHandle(1)
+	return group, nil
+}
+
+func (f *Backend) AddEntityToGroup(_ dcgm.GroupHandle, entityGroup dcgm.Field_Entity_Group, entityID uint) error {
+	f.GroupEntities = append(f.GroupEntities, dcgm.GroupEntityPair{EntityGroupId: entityGroup, EntityId: entityID})
+	return nil
+}
+
+func (f *Backend) DestroyGroup(dcgm.GroupHandle) error {
+	f.GroupsDestroyed++
+	return nil
+}
+
+func (f *Backend) FieldGroupCreate(_ string, fields []dcgm.Short) (dcgm.FieldHandle, error) {
+	f.WatchedFields = fields
+	var fieldGroup dcgm.FieldHandle
+	fieldGroup.SetHandle(2)
+	return fieldGroup, nil
+}
+
+func (f *Backend) FieldGroupDestroy(dcgm.FieldHandle) error {
+	f.FieldsDestroyed++
+	return nil
+}
+
+func (f *Backend) WatchFieldsWithGroupEx(_ dcgm.FieldHandle, _ dcgm.GroupHandle, updateFreq int64, _ float64, _ int32) error {
+	f.UpdateFreq = updateFreq
+	return f.WatchErr
+}
+
+func (f *Backend) UnwatchFields(dcgm.FieldHandle, dcgm.GroupHandle) error {
+	f.Unwatched++
+	return nil
+}
+
+func (f *Backend) EntitiesGetLatestValues(
+	_ []dcgm.GroupEntityPair, _ []dcgm.Short, _ uint,
+) ([]dcgm.FieldValue_v2, error) {
+	return f.Values, f.ValuesErr
+}
+
+func (f *Backend) HealthSet(_ dcgm.GroupHandle, systems dcgm.HealthSystem) error {
+	f.HealthSystems = systems
+	return nil
+}
+
+func (f *Backend) HealthCheck(dcgm.GroupHandle) (dcgm.HealthResponse, error) {
+	return f.Health, nil
+}
+
+func (f *Backend) WatchPolicyViolationsForGroup(
+	ctx context.Context, _ dcgm.GroupHandle, conditions ...dcgm.PolicyCondition,
+) (<-chan dcgm.PolicyViolation, error) {
+	f.Conditions = conditions
+	out := make(chan dcgm.PolicyViolation)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v := <-f.Violations:
+				out <- v
+			}
+		}
+	}()
+	return out, nil
+}
+
+// NewBackend returns a Backend with two GPUs, 0 and 1, with UUIDs GPU-aaaa and GPU-bbbb
+func NewBackend() *Backend {
+	return &Backend{
+		GPUs: []uint{0, 1},
+		Devices: map[uint]dcgm.Device{
+			0: {GPU: 0, UUID: "GPU-aaaa"},
+			1: {GPU: 1, UUID: "GPU-bbbb"},
+		},
+		Violations: make(chan dcgm.PolicyViolation),
+	}
+}
+
+// Int64Value returns a DCGM_FT_INT64 value of a field
+func Int64Value(entityGroup dcgm.Field_Entity_Group, entityID uint, field dcgm.Short, v int64) dcgm.FieldValue_v2 {
+	fv := dcgm.FieldValue_v2{
+		EntityGroupId: entityGroup,
+		EntityID:      entityID,
+		FieldID:       field,
+		FieldType:     dcgm.DCGM_FT_INT64,
+		Status:        dcgm.DCGM_ST_OK,
+	}
+	binary.NativeEndian.PutUint64(fv.Value[:], uint64(v))
+	return fv
+}
+
+// Float64Value returns a DCGM_FT_DOUBLE value of a field
+func Float64Value(entityGroup dcgm.Field_Entity_Group, entityID uint, field dcgm.Short, v float64) dcgm.FieldValue_v2 {
+	fv := dcgm.FieldValue_v2{
+		EntityGroupId: entityGroup,
+		EntityID:      entityID,
+		FieldID:       field,
+		FieldType:     dcgm.DCGM_FT_DOUBLE,
+		Status:        dcgm.DCGM_ST_OK,
+	}
+	binary.NativeEndian.PutUint64(fv.Value[:], math.Float64bits(v))
+	return fv
+}
@@ -0,0 +1,148 @@
+package fieldwatch
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+const (
+	// DefaultUpdateInterval matches the update frequency of dcgm.WatchFieldsWithGroup
+	DefaultUpdateInterval = 30 * time.Second
+	// maxKeepSamples is the number of samples DCGM keeps per field; only the latest is exported
+	maxKeepSamples = 1
+)
+
+// ParseField resolves a field given by numeric ID or by name
+func ParseField(field string) (dcgm.Short, error) {
+	if id, err := strconv.ParseUint(field, 10, 16); err == nil {
+		if _, ok := dcgm.GetFieldInfo(dcgm.Short(id)); !ok {
+			return 0, fmt.Errorf("unknown field ID %d", id)
+		}
+		return dcgm.Short(id), nil
+	}
+
+	id, ok := dcgm.GetFieldID(field)
+	if !ok {
+		return 0, fmt.Errorf("unknown field %q", field)
+	}
+	return id, nil
+}
+
+// ParseFields resolves fields given by numeric ID or by name, dropping duplicates
+func ParseFields(fields []string) ([]dcgm.Short, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("at least one field is required")
+	}
+
+	ids := make([]dcgm.Short, 0, len(fields))
+	seen := make(map[dcgm.Short]bool, len(fields))
+	for _, field := range fields {
+		id, err := ParseField(field)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// Watch is a DCGM group and field group with a watch on the fields
+type Watch struct {
+	// Group contains the watched entities
+	Group dcgm.GroupHandle
+	// Entities are the watched entities
+	Entities []Entity
+	// Fields are the watched fields
+	Fields []dcgm.Short
+
+	backend    Backend
+	fieldGroup *dcgm.FieldHandle
+	pairs      []dcgm.GroupEntityPair
+	created    bool
+	watching   bool
+}
+
+// Start creates a group of the entities and watches the fields on it. A zero
+// interval uses DefaultUpdateInterval.
+func Start(backend Backend, entities []Entity, fields []dcgm.Short, interval time.Duration) (*Watch, error) {
+	if len(entities) == 0 {
+		return nil, errors.New("no entities found to watch")
+	}
+
+	w := &Watch{
+		Entities: entities,
+		Fields:   fields,
+		backend:  backend,
+		pairs:    make([]dcgm.GroupEntityPair, len(entities)),
+	}
+	for i, entity := range entities {
+		w.pairs[i] = entity.GroupEntityPair
+	}
+
+	if err := w.start(interval); err != nil {
+		return nil, errors.Join(err, w.Close())
+	}
+	return w, nil
+}
+
+func (w *Watch) start(interval time.Duration) error {
+	group, err := w.backend.CreateGroup(fmt.Sprintf("fieldwatch%d", rand.Uint64()))
+	if err != nil {
+		return err
+	}
+	w.Group = group
+	w.created = true
+
+	for _, pair := range w.pairs {
+		if err := w.backend.AddEntityToGroup(group, pair.EntityGroupId, pair.EntityId); err != nil {
+			return err
+		}
+	}
+
+	fieldGroup, err := w.backend.FieldGroupCreate(fmt.Sprintf("fieldwatch%d", rand.Uint64()), w.Fields)
+	if err != nil {
+		return err
+	}
+	w.fieldGroup = &fieldGroup
+
+	if interval <= 0 {
+		interval = DefaultUpdateInterval
+	}
+	if err := w.backend.WatchFieldsWithGroupEx(fieldGroup, group, interval.Microseconds(), 0, maxKeepSamples); err != nil {
+		return err
+	}
+	w.watching = true
+
+	return nil
+}
+
+// Latest returns the latest value of every watched field on every watched entity
+func (w *Watch) Latest() ([]dcgm.FieldValue_v2, error) {
+	return w.backend.EntitiesGetLatestValues(w.pairs, w.Fields, 0)
+}
+
+// Close removes the watch and destroys the field group and group
+func (w *Watch) Close() error {
+	var errs []error
+	if w.watching {
+		errs = append(errs, w.backend.UnwatchFields(*w.fieldGroup, w.Group))
+		w.watching = false
+	}
+	if w.fieldGroup != nil {
+		errs = append(errs, w.backend.FieldGroupDestroy(*w.fieldGroup))
+		w.fieldGroup = nil
+	}
+	if w.created {
+		errs = append(errs, w.backend.DestroyGroup(w.Group))
+		w.created = false
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,265 @@
+package dcgm
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// Inventory is a snapshot of the GPUs of a node, with their identities, PCI
+// information, affinity, topology and MIG instances, and of the CPUs,
+// NVSwitches and NVLinks of the node
+type Inventory struct {
+	// Timestamp is the time the snapshot was taken
+	Timestamp time.Time
+	// GPUs are all the GPUs of the node, ordered by ID
+	GPUs []InventoryGPU
+	// CPUs are the CPUs managed by DCGM. It is empty when DCGM does not manage
+	// the CPUs of the node.
+	CPUs []CPUHierarchyCPU_v2
+	// NvSwitches are the entity IDs of the NVSwitches
+	NvSwitches []uint
+	// NvLinks is the status of the NVLinks of the GPUs and NVSwitches
+	NvLinks []NvLinkStatus
+}
+
+// InventoryGPU is a GPU of an Inventory
+type InventoryGPU struct {
+	// Device is the information returned by GetDeviceInfo. Topology and
+	// CPUAffinity are only set for DCGM supported GPUs.
+	Device
+	// Affinity is the CPUs and memory close to the GPU
+	Affinity GPUAffinity
+	// MIGMode reports whether MIG is enabled
+	MIGMode bool
+	// MIG are the GPU instances of the GPU, each followed by its compute instances
+	MIG []MigHierarchyInfo_v2
+}
+
+// GPU returns a GPU of the inventory
+func (inv Inventory) GPU(gpuID uint) (InventoryGPU, bool) {
+	for _, gpu := range inv.GPUs {
+		if gpu.GPU == gpuID {
+			return gpu, true
+		}
+	}
+	return InventoryGPU{}, false
+}
+
+// inventoryFields are the fields read for every GPU of an Inventory, followed
+// by the affinity fields
+var inventoryFields = append([]Short{
+	DCGM_FI_DEV_GPU_UUID,
+	DCGM_FI_DEV_GPU_BRAND,
+	DCGM_FI_DEV_GPU_NAME,
+	DCGM_FI_DEV_BOARD_SERIAL,
+	DCGM_FI_DEV_VBIOS_VERSION,
+	DCGM_FI_DEV_INFOROM_IMAGE_VERSION,
+	DCGM_FI_SYSTEM_DRIVER_VERSION,
+	DCGM_FI_DEV_PCI_BUS_ID,
+	DCGM_FI_DEV_BAR1_TOTAL,
+	DCGM_FI_DEV_FB_TOTAL,
+	DCGM_FI_DEV_PCIE_MAX_LINK_GEN,
+	DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH,
+	DCGM_FI_DEV_BOARD_POWER_LIMIT_DEFAULT_WATTS,
+	DCGM_FI_DEV_MIG_MODE,
+}, affinityFields...)
+
+// getInventory watches the inventory fields of all the GPUs once and reads
+// them in a single batch. The CPUs, NVSwitches, NVLinks and MIG instances are
+// added when DCGM reports them.
+func getInventory() (*Inventory, error) {
+	gpus, err := getEntityGroupEntities(FE_GPU)
+	if err != nil {
+		return nil, err
+	}
+	supported, err := getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &Inventory{Timestamp: time.Now()}
+	if len(gpus) == 0 {
+		return inventory, nil
+	}
+
+	values, err := inventoryValues(gpus)
+	if err != nil {
+		return nil, err
+	}
+
+	var hierarchy *CPUHierarchy_v2
+	if h, err := GetCPUHierarchy_v2(); err == nil {
+		hierarchy = &h
+		inventory.CPUs = h.CPUs[:min(h.NumCPUs, MAX_NUM_CPUS)]
+	}
+	var migHierarchy MigHierarchy_v2
+	if h, err := GetGPUInstanceHierarchy(); err == nil {
+		migHierarchy = h
+	}
+
+	byGPU := make(map[uint][]TypedValue, len(gpus))
+	for _, value := range values {
+		byGPU[value.EntityID] = append(byGPU[value.EntityID], value.TypedValue())
+	}
+
+	slices.Sort(gpus)
+	for _, gpuID := range gpus {
+		dcgmSupported := slices.Contains(supported, gpuID) && getGPUStatus(gpuID) == EntityStatusOk
+		gpu := newInventoryGPU(gpuID, byGPU[gpuID], dcgmSupported, hierarchy)
+		gpu.MIG = migInstances(migHierarchy, gpuID)
+
+		if dcgmSupported {
+			gpu.Topology, err = getDeviceTopologyPaths(gpuID)
+			if err != nil {
+				return nil, fmt.Errorf("get topology of GPU %d: %w", gpuID, err)
+			}
+			setPeerBusIDs(gpu.Topology, byGPU)
+		}
+		inventory.GPUs = append(inventory.GPUs, gpu)
+	}
+
+	if switches, err := getEntityGroupEntities(FE_SWITCH); err == nil {
+		inventory.NvSwitches = switches
+	}
+	if links, err := getNvLinkLinkStatus(); err == nil {
+		inventory.NvLinks = links
+	}
+	return inventory, nil
+}
+
+// inventoryValues watches the inventory fields of all the GPUs with a single
+// group and reads them with one request
+func inventoryValues(gpus []uint) ([]FieldValue_v2, error) {
+	fieldsID, err := FieldGroupCreate(fmt.Sprintf("inventoryFields%d", rand.Uint64()), inventoryFields)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := FieldGroupDestroy(fieldsID); ret != nil {
+			log.Printf("error destroying field group: %v", ret)
+		}
+	}()
+
+	groupID, err := NewDefaultGroup(fmt.Sprintf("inventory%d", rand.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := DestroyGroup(groupID); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+	}()
+
+	if err := WatchFieldsWithGroup(fieldsID, groupID); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := UnwatchFields(fieldsID, groupID); ret != nil {
+			log.Printf("error unwatching inventory fields: %v", ret)
+		}
+	}()
+
+	entities := make([]GroupEntityPair, len(gpus))
+	for i, gpu := range gpus {
+		entities[i] = GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu}
+	}
+	values, err := EntitiesGetLatestValues(entities, inventoryFields, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error getting inventory: %w", err)
+	}
+	return values, nil
+}
+
+// newInventoryGPU decodes the values of the inventory fields of a GPU, in the
+// order of inventoryFields. Fields without data are left empty.
+func newInventoryGPU(gpuID uint, values []TypedValue, dcgmSupported bool, hierarchy *CPUHierarchy_v2) InventoryGPU {
+	field := func(id Short) TypedValue {
+		for _, value := range values {
+			if value.FieldID == id {
+				return value
+			}
+		}
+		return TypedValue{}
+	}
+	str := func(id Short) string {
+		s, _ := field(id).Str()
+		return s
+	}
+	number := func(id Short) int64 {
+		n, _ := field(id).Number()
+		return int64(n)
+	}
+
+	var affinity []TypedValue
+	for _, id := range affinityFields {
+		affinity = append(affinity, field(id))
+	}
+	cpus, memory := affinitySets(affinity)
+
+	device := Device{
+		GPU:           gpuID,
+		DCGMSupported: "No",
+		UUID:          str(DCGM_FI_DEV_GPU_UUID),
+		Power:         uint(number(DCGM_FI_DEV_BOARD_POWER_LIMIT_DEFAULT_WATTS)),
+		PCI: PCIInfo{
+			BusID:   str(DCGM_FI_DEV_PCI_BUS_ID),
+			BAR1:    uint(number(DCGM_FI_DEV_BAR1_TOTAL)),
+			FBTotal: uint(number(DCGM_FI_DEV_FB_TOTAL)),
+		},
+		Identifiers: DeviceIdentifiers{
+			Brand:               str(DCGM_FI_DEV_GPU_BRAND),
+			Model:               str(DCGM_FI_DEV_GPU_NAME),
+			Serial:              str(DCGM_FI_DEV_BOARD_SERIAL),
+			Vbios:               str(DCGM_FI_DEV_VBIOS_VERSION),
+			InforomImageVersion: str(DCGM_FI_DEV_INFOROM_IMAGE_VERSION),
+			DriverVersion:       str(DCGM_FI_SYSTEM_DRIVER_VERSION),
+		},
+	}
+	if dcgmSupported {
+		device.DCGMSupported = "Yes"
+		device.PCI.Bandwidth = pciBandwidth(number(DCGM_FI_DEV_PCIE_MAX_LINK_GEN), number(DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH))
+		device.CPUAffinity = cpus.bits.String()
+		device.CPUs = cpus
+	}
+
+	return InventoryGPU{
+		Device:   device,
+		Affinity: newGPUAffinity(gpuID, cpus, memory, hierarchy),
+		MIGMode:  number(DCGM_FI_DEV_MIG_MODE) == 1,
+	}
+}
+
+// setPeerBusIDs sets the bus IDs of the peers of a GPU from the inventory
+// values of the GPUs. Peers without a bus ID, e.g. lost GPUs, are left empty.
+func setPeerBusIDs(links []P2PLink, byGPU map[uint][]TypedValue) {
+	for i := range links {
+		for _, value := range byGPU[links[i].GPU] {
+			if value.FieldID == DCGM_FI_DEV_PCI_BUS_ID {
+				links[i].BusID, _ = value.Str()
+			}
+		}
+	}
+}
+
+// migInstances returns the GPU instances of a GPU, each followed by its
+// compute instances
+func migInstances(hierarchy MigHierarchy_v2, gpuID uint) []MigHierarchyInfo_v2 {
+	entities := hierarchy.EntityList[:min(hierarchy.Count, MAX_HIERARCHY_INFO)]
+
+	var instances []MigHierarchyInfo_v2
+	for _, instance := range entities {
+		if instance.Entity.EntityGroupId != FE_GPU_I || instance.Parent != (GroupEntityPair{FE_GPU, gpuID}) {
+			continue
+		}
+		instances = append(instances, instance)
+		for _, compute := range entities {
+			if compute.Entity.EntityGroupId == FE_GPU_CI && compute.Parent == instance.Entity {
+				instances = append(instances, compute)
+			}
+		}
+	}
+	return instances
+}
@@ -0,0 +1,120 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func inventoryTestValues() []TypedValue {
+	str := func(id Short, s string) TypedValue {
+		return typedTestValue(id, DCGM_FT_STRING, []byte(s)).TypedValue()
+	}
+	integer := func(id Short, n int64) TypedValue {
+		return typedTestValue(id, DCGM_FT_INT64, int64Bytes(n)).TypedValue()
+	}
+	return []TypedValue{
+		str(DCGM_FI_DEV_GPU_UUID, "GPU-1234"),
+		str(DCGM_FI_DEV_GPU_NAME, "NVIDIA H100 80GB HBM3"),
+		str(DCGM_FI_DEV_BOARD_SERIAL, "1650000000001"),
+		str(DCGM_FI_DEV_PCI_BUS_ID, "00000000:1B:00.0"),
+		integer(DCGM_FI_DEV_FB_TOTAL, 81559),
+		integer(DCGM_FI_DEV_PCIE_MAX_LINK_GEN, 4),
+		integer(DCGM_FI_DEV_PCIE_MAX_LINK_WIDTH, 16),
+		typedTestValue(DCGM_FI_DEV_BOARD_POWER_LIMIT_DEFAULT_WATTS, DCGM_FT_DOUBLE, float64Bytes(700)).TypedValue(),
+		integer(DCGM_FI_DEV_MIG_MODE, 1),
+		integer(DCGM_FI_DEV_CPU_AFFINITY_0, 0xff),
+		integer(DCGM_FI_DEV_CPU_AFFINITY_1, 0x1),
+		integer(DCGM_FI_DEV_MEMORY_AFFINITY_0, 0x2),
+		integer(DCGM_FI_DEV_MEMORY_AFFINITY_1, DCGM_FT_INT64_NOT_SUPPORTED),
+	}
+}
+
+func TestNewInventoryGPU(t *testing.T) {
+	hierarchy := &CPUHierarchy_v2{NumCPUs: 2}
+	hierarchy.CPUs[0] = CPUHierarchyCPU_v2{CPUID: 0, OwnedCores: []uint64{0xffff}}
+	hierarchy.CPUs[1] = CPUHierarchyCPU_v2{CPUID: 1, OwnedCores: []uint64{0, 0xffff}}
+
+	gpu := newInventoryGPU(3, inventoryTestValues(), true, hierarchy)
+
+	assert.Equal(t, uint(3), gpu.GPU)
+	assert.Equal(t, "Yes", gpu.DCGMSupported)
+	assert.Equal(t, "GPU-1234", gpu.UUID)
+	assert.Equal(t, "NVIDIA H100 80GB HBM3", gpu.Identifiers.Model)
+	assert.Equal(t, "1650000000001", gpu.Identifiers.Serial)
+	assert.Empty(t, gpu.Identifiers.Vbios)
+	assert.Equal(t, PCIInfo{BusID: "00000000:1B:00.0", FBTotal: 81559, Bandwidth: 1969 * 16}, gpu.PCI)
+	assert.Equal(t, uint(700), gpu.Power)
+	assert.True(t, gpu.MIGMode)
+	assert.Equal(t, "0-7,64", gpu.CPUs.String())
+	assert.Equal(t, gpu.CPUs, gpu.Affinity.CPUs)
+	assert.Equal(t, []uint{1}, gpu.Affinity.MemoryNodes)
+	assert.Equal(t, 1, gpu.Affinity.NUMANode)
+	assert.Equal(t, []uint{0, 1}, gpu.Affinity.CPUIDs)
+
+	unsupported := newInventoryGPU(3, inventoryTestValues(), false, nil)
+	assert.Equal(t, "No", unsupported.DCGMSupported)
+	assert.Empty(t, unsupported.CPUAffinity)
+	assert.Zero(t, unsupported.PCI.Bandwidth)
+	assert.Equal(t, "0-7,64", unsupported.Affinity.CPUs.String())
+	assert.Empty(t, unsupported.Affinity.CPUIDs)
+
+	empty := newInventoryGPU(0, nil, false, nil)
+	assert.Equal(t, -1, empty.Affinity.NUMANode)
+	assert.False(t, empty.MIGMode)
+}
+
+func TestMigInstances(t *testing.T) {
+	hierarchy := MigHierarchy_v2{Count: 5}
+	hierarchy.EntityList[0] = MigHierarchyInfo_v2{Entity: GroupEntityPair{FE_GPU_I, 0}, Parent: GroupEntityPair{FE_GPU, 0}}
+	hierarchy.EntityList[1] = MigHierarchyInfo_v2{Entity: GroupEntityPair{FE_GPU_I, 1}, Parent: GroupEntityPair{FE_GPU, 1}}
+	hierarchy.EntityList[2] = MigHierarchyInfo_v2{Entity: GroupEntityPair{FE_GPU_CI, 0}, Parent: GroupEntityPair{FE_GPU_I, 1}}
+	hierarchy.EntityList[3] = MigHierarchyInfo_v2{Entity: GroupEntityPair{FE_GPU_I, 2}, Parent: GroupEntityPair{FE_GPU, 1}}
+	hierarchy.EntityList[4] = MigHierarchyInfo_v2{Entity: GroupEntityPair{FE_GPU_CI, 1}, Parent: GroupEntityPair{FE_GPU_I, 1}}
+
+	var entities []GroupEntityPair
+	for _, instance := range migInstances(hierarchy, 1) {
+		entities = append(entities, instance.Entity)
+	}
+	assert.Equal(t, []GroupEntityPair{{FE_GPU_I, 1}, {FE_GPU_CI, 0}, {FE_GPU_CI, 1}, {FE_GPU_I, 2}}, entities)
+	assert.Empty(t, migInstances(hierarchy, 2))
+}
+
+func TestSetPeerBusIDs(t *testing.T) {
+	lost := typedTestValue(DCGM_FI_DEV_PCI_BUS_ID, DCGM_FT_STRING, []byte("00000000:1B:00.0"))
+	lost.Status = DCGM_ST_GPU_IS_LOST
+	byGPU := map[uint][]TypedValue{
+		1: {typedTestValue(DCGM_FI_DEV_PCI_BUS_ID, DCGM_FT_STRING, []byte("00000000:0F:00.0")).TypedValue()},
+		2: {lost.TypedValue()},
+	}
+	links := []P2PLink{{GPU: 1}, {GPU: 2}, {GPU: 3}}
+
+	setPeerBusIDs(links, byGPU)
+	assert.Equal(t, "00000000:0F:00.0", links[0].BusID)
+	assert.Empty(t, links[1].BusID)
+	assert.Empty(t, links[2].BusID)
+}
+
+func TestInventoryJSON(t *testing.T) {
+	inventory := Inventory{
+		GPUs:    []InventoryGPU{newInventoryGPU(3, inventoryTestValues(), true, nil)},
+		NvLinks: []NvLinkStatus{{ParentId: 3, ParentType: FE_GPU, State: LS_UP}},
+	}
+
+	b, err := json.Marshal(inventory)
+	require.NoError(t, err)
+
+	var decoded Inventory
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, inventory.GPUs[0].UUID, decoded.GPUs[0].UUID)
+	assert.Equal(t, "0-7,64", decoded.GPUs[0].CPUs.String())
+	assert.Equal(t, inventory.NvLinks, decoded.NvLinks)
+
+	gpu, ok := decoded.GPU(3)
+	require.True(t, ok)
+	assert.Equal(t, 1, gpu.Affinity.NUMANode)
+	_, ok = decoded.GPU(0)
+	assert.False(t, ok)
+}
@@ -39,31 +39,42 @@ const (
 	FE_COUNT Field_Entity_Group = C.DCGM_FE_COUNT
 )
 
+var fieldEntityGroupNames = newEnumNames("entity group", map[Field_Entity_Group]string{
+	FE_NONE:     "None",
+	FE_GPU:      "GPU",
+	FE_VGPU:     "vGPU",
+	FE_SWITCH:   "NvSwitch",
+	FE_GPU_I:    "GPU Instance",
+	FE_GPU_CI:   "GPU Compute Instance",
+	FE_LINK:     "NvLink",
+	FE_CPU:      "CPU",
+	FE_CPU_CORE: "CPU Core",
+	FE_CONNECTX: "ConnectX",
+})
+
 // String returns a string representation of the Field_Entity_Group
 func (e Field_Entity_Group) String() string {
-	switch e {
-	case FE_GPU:
-		return "GPU"
-	case FE_VGPU:
-		return "vGPU"
-	case FE_SWITCH:
-		return "NvSwitch"
-	case FE_GPU_I:
-		return "GPU Instance"
-	case FE_GPU_CI:
-		return "GPU Compute Instance"
-	case FE_LINK:
-		return "NvLink"
-	case FE_CPU:
-		return "CPU"
-	case FE_CPU_CORE:
-		return "CPU Core"
-	case FE_CONNECTX:
-		return "ConnectX"
+	if name, ok := fieldEntityGroupNames.name(e); ok {
+		return name
 	}
 	return "unknown"
 }
 
+// MarshalText encodes the entity group as its name
+func (e Field_Entity_Group) MarshalText() ([]byte, error) {
+	return fieldEntityGroupNames.text(e), nil
+}
+
+// UnmarshalText decodes an entity group name or number
+func (e *Field_Entity_Group) UnmarshalText(text []byte) error {
+	group, err := fieldEntityGroupNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*e = group
+	return nil
+}
+
 // GroupEntityPair represents a DCGM entity and its group identifier
 type GroupEntityPair struct {
 	// EntityGroupId specifies the type of the entity
@@ -0,0 +1,439 @@
+package dcgm
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// migTreeFields are the fields read for the GPUs and GPU instances of a MigTree
+var migTreeFields = []Short{
+	DCGM_FI_DEV_GPU_UUID,
+	DCGM_FI_DEV_NVML_INDEX,
+	DCGM_FI_DEV_MIG_MODE,
+	DCGM_FI_DEV_MIG_MAX_SLICES,
+	DCGM_FI_DEV_FB_TOTAL,
+}
+
+// MigTree is the MIG hierarchy of the node as a tree of GPUs, GPU instances
+// and compute instances
+type MigTree struct {
+	// GPUs are the GPUs with MIG enabled, ordered by entity ID
+	GPUs []MigGPU
+}
+
+// MigGPU is a GPU of a MigTree
+type MigGPU struct {
+	// GPU is the entity ID of the GPU
+	GPU uint
+	// UUID is the UUID of the GPU
+	UUID string
+	// NvmlIndex is the NVML index of the GPU
+	NvmlIndex uint
+	// TotalSlices is the number of MIG slices of the GPU, 0 when unknown
+	TotalSlices uint
+	// Instances are the GPU instances, ordered by NVML instance ID
+	Instances []MigGPUInstance
+}
+
+// MigGPUInstance is a GPU instance of a MigTree
+type MigGPUInstance struct {
+	// EntityID is the FE_GPU_I entity ID of the instance
+	EntityID uint
+	// NvmlInstanceID is the NVML GPU instance ID
+	NvmlInstanceID uint
+	// Profile is the MIG profile of the instance
+	Profile MigProfile
+	// ProfileName is the name of the profile, e.g. "1g.10gb"
+	ProfileName string
+	// Slices is the number of slices of the instance
+	Slices uint
+	// MemoryMiB is the frame buffer of the instance, 0 when unknown
+	MemoryMiB uint
+	// ComputeInstances are the compute instances, ordered by NVML compute instance ID
+	ComputeInstances []MigComputeInstance
+}
+
+// MigComputeInstance is a compute instance of a MigTree, i.e. a MIG device
+type MigComputeInstance struct {
+	// EntityID is the FE_GPU_CI entity ID of the instance
+	EntityID uint
+	// NvmlComputeInstanceID is the NVML compute instance ID within the GPU instance
+	NvmlComputeInstanceID uint
+	// Profile is the MIG profile of the instance
+	Profile MigProfile
+	// ProfileName is the name of the profile, e.g. "1c.3g.40gb", or the name of
+	// the GPU instance profile when the instance uses all its slices
+	ProfileName string
+	// Slices is the number of slices of the instance
+	Slices uint
+	// UUID is the MIG device UUID in the "MIG-<GPU UUID>/<GI>/<CI>" format
+	UUID string
+	// DeviceUUID is the MIG device UUID reported by the driver, e.g.
+	// "MIG-a9f3b2c1-...", empty when unknown
+	DeviceUUID string
+}
+
+// UsedSlices returns the number of slices used by the GPU instances
+func (g MigGPU) UsedSlices() uint {
+	var used uint
+	for _, instance := range g.Instances {
+		used += instance.Slices
+	}
+	return used
+}
+
+// FreeSlices returns the number of slices not used by a GPU instance
+func (g MigGPU) FreeSlices() uint {
+	used := g.UsedSlices()
+	if used > g.TotalSlices {
+		return 0
+	}
+	return g.TotalSlices - used
+}
+
+// GPU returns a GPU by entity ID
+func (t *MigTree) GPU(gpuID uint) (MigGPU, bool) {
+	for _, gpu := range t.GPUs {
+		if gpu.GPU == gpuID {
+			return gpu, true
+		}
+	}
+	return MigGPU{}, false
+}
+
+// GPUInstance returns a GPU instance by FE_GPU_I entity ID, with its GPU
+func (t *MigTree) GPUInstance(entityID uint) (MigGPUInstance, MigGPU, bool) {
+	for _, gpu := range t.GPUs {
+		for _, instance := range gpu.Instances {
+			if instance.EntityID == entityID {
+				return instance, gpu, true
+			}
+		}
+	}
+	return MigGPUInstance{}, MigGPU{}, false
+}
+
+// ComputeInstance returns a compute instance by FE_GPU_CI entity ID, with its
+// GPU instance
+func (t *MigTree) ComputeInstance(entityID uint) (MigComputeInstance, MigGPUInstance, bool) {
+	return t.findComputeInstance(func(_ MigGPU, _ MigGPUInstance, ci MigComputeInstance) bool {
+		return ci.EntityID == entityID
+	})
+}
+
+// GPUInstanceByNvmlID returns a GPU instance of a GPU by NVML GPU instance ID
+func (t *MigTree) GPUInstanceByNvmlID(gpuID, nvmlInstanceID uint) (MigGPUInstance, bool) {
+	gpu, ok := t.GPU(gpuID)
+	if !ok {
+		return MigGPUInstance{}, false
+	}
+	for _, instance := range gpu.Instances {
+		if instance.NvmlInstanceID == nvmlInstanceID {
+			return instance, true
+		}
+	}
+	return MigGPUInstance{}, false
+}
+
+// ComputeInstanceByNvmlID returns a compute instance of a GPU by NVML GPU
+// instance and compute instance IDs
+func (t *MigTree) ComputeInstanceByNvmlID(gpuID, nvmlInstanceID, nvmlComputeInstanceID uint) (MigComputeInstance, bool) {
+	ci, _, ok := t.findComputeInstance(func(gpu MigGPU, gi MigGPUInstance, ci MigComputeInstance) bool {
+		return gpu.GPU == gpuID && gi.NvmlInstanceID == nvmlInstanceID && ci.NvmlComputeInstanceID == nvmlComputeInstanceID
+	})
+	return ci, ok
+}
+
+// ComputeInstanceByUUID returns a compute instance by MIG device UUID, either
+// in the "MIG-<GPU UUID>/<GI>/<CI>" format or as reported by the driver
+func (t *MigTree) ComputeInstanceByUUID(uuid string) (MigComputeInstance, MigGPUInstance, bool) {
+	return t.findComputeInstance(func(_ MigGPU, _ MigGPUInstance, ci MigComputeInstance) bool {
+		return ci.UUID == uuid || (ci.DeviceUUID != "" && ci.DeviceUUID == uuid)
+	})
+}
+
+// Resolve returns the entity of a MIG device or GPU name, as accepted by
+// CUDA_VISIBLE_DEVICES:
+//   - a MIG device UUID, "MIG-<uuid>" or "MIG-<GPU UUID>/<GI>/<CI>", or NVML
+//     indices "<GPU>:<GI>:<CI>" resolve to an FE_GPU_CI entity
+//   - NVML indices "<GPU>:<GI>" resolve to an FE_GPU_I entity
+//   - a GPU UUID "GPU-<uuid>" resolves to an FE_GPU entity
+func (t *MigTree) Resolve(name string) (GroupEntityPair, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case strings.HasPrefix(name, "MIG-"):
+		if ci, _, ok := t.ComputeInstanceByUUID(name); ok {
+			return GroupEntityPair{EntityGroupId: FE_GPU_CI, EntityId: ci.EntityID}, nil
+		}
+		return GroupEntityPair{}, fmt.Errorf("unknown MIG device %q", name)
+	case strings.HasPrefix(name, "GPU-"):
+		for _, gpu := range t.GPUs {
+			if gpu.UUID == name {
+				return GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu.GPU}, nil
+			}
+		}
+		return GroupEntityPair{}, fmt.Errorf("unknown GPU %q", name)
+	}
+
+	indices, err := parseMigIndices(name)
+	if err != nil {
+		return GroupEntityPair{}, err
+	}
+	gpu, ok := t.gpuByNvmlIndex(indices[0])
+	if !ok {
+		return GroupEntityPair{}, fmt.Errorf("unknown MIG device %q: no GPU with NVML index %d", name, indices[0])
+	}
+	gi, ok := t.GPUInstanceByNvmlID(gpu.GPU, indices[1])
+	if !ok {
+		return GroupEntityPair{}, fmt.Errorf("unknown MIG device %q: no GPU instance %d", name, indices[1])
+	}
+	if len(indices) == 2 {
+		return GroupEntityPair{EntityGroupId: FE_GPU_I, EntityId: gi.EntityID}, nil
+	}
+	ci, ok := t.ComputeInstanceByNvmlID(gpu.GPU, indices[1], indices[2])
+	if !ok {
+		return GroupEntityPair{}, fmt.Errorf("unknown MIG device %q: no compute instance %d", name, indices[2])
+	}
+	return GroupEntityPair{EntityGroupId: FE_GPU_CI, EntityId: ci.EntityID}, nil
+}
+
+// NvmlIndices returns the NVML indices of a GPU instance, "<GPU>:<GI>", or of
+// a compute instance, "<GPU>:<GI>:<CI>"
+func (t *MigTree) NvmlIndices(entity GroupEntityPair) (string, error) {
+	switch entity.EntityGroupId {
+	case FE_GPU_I:
+		gi, gpu, ok := t.GPUInstance(entity.EntityId)
+		if !ok {
+			return "", fmt.Errorf("unknown GPU instance %d", entity.EntityId)
+		}
+		return fmt.Sprintf("%d:%d", gpu.NvmlIndex, gi.NvmlInstanceID), nil
+	case FE_GPU_CI:
+		ci, gi, ok := t.ComputeInstance(entity.EntityId)
+		if !ok {
+			return "", fmt.Errorf("unknown compute instance %d", entity.EntityId)
+		}
+		_, gpu, _ := t.GPUInstance(gi.EntityID)
+		return fmt.Sprintf("%d:%d:%d", gpu.NvmlIndex, gi.NvmlInstanceID, ci.NvmlComputeInstanceID), nil
+	default:
+		return "", fmt.Errorf("entity group %s has no MIG indices", entity.EntityGroupId)
+	}
+}
+
+// UUID returns the UUID of a GPU or of a compute instance, preferring the
+// UUID reported by the driver. GPU instances have no UUID.
+func (t *MigTree) UUID(entity GroupEntityPair) (string, error) {
+	switch entity.EntityGroupId {
+	case FE_GPU:
+		gpu, ok := t.GPU(entity.EntityId)
+		if !ok || gpu.UUID == "" {
+			return "", fmt.Errorf("unknown GPU %d", entity.EntityId)
+		}
+		return gpu.UUID, nil
+	case FE_GPU_CI:
+		ci, _, ok := t.ComputeInstance(entity.EntityId)
+		if !ok {
+			return "", fmt.Errorf("unknown compute instance %d", entity.EntityId)
+		}
+		return cmp.Or(ci.DeviceUUID, ci.UUID), nil
+	default:
+		return "", fmt.Errorf("entity group %s has no UUID", entity.EntityGroupId)
+	}
+}
+
+func (t *MigTree) gpuByNvmlIndex(index uint) (MigGPU, bool) {
+	for _, gpu := range t.GPUs {
+		if gpu.NvmlIndex == index {
+			return gpu, true
+		}
+	}
+	return MigGPU{}, false
+}
+
+// parseMigIndices parses the NVML indices "<GPU>:<GI>" or "<GPU>:<GI>:<CI>"
+func parseMigIndices(s string) ([]uint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid MIG device %q", s)
+	}
+	indices := make([]uint, len(parts))
+	for i, part := range parts {
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MIG device %q", s)
+		}
+		indices[i] = uint(index)
+	}
+	return indices, nil
+}
+
+func (t *MigTree) findComputeInstance(match func(MigGPU, MigGPUInstance, MigComputeInstance) bool) (MigComputeInstance, MigGPUInstance, bool) {
+	for _, gpu := range t.GPUs {
+		for _, gi := range gpu.Instances {
+			for _, ci := range gi.ComputeInstances {
+				if match(gpu, gi, ci) {
+					return ci, gi, true
+				}
+			}
+		}
+	}
+	return MigComputeInstance{}, MigGPUInstance{}, false
+}
+
+// migProfile returns the MigProfile of an NVML GPU or compute instance
+// profile ID
+func migProfile(group Field_Entity_Group, nvmlProfileID uint) MigProfile {
+	if group == FE_GPU_CI {
+		return MigProfileComputeInstanceSlice1 + MigProfile(nvmlProfileID)
+	}
+	return MigProfileGPUInstanceSlice1 + MigProfile(nvmlProfileID)
+}
+
+// migGPUInstanceProfileName names a GPU instance profile like nvidia-smi,
+// e.g. "1g.10gb" or "1g.10gb+me" for media extensions. The memory is left out
+// when unknown.
+func migGPUInstanceProfileName(profile MigProfile, slices, memoryMiB uint) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%dg", slices)
+	if memoryMiB > 0 {
+		fmt.Fprintf(&sb, ".%dgb", (memoryMiB+1023)/1024)
+	}
+	if profile == MigProfileGPUInstanceSlice1Rev1 || profile == MigProfileGPUInstanceSlice2Rev1 {
+		sb.WriteString("+me")
+	}
+	return sb.String()
+}
+
+// migComputeInstanceProfileName names a compute instance profile like
+// nvidia-smi, e.g. "1c.3g.40gb"
+func migComputeInstanceProfileName(slices uint, gi MigGPUInstance) string {
+	if slices >= gi.Slices {
+		return gi.ProfileName
+	}
+	return fmt.Sprintf("%dc.%s", slices, gi.ProfileName)
+}
+
+// migDeviceUUID returns the UUID of a MIG device in the
+// "MIG-<GPU UUID>/<GI>/<CI>" format
+func migDeviceUUID(gpuUUID string, nvmlInstanceID, nvmlComputeInstanceID uint) string {
+	return fmt.Sprintf("MIG-%s/%d/%d", gpuUUID, nvmlInstanceID, nvmlComputeInstanceID)
+}
+
+// getMigTree reads the MIG hierarchy and completes it with the slices and
+// memory of the GPUs and GPU instances and the UUIDs of the compute instances
+func getMigTree() (*MigTree, error) {
+	hierarchy, err := GetGPUInstanceHierarchy()
+	if err != nil {
+		return nil, err
+	}
+	gpus, err := getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]GroupEntityPair, 0, len(gpus)+int(hierarchy.Count))
+	for _, gpu := range gpus {
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpu})
+	}
+	for _, entity := range hierarchy.EntityList[:min(hierarchy.Count, MAX_HIERARCHY_INFO)] {
+		if entity.Entity.EntityGroupId == FE_GPU_I || entity.Entity.EntityGroupId == FE_GPU_CI {
+			entities = append(entities, entity.Entity)
+		}
+	}
+
+	var values []TypedValue
+	if len(entities) > 0 {
+		fieldValues, err := EntitiesGetLatestValues(entities, migTreeFields, DCGM_FV_FLAG_LIVE_DATA)
+		if err != nil {
+			return nil, fmt.Errorf("get MIG fields: %w", err)
+		}
+		for _, value := range fieldValues {
+			values = append(values, value.TypedValue())
+		}
+	}
+	return newMigTree(hierarchy, gpus, values), nil
+}
+
+// newMigTree builds the tree of the GPUs with MIG enabled or with GPU
+// instances
+func newMigTree(hierarchy MigHierarchy_v2, gpus []uint, values []TypedValue) *MigTree {
+	number := func(entity GroupEntityPair, field Short) uint {
+		for _, value := range values {
+			if value.EntityGroupId == entity.EntityGroupId && value.EntityID == entity.EntityId && value.FieldID == field {
+				n, _ := value.Number()
+				return uint(n)
+			}
+		}
+		return 0
+	}
+	str := func(entity GroupEntityPair, field Short) string {
+		for _, value := range values {
+			if value.EntityGroupId == entity.EntityGroupId && value.EntityID == entity.EntityId && value.FieldID == field {
+				s, _ := value.Str()
+				return s
+			}
+		}
+		return ""
+	}
+
+	entities := hierarchy.EntityList[:min(hierarchy.Count, MAX_HIERARCHY_INFO)]
+	tree := &MigTree{}
+	for _, gpuID := range gpus {
+		entity := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: gpuID}
+		gpu := MigGPU{
+			GPU:         gpuID,
+			UUID:        str(entity, DCGM_FI_DEV_GPU_UUID),
+			NvmlIndex:   number(entity, DCGM_FI_DEV_NVML_INDEX),
+			TotalSlices: number(entity, DCGM_FI_DEV_MIG_MAX_SLICES),
+		}
+
+		for _, info := range entities {
+			if info.Entity.EntityGroupId != FE_GPU_I || info.Parent != entity {
+				continue
+			}
+			gpu.UUID = cmp.Or(gpu.UUID, info.Info.GpuUuid)
+			gpu.NvmlIndex = info.Info.NvmlGpuIndex
+
+			gi := MigGPUInstance{
+				EntityID:       info.Entity.EntityId,
+				NvmlInstanceID: info.Info.NvmlInstanceId,
+				Profile:        migProfile(FE_GPU_I, info.Info.NvmlMigProfileId),
+				Slices:         info.Info.NvmlProfileSlices,
+				MemoryMiB:      number(info.Entity, DCGM_FI_DEV_FB_TOTAL),
+			}
+			gi.ProfileName = migGPUInstanceProfileName(gi.Profile, gi.Slices, gi.MemoryMiB)
+
+			for _, ciInfo := range entities {
+				if ciInfo.Entity.EntityGroupId != FE_GPU_CI || ciInfo.Parent != info.Entity {
+					continue
+				}
+				ci := MigComputeInstance{
+					EntityID:              ciInfo.Entity.EntityId,
+					NvmlComputeInstanceID: ciInfo.Info.NvmlComputeInstanceId,
+					Profile:               migProfile(FE_GPU_CI, ciInfo.Info.NvmlMigProfileId),
+					Slices:                ciInfo.Info.NvmlProfileSlices,
+				}
+				ci.ProfileName = migComputeInstanceProfileName(ci.Slices, gi)
+				ci.UUID = migDeviceUUID(gpu.UUID, gi.NvmlInstanceID, ci.NvmlComputeInstanceID)
+				if uuid := str(ciInfo.Entity, DCGM_FI_DEV_GPU_UUID); strings.HasPrefix(uuid, "MIG-") {
+					ci.DeviceUUID = uuid
+				}
+				gi.ComputeInstances = append(gi.ComputeInstances, ci)
+			}
+			slices.SortFunc(gi.ComputeInstances, func(a, b MigComputeInstance) int {
+				return cmp.Compare(a.NvmlComputeInstanceID, b.NvmlComputeInstanceID)
+			})
+			gpu.Instances = append(gpu.Instances, gi)
+		}
+
+		if len(gpu.Instances) == 0 && number(entity, DCGM_FI_DEV_MIG_MODE) != 1 {
+			continue
+		}
+		slices.SortFunc(gpu.Instances, func(a, b MigGPUInstance) int { return cmp.Compare(a.NvmlInstanceID, b.NvmlInstanceID) })
+		tree.GPUs = append(tree.GPUs, gpu)
+	}
+	slices.SortFunc(tree.GPUs, func(a, b MigGPU) int { return cmp.Compare(a.GPU, b.GPU) })
+	return tree
+}
@@ -0,0 +1,186 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	migTestGPUUUID    = "GPU-5fa4d6a0-8b3e-4c2a-9f31-0d7f6a1b2c3d"
+	migTestDeviceUUID = "MIG-0b1c2d3e-4f5a-5b6c-8d7e-9f0a1b2c3d4e"
+)
+
+// migTestTree is an 80GB GPU with a 3g.40gb instance split into two compute
+// instances and a 1g.10gb instance, and a GPU with MIG enabled and no instance
+func migTestTree() *MigTree {
+	hierarchy := MigHierarchy_v2{Count: 5}
+	gi := func(id, nvmlID, profileID, slices uint) MigHierarchyInfo_v2 {
+		return MigHierarchyInfo_v2{
+			Entity: GroupEntityPair{FE_GPU_I, id},
+			Parent: GroupEntityPair{FE_GPU, 0},
+			Info: MigEntityInfo{
+				GpuUuid:           migTestGPUUUID,
+				NvmlGpuIndex:      0,
+				NvmlInstanceId:    nvmlID,
+				NvmlMigProfileId:  profileID,
+				NvmlProfileSlices: slices,
+			},
+		}
+	}
+	ci := func(id, parent, nvmlGI, nvmlID, profileID, slices uint) MigHierarchyInfo_v2 {
+		return MigHierarchyInfo_v2{
+			Entity: GroupEntityPair{FE_GPU_CI, id},
+			Parent: GroupEntityPair{FE_GPU_I, parent},
+			Info: MigEntityInfo{
+				GpuUuid:               migTestGPUUUID,
+				NvmlInstanceId:        nvmlGI,
+				NvmlComputeInstanceId: nvmlID,
+				NvmlMigProfileId:      profileID,
+				NvmlProfileSlices:     slices,
+			},
+		}
+	}
+	hierarchy.EntityList[0] = gi(1, 9, 0, 1)
+	hierarchy.EntityList[1] = ci(2, 0, 2, 1, 0, 1)
+	hierarchy.EntityList[2] = gi(0, 2, 2, 3)
+	hierarchy.EntityList[3] = ci(1, 0, 2, 0, 1, 2)
+	hierarchy.EntityList[4] = ci(0, 1, 9, 0, 0, 1)
+
+	number := func(group Field_Entity_Group, id uint, field Short, n int64) TypedValue {
+		value := typedTestValue(field, DCGM_FT_INT64, int64Bytes(n))
+		value.EntityGroupId, value.EntityID = group, id
+		return value.TypedValue()
+	}
+	values := []TypedValue{
+		number(FE_GPU, 0, DCGM_FI_DEV_MIG_MAX_SLICES, 7),
+		number(FE_GPU, 0, DCGM_FI_DEV_MIG_MODE, 1),
+		number(FE_GPU, 1, DCGM_FI_DEV_MIG_MAX_SLICES, 7),
+		number(FE_GPU, 1, DCGM_FI_DEV_MIG_MODE, 1),
+		number(FE_GPU, 1, DCGM_FI_DEV_NVML_INDEX, 1),
+		number(FE_GPU, 2, DCGM_FI_DEV_MIG_MODE, 0),
+		number(FE_GPU_I, 0, DCGM_FI_DEV_FB_TOTAL, 40192),
+		number(FE_GPU_I, 1, DCGM_FI_DEV_FB_TOTAL, 9856),
+		fieldValueV2String(FE_GPU_CI, 0, DCGM_FI_DEV_GPU_UUID, migTestDeviceUUID).TypedValue(),
+	}
+	return newMigTree(hierarchy, []uint{2, 1, 0}, values)
+}
+
+func TestMigTree(t *testing.T) {
+	tree := migTestTree()
+
+	require.Len(t, tree.GPUs, 2, "GPUs without MIG are left out")
+	gpu := tree.GPUs[0]
+	assert.Equal(t, uint(0), gpu.GPU)
+	assert.Equal(t, migTestGPUUUID, gpu.UUID)
+	assert.Equal(t, uint(7), gpu.TotalSlices)
+	assert.Equal(t, uint(4), gpu.UsedSlices())
+	assert.Equal(t, uint(3), gpu.FreeSlices())
+
+	require.Len(t, gpu.Instances, 2)
+	large := gpu.Instances[0]
+	assert.Equal(t, uint(2), large.NvmlInstanceID)
+	assert.Equal(t, MigProfileGPUInstanceSlice3, large.Profile)
+	assert.Equal(t, "3g.40gb", large.ProfileName)
+	require.Len(t, large.ComputeInstances, 2)
+	assert.Equal(t, "2c.3g.40gb", large.ComputeInstances[0].ProfileName)
+	assert.Equal(t, MigProfileComputeInstanceSlice2, large.ComputeInstances[0].Profile)
+	assert.Equal(t, "1c.3g.40gb", large.ComputeInstances[1].ProfileName)
+	assert.Equal(t, "MIG-"+migTestGPUUUID+"/2/1", large.ComputeInstances[1].UUID)
+
+	small := gpu.Instances[1]
+	assert.Equal(t, "1g.10gb", small.ProfileName)
+	assert.Equal(t, "1g.10gb", small.ComputeInstances[0].ProfileName)
+
+	empty := tree.GPUs[1]
+	assert.Equal(t, uint(1), empty.NvmlIndex)
+	assert.Empty(t, empty.Instances)
+	assert.Equal(t, uint(7), empty.FreeSlices())
+}
+
+func TestMigTreeLookups(t *testing.T) {
+	tree := migTestTree()
+
+	_, ok := tree.GPU(2)
+	assert.False(t, ok)
+
+	gi, gpu, ok := tree.GPUInstance(1)
+	require.True(t, ok)
+	assert.Equal(t, uint(9), gi.NvmlInstanceID)
+	assert.Equal(t, uint(0), gpu.GPU)
+
+	ci, parent, ok := tree.ComputeInstance(2)
+	require.True(t, ok)
+	assert.Equal(t, uint(1), ci.NvmlComputeInstanceID)
+	assert.Equal(t, uint(0), parent.EntityID)
+
+	gi, ok = tree.GPUInstanceByNvmlID(0, 2)
+	require.True(t, ok)
+	assert.Equal(t, uint(0), gi.EntityID)
+	_, ok = tree.GPUInstanceByNvmlID(1, 2)
+	assert.False(t, ok)
+
+	ci, ok = tree.ComputeInstanceByNvmlID(0, 9, 0)
+	require.True(t, ok)
+	assert.Equal(t, uint(0), ci.EntityID)
+
+	ci, parent, ok = tree.ComputeInstanceByUUID("MIG-" + migTestGPUUUID + "/2/0")
+	require.True(t, ok)
+	assert.Equal(t, uint(1), ci.EntityID)
+	assert.Equal(t, uint(2), parent.NvmlInstanceID)
+	_, _, ok = tree.ComputeInstanceByUUID("MIG-" + migTestGPUUUID + "/2/5")
+	assert.False(t, ok)
+
+	ci, _, ok = tree.ComputeInstanceByUUID(migTestDeviceUUID)
+	require.True(t, ok)
+	assert.Equal(t, uint(0), ci.EntityID)
+}
+
+func TestMigTreeResolve(t *testing.T) {
+	tree := migTestTree()
+
+	for name, want := range map[string]GroupEntityPair{
+		migTestDeviceUUID:                {FE_GPU_CI, 0},
+		"MIG-" + migTestGPUUUID + "/2/1": {FE_GPU_CI, 2},
+		"0:2:0":                          {FE_GPU_CI, 1},
+		"0:9":                            {FE_GPU_I, 1},
+		migTestGPUUUID:                   {FE_GPU, 0},
+	} {
+		entity, err := tree.Resolve(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, entity, name)
+	}
+	for _, name := range []string{"MIG-unknown", "GPU-unknown", "0", "0:3", "0:2:7", "1:0", "a:b"} {
+		_, err := tree.Resolve(name)
+		require.Error(t, err, name)
+	}
+
+	indices, err := tree.NvmlIndices(GroupEntityPair{FE_GPU_CI, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "0:2:1", indices)
+	indices, err = tree.NvmlIndices(GroupEntityPair{FE_GPU_I, 1})
+	require.NoError(t, err)
+	assert.Equal(t, "0:9", indices)
+	_, err = tree.NvmlIndices(GroupEntityPair{FE_GPU, 0})
+	require.Error(t, err)
+
+	uuid, err := tree.UUID(GroupEntityPair{FE_GPU_CI, 0})
+	require.NoError(t, err)
+	assert.Equal(t, migTestDeviceUUID, uuid)
+	uuid, err = tree.UUID(GroupEntityPair{FE_GPU_CI, 1})
+	require.NoError(t, err)
+	assert.Equal(t, "MIG-"+migTestGPUUUID+"/2/0", uuid)
+	uuid, err = tree.UUID(GroupEntityPair{FE_GPU, 0})
+	require.NoError(t, err)
+	assert.Equal(t, migTestGPUUUID, uuid)
+	_, err = tree.UUID(GroupEntityPair{FE_GPU_I, 0})
+	require.Error(t, err)
+}
+
+func TestMigGPUInstanceProfileName(t *testing.T) {
+	assert.Equal(t, "7g.80gb", migGPUInstanceProfileName(MigProfileGPUInstanceSlice7, 7, 81559))
+	assert.Equal(t, "1g.5gb", migGPUInstanceProfileName(MigProfileGPUInstanceSlice1, 1, 4864))
+	assert.Equal(t, "1g.10gb+me", migGPUInstanceProfileName(MigProfileGPUInstanceSlice1Rev1, 1, 9856))
+	assert.Equal(t, "2g", migGPUInstanceProfileName(MigProfileGPUInstanceSlice2, 2, 0))
+}
@@ -0,0 +1,164 @@
+package dcgm
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// NvLinkCounters are the cumulative counters of an NVLink of a GPU
+type NvLinkCounters struct {
+	// GPU is the ID of the GPU
+	GPU uint
+	// Link is the index of the link on the GPU
+	Link uint
+	// Timestamp is when DCGM last sampled the counters
+	Timestamp      time.Time
+	TxBytes        int64
+	RxBytes        int64
+	CRCFlitErrors  int64
+	CRCDataErrors  int64
+	ReplayErrors   int64
+	RecoveryErrors int64
+}
+
+// NvLinkRates are the rates of the counters of an NVLink of a GPU between two
+// samples
+type NvLinkRates struct {
+	GPU      uint
+	Link     uint
+	Interval time.Duration
+	// The throughput and error counters per second
+	TxBytes        float64
+	RxBytes        float64
+	CRCFlitErrors  float64
+	CRCDataErrors  float64
+	ReplayErrors   float64
+	RecoveryErrors float64
+}
+
+// nvLinkCounterFields are read for the FE_LINK entities of the GPU links, one
+// field ID covering every link
+var nvLinkCounterFields = []Short{
+	DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_PER_LINK,
+	DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_PER_LINK,
+	DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_PER_LINK_TOTAL,
+	DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_PER_LINK_TOTAL,
+	DCGM_FI_DEV_NVLINK_REPLAY_ERROR_PER_LINK_TOTAL,
+	DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_PER_LINK_TOTAL,
+}
+
+// nvLinkEntity returns the FE_LINK entity of a link of a GPU
+func nvLinkEntity(gpuID, link uint) GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_LINK, EntityId: linkEntityID(link, FE_GPU, gpuID)}
+}
+
+// upNvLinks returns the indices of the up links of a GPU
+func upNvLinks(status []NvLinkStatus, gpuID uint) []uint {
+	var links []uint
+	for _, link := range status {
+		if link.ParentType == FE_GPU && link.ParentId == gpuID && link.State == LS_UP {
+			links = append(links, link.Index)
+		}
+	}
+	slices.Sort(links)
+	return links
+}
+
+// getNvLinkCounters watches and reads the counters of the up links of a GPU
+func getNvLinkCounters(gpuID uint) ([]NvLinkCounters, error) {
+	status, err := getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	links := upNvLinks(status, gpuID)
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	entities := make([]GroupEntityPair, len(links))
+	for i, link := range links {
+		entities[i] = nvLinkEntity(gpuID, link)
+	}
+	values, err := entityStatusValues(entities, nvLinkCounterFields)
+	if err != nil {
+		return nil, err
+	}
+	return newNvLinkCounters(gpuID, links, values, time.Now()), nil
+}
+
+// getNvLinkRates reads the counters of the up links of a GPU twice, window
+// apart, and returns the rates between the two reads
+func getNvLinkRates(gpuID uint, window time.Duration) ([]NvLinkRates, error) {
+	if window <= 0 {
+		return nil, errors.New("NVLink rate window must be positive")
+	}
+
+	previous, err := getNvLinkCounters(gpuID)
+	if err != nil || len(previous) == 0 {
+		return nil, err
+	}
+	time.Sleep(window)
+	current, err := getNvLinkCounters(gpuID)
+	if err != nil {
+		return nil, err
+	}
+	return NvLinkRatesBetween(previous, current), nil
+}
+
+// newNvLinkCounters decodes the counters of the links of a GPU. Links without
+// a DCGM timestamp are stamped with now.
+func newNvLinkCounters(gpuID uint, links []uint, values entityValues, now time.Time) []NvLinkCounters {
+	counters := make([]NvLinkCounters, len(links))
+	for i, link := range links {
+		entity := nvLinkEntity(gpuID, link)
+		counters[i] = NvLinkCounters{
+			GPU:            gpuID,
+			Link:           link,
+			Timestamp:      values.timestamp(entity, nvLinkCounterFields, now),
+			TxBytes:        values.integer(entity, DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_PER_LINK),
+			RxBytes:        values.integer(entity, DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_PER_LINK),
+			CRCFlitErrors:  values.integer(entity, DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_PER_LINK_TOTAL),
+			CRCDataErrors:  values.integer(entity, DCGM_FI_DEV_NVLINK_CRC_DATA_ERROR_PER_LINK_TOTAL),
+			ReplayErrors:   values.integer(entity, DCGM_FI_DEV_NVLINK_REPLAY_ERROR_PER_LINK_TOTAL),
+			RecoveryErrors: values.integer(entity, DCGM_FI_DEV_NVLINK_RECOVERY_ERROR_PER_LINK_TOTAL),
+		}
+	}
+	return counters
+}
+
+// Rates returns the rates of the counters since a previous sample of the same
+// link. A counter lower than in the previous sample is taken as reset.
+func (c NvLinkCounters) Rates(previous NvLinkCounters) (NvLinkRates, error) {
+	interval, err := linkInterval(c, previous)
+	if err != nil {
+		return NvLinkRates{}, err
+	}
+	return NvLinkRates{
+		GPU:            c.GPU,
+		Link:           c.Link,
+		Interval:       interval,
+		TxBytes:        counterRate(c.TxBytes, previous.TxBytes, interval),
+		RxBytes:        counterRate(c.RxBytes, previous.RxBytes, interval),
+		CRCFlitErrors:  counterRate(c.CRCFlitErrors, previous.CRCFlitErrors, interval),
+		CRCDataErrors:  counterRate(c.CRCDataErrors, previous.CRCDataErrors, interval),
+		ReplayErrors:   counterRate(c.ReplayErrors, previous.ReplayErrors, interval),
+		RecoveryErrors: counterRate(c.RecoveryErrors, previous.RecoveryErrors, interval),
+	}, nil
+}
+
+// link identifies the link of the counters for linkInterval
+func (c NvLinkCounters) link() (kind string, parent, index uint) {
+	return "GPU", c.GPU, c.Link
+}
+
+func (c NvLinkCounters) sampledAt() time.Time {
+	return c.Timestamp
+}
+
+// NvLinkRatesBetween returns the rates of the links sampled in both the
+// previous and the current counters, in the order of the current counters
+func NvLinkRatesBetween(previous, current []NvLinkCounters) []NvLinkRates {
+	return linkRatesBetween(previous, current, NvLinkCounters.Rates)
+}
@@ -0,0 +1,69 @@
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpNvLinks(t *testing.T) {
+	status := []NvLinkStatus{
+		{ParentId: 0, ParentType: FE_GPU, State: LS_UP, Index: 4},
+		{ParentId: 0, ParentType: FE_GPU, State: LS_DOWN, Index: 1},
+		{ParentId: 0, ParentType: FE_GPU, State: LS_UP, Index: 2},
+		{ParentId: 1, ParentType: FE_GPU, State: LS_UP, Index: 0},
+		{ParentId: 0, ParentType: FE_SWITCH, State: LS_UP, Index: 3},
+	}
+	assert.Equal(t, []uint{2, 4}, upNvLinks(status, 0))
+	assert.Empty(t, upNvLinks(status, 2))
+}
+
+func TestNvLinkCounters(t *testing.T) {
+	value := func(link uint, field Short, n int64) TypedValue {
+		return entityTestValue(nvLinkEntity(1, link), field, DCGM_FT_INT64, int64Bytes(n))
+	}
+	now := time.Unix(1800000000, 0)
+
+	counters := newNvLinkCounters(1, []uint{0, 3}, entityValues{
+		value(0, DCGM_FI_DEV_NVLINK_TX_THROUGHPUT_PER_LINK, 8000),
+		value(0, DCGM_FI_DEV_NVLINK_CRC_FLIT_ERROR_PER_LINK_TOTAL, 10),
+		value(3, DCGM_FI_DEV_NVLINK_RX_THROUGHPUT_PER_LINK, 100),
+	}, now)
+	require.Len(t, counters, 2)
+	previous := counters[0]
+	assert.Equal(t, NvLinkCounters{
+		GPU:           1,
+		Link:          0,
+		Timestamp:     time.UnixMicro(1700000000123456),
+		TxBytes:       8000,
+		CRCFlitErrors: 10,
+	}, previous)
+	assert.Equal(t, int64(100), counters[1].RxBytes)
+	assert.Equal(t, uint(3), counters[1].Link)
+
+	current := previous
+	current.Timestamp = previous.Timestamp.Add(4 * time.Second)
+	current.TxBytes = 16000
+	current.CRCFlitErrors = 18
+	current.RecoveryErrors = 2
+
+	rates, err := current.Rates(previous)
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Second, rates.Interval)
+	assert.InDelta(t, 2000, rates.TxBytes, 0)
+	assert.InDelta(t, 2, rates.CRCFlitErrors, 0)
+	assert.InDelta(t, 0.5, rates.RecoveryErrors, 0)
+
+	_, err = previous.Rates(current)
+	require.Error(t, err)
+	other := current
+	other.Link = 3
+	_, err = other.Rates(previous)
+	require.Error(t, err)
+
+	between := NvLinkRatesBetween([]NvLinkCounters{previous}, []NvLinkCounters{other, current})
+	require.Len(t, between, 1)
+	assert.Equal(t, uint(0), between[0].Link)
+}
@@ -0,0 +1,365 @@
+package dcgm
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// NvSwitch is an NVSwitch and its links
+type NvSwitch struct {
+	// Switch is the FE_SWITCH entity ID of the NVSwitch
+	Switch uint
+	// BusID is the PCI bus ID of the NVSwitch, empty when unknown
+	BusID string
+	// Links are the links of the NVSwitch, ordered by index
+	Links []NvSwitchLink
+}
+
+// NvSwitchLink is a link, or port, of an NVSwitch and the device at its other end
+type NvSwitchLink struct {
+	// Switch is the FE_SWITCH entity ID of the NVSwitch
+	Switch uint
+	// Index is the index of the link on the NVSwitch
+	Index uint
+	// State is the state of the link
+	State Link_State
+	// RemoteBusID is the PCI bus ID of the device at the other end of an up
+	// link, empty when unknown
+	RemoteBusID string
+	// RemoteGPU is the GPU at the other end of the link, -1 when the link is
+	// down or not connected to a GPU managed by DCGM
+	RemoteGPU int
+	// RemoteLink is the index of the link on the remote device, -1 when unknown
+	RemoteLink int
+}
+
+// Entity returns the FE_LINK entity of the link
+func (l NvSwitchLink) Entity() GroupEntityPair {
+	return GroupEntityPair{EntityGroupId: FE_LINK, EntityId: linkEntityID(l.Index, FE_SWITCH, l.Switch)}
+}
+
+// NvSwitchLinkCounters are the cumulative counters of an NVSwitch link
+type NvSwitchLinkCounters struct {
+	Switch uint
+	Index  uint
+	// Timestamp is when DCGM last sampled the counters
+	Timestamp      time.Time
+	ThroughputTx   int64
+	ThroughputRx   int64
+	CRCErrors      int64
+	FlitErrors     int64
+	ReplayErrors   int64
+	RecoveryErrors int64
+	FatalErrors    int64
+	NonFatalErrors int64
+}
+
+// NvSwitchLinkRates are the rates of the counters of an NVSwitch link between
+// two samples
+type NvSwitchLinkRates struct {
+	Switch   uint
+	Index    uint
+	Interval time.Duration
+	// The throughput and error counters per second
+	ThroughputTx   float64
+	ThroughputRx   float64
+	CRCErrors      float64
+	FlitErrors     float64
+	ReplayErrors   float64
+	RecoveryErrors float64
+	// FatalErrors and NonFatalErrors are the errors reported during the interval
+	FatalErrors    int64
+	NonFatalErrors int64
+}
+
+var (
+	// nvSwitchLinkFields are read to find the PCI addresses of the NVSwitches
+	// and of the devices at the other end of their links
+	nvSwitchLinkFields = []Short{
+		DCGM_FI_DEV_NVSWITCH_PCIE_DOMAIN,
+		DCGM_FI_DEV_NVSWITCH_PCIE_BUS,
+		DCGM_FI_DEV_NVSWITCH_PCIE_DEVICE,
+		DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN,
+		DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS,
+		DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE,
+		DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_LINK_ID,
+	}
+
+	nvSwitchLinkCounterFields = []Short{
+		DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX,
+		DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_RX,
+		DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_TOTAL,
+		DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERROR_TOTAL,
+		DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERROR_TOTAL,
+		DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERROR_TOTAL,
+		DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS,
+		DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS,
+	}
+)
+
+// getNvSwitches lists the NVSwitches and maps their up links to the GPUs
+func getNvSwitches() ([]NvSwitch, error) {
+	gpus, err := getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	gpuLinks, err := getGPUBusIDs(gpus)
+	if err != nil {
+		return nil, err
+	}
+	return readNvSwitches(gpuPCIAddresses(gpuLinks))
+}
+
+// readNvSwitches lists the NVSwitches and maps their up links to the GPUs of
+// the given PCI addresses
+func readNvSwitches(gpuAddresses map[pciAddress]uint) ([]NvSwitch, error) {
+	switches, err := getEntityGroupEntities(FE_SWITCH)
+	if err != nil || len(switches) == 0 {
+		return nil, err
+	}
+	status, err := getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]GroupEntityPair, 0, len(switches))
+	for _, nvSwitch := range switches {
+		entities = append(entities, GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: nvSwitch})
+	}
+	for _, link := range status {
+		if link.ParentType == FE_SWITCH && link.State == LS_UP {
+			entities = append(entities, GroupEntityPair{EntityGroupId: FE_LINK, EntityId: linkEntityID(link.Index, FE_SWITCH, link.ParentId)})
+		}
+	}
+
+	fieldValues, err := EntitiesGetLatestValues(entities, nvSwitchLinkFields, DCGM_FV_FLAG_LIVE_DATA)
+	if err != nil {
+		return nil, fmt.Errorf("get NVSwitch links: %w", err)
+	}
+	values := make(entityValues, len(fieldValues))
+	for i, value := range fieldValues {
+		values[i] = value.TypedValue()
+	}
+	return newNvSwitches(switches, status, values, gpuAddresses), nil
+}
+
+// newNvSwitches builds the NVSwitches from the link status and the PCI
+// addresses read for the NVSwitches and their up links
+func newNvSwitches(switches []uint, status []NvLinkStatus, values entityValues, gpuAddresses map[pciAddress]uint) []NvSwitch {
+	nvSwitches := make([]NvSwitch, 0, len(switches))
+	for _, switchID := range switches {
+		nvSwitch := NvSwitch{Switch: switchID}
+		entity := GroupEntityPair{EntityGroupId: FE_SWITCH, EntityId: switchID}
+		if address, ok := values.pciAddress(entity, DCGM_FI_DEV_NVSWITCH_PCIE_DOMAIN,
+			DCGM_FI_DEV_NVSWITCH_PCIE_BUS, DCGM_FI_DEV_NVSWITCH_PCIE_DEVICE); ok {
+			nvSwitch.BusID = address.String()
+		}
+
+		for _, linkStatus := range status {
+			if linkStatus.ParentType != FE_SWITCH || linkStatus.ParentId != switchID {
+				continue
+			}
+			link := NvSwitchLink{Switch: switchID, Index: linkStatus.Index, State: linkStatus.State, RemoteGPU: -1, RemoteLink: -1}
+			if link.State == LS_UP {
+				if address, ok := values.pciAddress(link.Entity(), DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN,
+					DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE); ok {
+					link.RemoteBusID = address.String()
+					if gpu, ok := gpuAddresses[address]; ok {
+						link.RemoteGPU = int(gpu)
+					}
+				}
+				if value, ok := values.value(link.Entity(), DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_LINK_ID); ok {
+					if id, ok := value.Int64(); ok {
+						link.RemoteLink = int(id)
+					}
+				}
+			}
+			nvSwitch.Links = append(nvSwitch.Links, link)
+		}
+		slices.SortFunc(nvSwitch.Links, func(a, b NvSwitchLink) int { return cmp.Compare(a.Index, b.Index) })
+		nvSwitches = append(nvSwitches, nvSwitch)
+	}
+	slices.SortFunc(nvSwitches, func(a, b NvSwitch) int { return cmp.Compare(a.Switch, b.Switch) })
+	return nvSwitches
+}
+
+// getNvSwitchLinkCounters watches and reads the counters of the up links of
+// an NVSwitch
+func getNvSwitchLinkCounters(switchID uint) ([]NvSwitchLinkCounters, error) {
+	status, err := getNvLinkLinkStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	var links []NvSwitchLink
+	for _, link := range status {
+		if link.ParentType == FE_SWITCH && link.ParentId == switchID && link.State == LS_UP {
+			links = append(links, NvSwitchLink{Switch: switchID, Index: link.Index, State: link.State})
+		}
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	entities := make([]GroupEntityPair, len(links))
+	for i, link := range links {
+		entities[i] = link.Entity()
+	}
+	values, err := entityStatusValues(entities, nvSwitchLinkCounterFields)
+	if err != nil {
+		return nil, err
+	}
+	return newNvSwitchLinkCounters(links, values, time.Now()), nil
+}
+
+// newNvSwitchLinkCounters decodes the counters of the links. Links without a
+// DCGM timestamp are stamped with now.
+func newNvSwitchLinkCounters(links []NvSwitchLink, values entityValues, now time.Time) []NvSwitchLinkCounters {
+	counters := make([]NvSwitchLinkCounters, len(links))
+	for i, link := range links {
+		entity := link.Entity()
+		counters[i] = NvSwitchLinkCounters{
+			Switch:         link.Switch,
+			Index:          link.Index,
+			Timestamp:      values.timestamp(entity, nvSwitchLinkCounterFields, now),
+			ThroughputTx:   values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX),
+			ThroughputRx:   values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_RX),
+			CRCErrors:      values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_TOTAL),
+			FlitErrors:     values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_FLIT_ERROR_TOTAL),
+			ReplayErrors:   values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_REPLAY_ERROR_TOTAL),
+			RecoveryErrors: values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_RECOVERY_ERROR_TOTAL),
+			FatalErrors:    values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS),
+			NonFatalErrors: values.integer(entity, DCGM_FI_DEV_NVSWITCH_LINK_NON_FATAL_ERRORS),
+		}
+	}
+	return counters
+}
+
+// Rates returns the rates of the counters since a previous sample of the same
+// link. A counter lower than in the previous sample is taken as reset.
+func (c NvSwitchLinkCounters) Rates(previous NvSwitchLinkCounters) (NvSwitchLinkRates, error) {
+	interval, err := linkInterval(c, previous)
+	if err != nil {
+		return NvSwitchLinkRates{}, err
+	}
+	return NvSwitchLinkRates{
+		Switch:         c.Switch,
+		Index:          c.Index,
+		Interval:       interval,
+		ThroughputTx:   counterRate(c.ThroughputTx, previous.ThroughputTx, interval),
+		ThroughputRx:   counterRate(c.ThroughputRx, previous.ThroughputRx, interval),
+		CRCErrors:      counterRate(c.CRCErrors, previous.CRCErrors, interval),
+		FlitErrors:     counterRate(c.FlitErrors, previous.FlitErrors, interval),
+		ReplayErrors:   counterRate(c.ReplayErrors, previous.ReplayErrors, interval),
+		RecoveryErrors: counterRate(c.RecoveryErrors, previous.RecoveryErrors, interval),
+		FatalErrors:    counterDelta(c.FatalErrors, previous.FatalErrors),
+		NonFatalErrors: counterDelta(c.NonFatalErrors, previous.NonFatalErrors),
+	}, nil
+}
+
+// link identifies the link of the counters for linkInterval
+func (c NvSwitchLinkCounters) link() (kind string, parent, index uint) {
+	return "NVSwitch", c.Switch, c.Index
+}
+
+func (c NvSwitchLinkCounters) sampledAt() time.Time {
+	return c.Timestamp
+}
+
+// NvSwitchLinkRatesBetween returns the rates of the links sampled in both the
+// previous and the current counters, in the order of the current counters
+func NvSwitchLinkRatesBetween(previous, current []NvSwitchLinkCounters) []NvSwitchLinkRates {
+	return linkRatesBetween(previous, current, NvSwitchLinkCounters.Rates)
+}
+
+// linkCounters are the cumulative counters of a GPU or NVSwitch link
+type linkCounters interface {
+	// link returns the type and ID of the parent of the link and its index
+	link() (kind string, parent, index uint)
+	sampledAt() time.Time
+}
+
+// linkInterval returns the time between two samples of the counters of the
+// same link
+func linkInterval(current, previous linkCounters) (time.Duration, error) {
+	kind, parent, index := current.link()
+	_, previousParent, previousIndex := previous.link()
+	if parent != previousParent || index != previousIndex {
+		return 0, fmt.Errorf("counters of %s %d link %d and %s %d link %d are not comparable",
+			kind, parent, index, kind, previousParent, previousIndex)
+	}
+	interval := current.sampledAt().Sub(previous.sampledAt())
+	if interval <= 0 {
+		return 0, errors.New("counters must be sampled after the previous counters")
+	}
+	return interval, nil
+}
+
+// linkRatesBetween returns the rates of the links sampled in both the previous
+// and the current counters, in the order of the current counters. Links whose
+// rates cannot be computed are left out.
+func linkRatesBetween[C linkCounters, R any](previous, current []C, rates func(C, C) (R, error)) []R {
+	var result []R
+	for _, counters := range current {
+		_, parent, index := counters.link()
+		i := slices.IndexFunc(previous, func(p C) bool {
+			_, previousParent, previousIndex := p.link()
+			return previousParent == parent && previousIndex == index
+		})
+		if i < 0 {
+			continue
+		}
+		if r, err := rates(counters, previous[i]); err == nil {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// counterRate returns the increase per second of a cumulative counter over an
+// interval
+func counterRate(current, previous int64, interval time.Duration) float64 {
+	return float64(counterDelta(current, previous)) / interval.Seconds()
+}
+
+// counterDelta returns the increase of a cumulative counter, taking a lower
+// current value as a reset of the counter
+func counterDelta(current, previous int64) int64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// pciAddress reads a PCI address from domain, bus and device fields
+func (v entityValues) pciAddress(entity GroupEntityPair, domain, bus, device Short) (pciAddress, bool) {
+	var parts [3]int64
+	for i, field := range []Short{domain, bus, device} {
+		value, _ := v.value(entity, field)
+		n, ok := value.Int64()
+		if !ok || n < 0 {
+			return pciAddress{}, false
+		}
+		parts[i] = n
+	}
+	return pciAddress{domain: uint64(parts[0]), bus: uint64(parts[1]), device: uint64(parts[2])}, true
+}
+
+// timestamp returns the latest sample time of the fields of the entity, or
+// fallback when none has data
+func (v entityValues) timestamp(entity GroupEntityPair, fields []Short, fallback time.Time) time.Time {
+	var latest time.Time
+	for _, field := range fields {
+		if value, ok := v.value(entity, field); ok && value.IsValid() {
+			latest = laterTime(latest, value.Timestamp)
+		}
+	}
+	if latest.IsZero() {
+		return fallback
+	}
+	return latest
+}
@@ -0,0 +1,100 @@
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNvSwitches(t *testing.T) {
+	status := []NvLinkStatus{
+		{ParentId: 0, ParentType: FE_GPU, State: LS_UP, Index: 0},
+		{ParentId: 1, ParentType: FE_SWITCH, State: LS_UP, Index: 3},
+		{ParentId: 1, ParentType: FE_SWITCH, State: LS_DOWN, Index: 1},
+		{ParentId: 1, ParentType: FE_SWITCH, State: LS_UP, Index: 2},
+	}
+	nvSwitch := GroupEntityPair{FE_SWITCH, 1}
+	up := NvSwitchLink{Switch: 1, Index: 3}.Entity()
+	other := NvSwitchLink{Switch: 1, Index: 2}.Entity()
+	number := func(entity GroupEntityPair, field Short, n int64) TypedValue {
+		return entityTestValue(entity, field, DCGM_FT_INT64, int64Bytes(n))
+	}
+	values := entityValues{
+		number(nvSwitch, DCGM_FI_DEV_NVSWITCH_PCIE_DOMAIN, 0),
+		number(nvSwitch, DCGM_FI_DEV_NVSWITCH_PCIE_BUS, 0xa1),
+		number(nvSwitch, DCGM_FI_DEV_NVSWITCH_PCIE_DEVICE, 0),
+		number(up, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN, 0),
+		number(up, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS, 0x3b),
+		number(up, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE, 0),
+		number(up, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_LINK_ID, 7),
+		number(other, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DOMAIN, 0),
+		number(other, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_BUS, 0x5e),
+		number(other, DCGM_FI_DEV_NVSWITCH_LINK_REMOTE_PCIE_DEVICE, 0),
+	}
+	gpus := map[pciAddress]uint{{domain: 0, bus: 0x3b, device: 0}: 4}
+
+	switches := newNvSwitches([]uint{1, 0}, status, values, gpus)
+	require.Len(t, switches, 2)
+	assert.Equal(t, NvSwitch{Switch: 0}, switches[0])
+
+	assert.Equal(t, "00000000:A1:00.0", switches[1].BusID)
+	assert.Equal(t, []NvSwitchLink{
+		{Switch: 1, Index: 1, State: LS_DOWN, RemoteGPU: -1, RemoteLink: -1},
+		{Switch: 1, Index: 2, State: LS_UP, RemoteBusID: "00000000:5E:00.0", RemoteGPU: -1, RemoteLink: -1},
+		{Switch: 1, Index: 3, State: LS_UP, RemoteBusID: "00000000:3B:00.0", RemoteGPU: 4, RemoteLink: 7},
+	}, switches[1].Links)
+}
+
+func TestNvSwitchLinkCounters(t *testing.T) {
+	link := NvSwitchLink{Switch: 2, Index: 5}
+	value := func(field Short, n int64) TypedValue {
+		return entityTestValue(link.Entity(), field, DCGM_FT_INT64, int64Bytes(n))
+	}
+	now := time.Unix(1800000000, 0)
+
+	counters := newNvSwitchLinkCounters([]NvSwitchLink{link}, entityValues{
+		value(DCGM_FI_DEV_NVSWITCH_LINK_THROUGHPUT_TX, 5000),
+		value(DCGM_FI_DEV_NVSWITCH_LINK_CRC_ERROR_TOTAL, 12),
+		value(DCGM_FI_DEV_NVSWITCH_LINK_FATAL_ERRORS, 1),
+	}, now)
+	require.Len(t, counters, 1)
+	previous := counters[0]
+	assert.Equal(t, int64(5000), previous.ThroughputTx)
+	assert.Equal(t, int64(12), previous.CRCErrors)
+	assert.Equal(t, time.UnixMicro(1700000000123456), previous.Timestamp, "the DCGM timestamp is preferred")
+
+	current := previous
+	current.Timestamp = previous.Timestamp.Add(2 * time.Second)
+	current.ThroughputTx = 9000
+	current.CRCErrors = 20
+	current.ReplayErrors = 3
+	current.FatalErrors = 3
+
+	rates, err := current.Rates(previous)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Second, rates.Interval)
+	assert.InDelta(t, 2000, rates.ThroughputTx, 0)
+	assert.InDelta(t, 4, rates.CRCErrors, 0)
+	assert.InDelta(t, 1.5, rates.ReplayErrors, 0)
+	assert.Equal(t, int64(2), rates.FatalErrors)
+
+	reset := current
+	reset.Timestamp = current.Timestamp.Add(time.Second)
+	reset.CRCErrors = 1
+	rates, err = reset.Rates(current)
+	require.NoError(t, err)
+	assert.InDelta(t, 1, rates.CRCErrors, 0, "a lower counter is a reset")
+
+	_, err = previous.Rates(current)
+	require.Error(t, err)
+	other := current
+	other.Index = 6
+	_, err = other.Rates(previous)
+	require.Error(t, err)
+
+	between := NvSwitchLinkRatesBetween([]NvSwitchLinkCounters{previous}, []NvSwitchLinkCounters{other, current})
+	require.Len(t, between, 1)
+	assert.Equal(t, uint(5), between[0].Index)
+}
@@ -0,0 +1,245 @@
+// Package otelbridge publishes DCGM field values as OpenTelemetry
+// asynchronous instruments.
+//
+// Each field is registered as an observable gauge or counter, depending on
+// its kind, and observed on every collection of the meter's reader:
+//
+//	res, err := otelbridge.Resource(otelbridge.DCGM())
+//	...
+//	provider := sdkmetric.NewMeterProvider(sdkmetric.WithResource(res), sdkmetric.WithReader(reader))
+//	bridge, err := otelbridge.New(otelbridge.DCGM(), provider.Meter("dcgm"), otelbridge.Config{
+//		Fields: []string{"DCGM_FI_DEV_GPU_TEMP", "DCGM_FI_DEV_POWER_USAGE"},
+//	})
+//	...
+//	defer bridge.Close()
+package otelbridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm/internal/fieldwatch"
+)
+
+// Resource attribute keys set by Resource
+const (
+	// DriverVersionKey is the NVIDIA driver version
+	DriverVersionKey = attribute.Key("dcgm.driver.version")
+	// DCGMVersionKey is the version of the DCGM library
+	DCGMVersionKey = attribute.Key("dcgm.version")
+)
+
+// Entity attribute keys set on every observation. Keys that do not apply to
+// an entity are omitted.
+const (
+	GPUKey             = attribute.Key("gpu")
+	UUIDKey            = attribute.Key("uuid")
+	GPUInstanceKey     = attribute.Key("gpu_instance")
+	ComputeInstanceKey = attribute.Key("compute_instance")
+	LinkKey            = attribute.Key("link")
+	CPUKey             = attribute.Key("cpu")
+	CPUCoreKey         = attribute.Key("cpu_core")
+)
+
+// Backend is the subset of the dcgm package used by the Bridge.
+// DCGM returns the implementation backed by libdcgm; tests supply a fake.
+type Backend = fieldwatch.Backend
+
+// DCGM returns the Backend that calls the dcgm package directly.
+// dcgm.Init must have been called before the backend is used.
+func DCGM() Backend {
+	return fieldwatch.DCGM()
+}
+
+// Config configures a Bridge
+type Config struct {
+	// Fields lists the fields to publish, by numeric ID ("150") or by name ("DCGM_FI_DEV_GPU_TEMP")
+	Fields []string
+	// EntityGroups selects the entities to watch. Supported groups are FE_GPU, FE_GPU_I,
+	// FE_GPU_CI, FE_LINK, FE_CPU and FE_CPU_CORE. Defaults to FE_GPU.
+	EntityGroups []dcgm.Field_Entity_Group
+	// UpdateInterval is how often DCGM samples the fields. Defaults to 30 seconds.
+	UpdateInterval time.Duration
+}
+
+// Bridge observes watched DCGM fields into OpenTelemetry instruments
+type Bridge struct {
+	watch        *fieldwatch.Watch
+	instruments  map[dcgm.Short]metric.Float64Observable
+	attributes   map[dcgm.GroupEntityPair]metric.MeasurementOption
+	registration metric.Registration
+}
+
+// Resource returns the resource describing the host: its hostname, the NVIDIA
+// driver version of the first GPU and the DCGM version. It is meant to be
+// passed to the MeterProvider, optionally merged with resource.Default().
+func Resource(backend Backend) (*resource.Resource, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("error getting hostname: %w", err)
+	}
+	attrs := []attribute.KeyValue{semconv.HostName(hostname)}
+
+	gpus, err := backend.GetSupportedDevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPUs: %w", err)
+	}
+	if len(gpus) > 0 {
+		device, err := backend.GetDeviceInfo(gpus[0])
+		if err != nil {
+			return nil, fmt.Errorf("error getting info for GPU %d: %w", gpus[0], err)
+		}
+		attrs = append(attrs, DriverVersionKey.String(device.Identifiers.DriverVersion))
+	}
+
+	version, err := backend.GetVersionInfo()
+	if err != nil {
+		return nil, fmt.Errorf("error getting DCGM version: %w", err)
+	}
+	if v := version.Version(); v != "" {
+		attrs = append(attrs, DCGMVersionKey.String(v))
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+// New watches cfg.Fields on the selected entities and registers an
+// asynchronous instrument for each field on meter.
+// The caller must call Close to release the DCGM resources it creates.
+func New(backend Backend, meter metric.Meter, cfg Config) (*Bridge, error) {
+	fields, err := fieldwatch.ParseFields(cfg.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bridge{
+		instruments: make(map[dcgm.Short]metric.Float64Observable, len(fields)),
+		attributes:  make(map[dcgm.GroupEntityPair]metric.MeasurementOption),
+	}
+
+	names := make(map[string]string, len(fields))
+	observables := make([]metric.Observable, 0, len(fields))
+	for _, id := range fields {
+		info, ok := dcgm.GetFieldInfo(id)
+		if !ok {
+			return nil, fmt.Errorf("no metadata for field %d", id)
+		}
+		name := MetricName(info)
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("fields %s and %s both publish %s", other, info.Name, name)
+		}
+		names[name] = info.Name
+
+		inst, err := newInstrument(meter, name, info)
+		if err != nil {
+			return nil, err
+		}
+		b.instruments[id] = inst
+		observables = append(observables, inst)
+	}
+
+	entityGroups := cfg.EntityGroups
+	if len(entityGroups) == 0 {
+		entityGroups = []dcgm.Field_Entity_Group{dcgm.FE_GPU}
+	}
+	entities, err := fieldwatch.Discover(backend, entityGroups)
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		b.attributes[entity.GroupEntityPair] = metric.WithAttributeSet(entityAttributes(entity))
+	}
+
+	b.watch, err = fieldwatch.Start(backend, entities, fields, cfg.UpdateInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	b.registration, err = meter.RegisterCallback(b.observe, observables...)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("error registering callback: %w", err), b.watch.Close())
+	}
+
+	return b, nil
+}
+
+// newInstrument creates the observable instrument of a field. Every field uses
+// a float64 instrument: the type in the field metadata is inferred from the
+// field name, and DCGM may report a field typed as INT64 there as a double.
+func newInstrument(meter metric.Meter, name string, info dcgm.FieldInfo) (metric.Float64Observable, error) {
+	description := metric.WithDescription(info.Description)
+	unit := metric.WithUnit(UnitSymbol(info.Unit))
+
+	var inst metric.Float64Observable
+	var err error
+	switch info.Kind {
+	case dcgm.FieldKindGauge:
+		inst, err = meter.Float64ObservableGauge(name, description, unit)
+	case dcgm.FieldKindCounter:
+		inst, err = meter.Float64ObservableCounter(name, description, unit)
+	default:
+		return nil, fmt.Errorf("field %s is not numeric", info.Name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating instrument %s: %w", name, err)
+	}
+	return inst, nil
+}
+
+func entityAttributes(entity fieldwatch.Entity) attribute.Set {
+	var attrs []attribute.KeyValue
+	for _, attr := range []struct {
+		key   attribute.Key
+		value string
+	}{
+		{GPUKey, entity.GPU},
+		{UUIDKey, entity.UUID},
+		{GPUInstanceKey, entity.GPUInstance},
+		{ComputeInstanceKey, entity.ComputeInstance},
+		{LinkKey, entity.Link},
+		{CPUKey, entity.CPU},
+		{CPUCoreKey, entity.CPUCore},
+	} {
+		if attr.value != "" {
+			attrs = append(attrs, attr.key.String(attr.value))
+		}
+	}
+	return attribute.NewSet(attrs...)
+}
+
+func (b *Bridge) observe(_ context.Context, observer metric.Observer) error {
+	values, err := b.watch.Latest()
+	if err != nil {
+		return fmt.Errorf("error reading field values: %w", err)
+	}
+
+	for _, value := range values {
+		inst, ok := b.instruments[value.FieldID]
+		if !ok {
+			continue
+		}
+		attrs, ok := b.attributes[dcgm.GroupEntityPair{EntityGroupId: value.EntityGroupId, EntityId: value.EntityID}]
+		if !ok {
+			continue
+		}
+
+		if n, ok := value.TypedValue().Number(); ok {
+			observer.ObserveFloat64(inst, n, attrs)
+		}
+	}
+	return nil
+}
+
+// Close unregisters the instruments' callback and releases the watches and groups created by New
+func (b *Bridge) Close() error {
+	return errors.Join(b.registration.Unregister(), b.watch.Close())
+}
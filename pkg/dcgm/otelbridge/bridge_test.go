@@ -0,0 +1,183 @@
+package otelbridge
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.41.0"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm/internal/fieldwatch/fieldwatchtest"
+)
+
+func TestMetricName(t *testing.T) {
+	tests := []struct {
+		field dcgm.Short
+		name  string
+		unit  string
+	}{
+		{dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, "dcgm.gpu.temp", "Cel"},
+		{dcgm.DCGM_FI_DEV_MEMORY_TEMP_CELSIUS, "dcgm.gpu.memory_temp", "Cel"},
+		{dcgm.DCGM_FI_DEV_BOARD_POWER_WATTS, "dcgm.gpu.board_power", "W"},
+		{dcgm.DCGM_FI_DEV_GPU_ENERGY_JOULES_TOTAL, "dcgm.gpu.energy", "J"},
+		{dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, "dcgm.gpu.ecc_dbe_vol", ""},
+		{dcgm.DCGM_FI_PROF_SM_UTIL_RATIO, "dcgm.gpu.prof_sm_util", "1"},
+		{dcgm.DCGM_FI_DEV_CPU_TEMP_CELSIUS, "dcgm.cpu.temp", "Cel"},
+	}
+
+	for _, tt := range tests {
+		info, ok := dcgm.GetFieldInfo(tt.field)
+		require.True(t, ok, "field %d", tt.field)
+		assert.Equal(t, tt.name, MetricName(info), info.Name)
+		assert.Equal(t, tt.unit, UnitSymbol(info.Unit), info.Name)
+	}
+}
+
+func TestBridgeObservesFields(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.MIG.Count = 1
+	backend.MIG.EntityList[0] = dcgm.MigHierarchyInfo_v2{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 4},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 1},
+		Info:   dcgm.MigEntityInfo{GpuUuid: "GPU-bbbb", NvmlInstanceId: 2},
+	}
+	backend.Values = []dcgm.FieldValue_v2{
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 0, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, 40),
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 0, dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, 3),
+		fieldwatchtest.Float64Value(dcgm.FE_GPU_I, 4, dcgm.DCGM_FI_PROF_SM_UTIL_RATIO, 0.25),
+		// Blank values are not observed
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 1, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, dcgm.DCGM_FT_INT64_BLANK),
+	}
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	bridge, err := New(backend, provider.Meter("test"), Config{
+		Fields:       []string{"DCGM_FI_DEV_GPU_TEMP", "DCGM_FI_DEV_ECC_DBE_VOL_TOTAL", "DCGM_FI_PROF_SM_UTIL_RATIO"},
+		EntityGroups: []dcgm.Field_Entity_Group{dcgm.FE_GPU, dcgm.FE_GPU_I},
+	})
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	metrics := make(map[string]metricdata.Metrics)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		metrics[m.Name] = m
+	}
+	require.Len(t, metrics, 3)
+
+	gpu0 := attribute.NewSet(GPUKey.String("0"), UUIDKey.String("GPU-aaaa"))
+
+	temp := metrics["dcgm.gpu.temp"]
+	assert.Equal(t, "Cel", temp.Unit)
+	gauge, ok := temp.Data.(metricdata.Gauge[float64])
+	require.True(t, ok, "%T", temp.Data)
+	require.Len(t, gauge.DataPoints, 1)
+	assert.InDelta(t, 40, gauge.DataPoints[0].Value, 0)
+	assert.Equal(t, gpu0, gauge.DataPoints[0].Attributes)
+
+	ecc, ok := metrics["dcgm.gpu.ecc_dbe_vol"].Data.(metricdata.Sum[float64])
+	require.True(t, ok, "%T", metrics["dcgm.gpu.ecc_dbe_vol"].Data)
+	assert.True(t, ecc.IsMonotonic)
+	require.Len(t, ecc.DataPoints, 1)
+	assert.InDelta(t, 3, ecc.DataPoints[0].Value, 0)
+
+	util, ok := metrics["dcgm.gpu.prof_sm_util"].Data.(metricdata.Gauge[float64])
+	require.True(t, ok, "%T", metrics["dcgm.gpu.prof_sm_util"].Data)
+	require.Len(t, util.DataPoints, 1)
+	assert.InDelta(t, 0.25, util.DataPoints[0].Value, 1e-9)
+	assert.Equal(t, attribute.NewSet(GPUKey.String("1"), UUIDKey.String("GPU-bbbb"), GPUInstanceKey.String("2")),
+		util.DataPoints[0].Attributes)
+
+	require.NoError(t, bridge.Close())
+	assert.Equal(t, 1, backend.Unwatched)
+	assert.Equal(t, 1, backend.GroupsDestroyed)
+
+	rm = metricdata.ResourceMetrics{}
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	assert.Empty(t, rm.ScopeMetrics)
+}
+
+func TestBridgeObservesDoublesOfIntegerFields(t *testing.T) {
+	info, ok := dcgm.GetFieldInfo(dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS)
+	require.True(t, ok)
+	require.Equal(t, dcgm.DCGM_FT_INT64, info.Type)
+
+	backend := fieldwatchtest.NewBackend()
+	backend.Values = []dcgm.FieldValue_v2{
+		fieldwatchtest.Float64Value(dcgm.FE_GPU, 0, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, 41.5),
+	}
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	bridge, err := New(backend, provider.Meter("test"), Config{Fields: []string{"DCGM_FI_DEV_GPU_TEMP"}})
+	require.NoError(t, err)
+	defer bridge.Close()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	gauge, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64])
+	require.True(t, ok, "%T", rm.ScopeMetrics[0].Metrics[0].Data)
+	require.Len(t, gauge.DataPoints, 1)
+	assert.InDelta(t, 41.5, gauge.DataPoints[0].Value, 0)
+}
+
+func TestBridgeReportsReadErrors(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.ValuesErr = errors.New("host engine unavailable")
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	bridge, err := New(backend, provider.Meter("test"), Config{Fields: []string{"DCGM_FI_DEV_GPU_TEMP"}})
+	require.NoError(t, err)
+	defer bridge.Close()
+
+	var rm metricdata.ResourceMetrics
+	err = reader.Collect(context.Background(), &rm)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "host engine unavailable")
+}
+
+func TestNewRejectsCollidingNames(t *testing.T) {
+	provider := sdkmetric.NewMeterProvider()
+	_, err := New(fieldwatchtest.NewBackend(), provider.Meter("test"), Config{
+		Fields: []string{"DCGM_FI_DEV_SM_CLOCK", "DCGM_FI_DEV_SM_CLOCK_HERTZ"},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dcgm.gpu.sm_clock")
+
+	_, err = New(fieldwatchtest.NewBackend(), provider.Meter("test"), Config{Fields: []string{"DCGM_FI_DEV_UUID"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not numeric")
+}
+
+func TestResource(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.Devices[0] = dcgm.Device{
+		GPU:         0,
+		UUID:        "GPU-aaaa",
+		Identifiers: dcgm.DeviceIdentifiers{DriverVersion: "580.65.06"},
+	}
+	backend.Version = dcgm.VersionInfo{RawBuildInfoString: "version:4.2.3;arch:x86_64"}
+
+	res, err := Resource(backend)
+	require.NoError(t, err)
+
+	driver, ok := res.Set().Value(DriverVersionKey)
+	require.True(t, ok)
+	assert.Equal(t, "580.65.06", driver.AsString())
+	version, ok := res.Set().Value(DCGMVersionKey)
+	require.True(t, ok)
+	assert.Equal(t, "4.2.3", version.AsString())
+	_, ok = res.Set().Value(semconv.HostNameKey)
+	assert.True(t, ok)
+}
@@ -0,0 +1,108 @@
+package otelbridge
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// unitSymbols maps DCGM units to UCUM symbols, as used by OpenTelemetry
+var unitSymbols = map[dcgm.Unit]string{
+	dcgm.UnitNone:               "",
+	dcgm.UnitCelsius:            "Cel",
+	dcgm.UnitWatts:              "W",
+	dcgm.UnitMilliwatts:         "mW",
+	dcgm.UnitJoules:             "J",
+	dcgm.UnitMillijoules:        "mJ",
+	dcgm.UnitHertz:              "Hz",
+	dcgm.UnitMegahertz:          "MHz",
+	dcgm.UnitBytes:              "By",
+	dcgm.UnitKibibytes:          "KiBy",
+	dcgm.UnitMebibytes:          "MiBy",
+	dcgm.UnitBytesPerSecond:     "By/s",
+	dcgm.UnitMebibytesPerSecond: "MiBy/s",
+	dcgm.UnitRatio:              "1",
+	dcgm.UnitPercent:            "%",
+	dcgm.UnitNanoseconds:        "ns",
+	dcgm.UnitMicroseconds:       "us",
+	dcgm.UnitMilliseconds:       "ms",
+	dcgm.UnitSeconds:            "s",
+	dcgm.UnitVolts:              "V",
+	dcgm.UnitMillivolts:         "mV",
+	dcgm.UnitMilliamps:          "mA",
+	dcgm.UnitRPM:                "{rotation}/min",
+	dcgm.UnitCount:              "{count}",
+}
+
+// unitSuffixes are the words DCGM field names end with to spell out their unit
+var unitSuffixes = map[dcgm.Unit][]string{
+	dcgm.UnitCelsius:      {"celsius"},
+	dcgm.UnitWatts:        {"watts"},
+	dcgm.UnitMilliwatts:   {"milliwatts", "mw"},
+	dcgm.UnitJoules:       {"joules"},
+	dcgm.UnitMillijoules:  {"millijoules", "mj"},
+	dcgm.UnitHertz:        {"hertz", "hz"},
+	dcgm.UnitMegahertz:    {"megahertz", "mhz"},
+	dcgm.UnitBytes:        {"bytes"},
+	dcgm.UnitKibibytes:    {"kib"},
+	dcgm.UnitMebibytes:    {"mib", "mb"},
+	dcgm.UnitRatio:        {"ratio"},
+	dcgm.UnitPercent:      {"percent"},
+	dcgm.UnitNanoseconds:  {"ns"},
+	dcgm.UnitMicroseconds: {"us"},
+	dcgm.UnitMilliseconds: {"ms"},
+	dcgm.UnitSeconds:      {"seconds", "sec"},
+	dcgm.UnitVolts:        {"volts"},
+	dcgm.UnitMillivolts:   {"millivolts", "mvolt", "mv"},
+	dcgm.UnitMilliamps:    {"milliamps", "ma"},
+	dcgm.UnitRPM:          {"rpm"},
+}
+
+// namespaces is the metric namespace of each entity level, which is also
+// dropped from the start of the field name
+var namespaces = map[dcgm.Field_Entity_Group][]string{
+	dcgm.FE_NONE:     {"system"},
+	dcgm.FE_GPU:      {"gpu"},
+	dcgm.FE_VGPU:     {"vgpu"},
+	dcgm.FE_SWITCH:   {"nvswitch"},
+	dcgm.FE_GPU_I:    {"gpu", "instance"},
+	dcgm.FE_GPU_CI:   {"compute", "instance"},
+	dcgm.FE_LINK:     {"nvlink"},
+	dcgm.FE_CPU:      {"cpu"},
+	dcgm.FE_CPU_CORE: {"cpu", "core"},
+	dcgm.FE_CONNECTX: {"connectx"},
+}
+
+// UnitSymbol returns the UCUM symbol of a DCGM unit, such as "Cel" for UnitCelsius
+func UnitSymbol(unit dcgm.Unit) string {
+	return unitSymbols[unit]
+}
+
+// MetricName returns the OpenTelemetry metric name of a field, following the
+// semantic conventions: a dot-separated namespace derived from the entity
+// level, no unit in the name and no _total suffix on counters. For example,
+// DCGM_FI_DEV_GPU_TEMP_CELSIUS is named "dcgm.gpu.temp".
+func MetricName(info dcgm.FieldInfo) string {
+	words := strings.Split(strings.ToLower(strings.TrimPrefix(info.Name, "DCGM_FI_")), "_")
+	if len(words) > 1 && words[0] == "dev" {
+		words = words[1:]
+	}
+
+	if info.Kind == dcgm.FieldKindCounter && len(words) > 1 && words[len(words)-1] == "total" {
+		words = words[:len(words)-1]
+	}
+	if len(words) > 1 && slices.Contains(unitSuffixes[info.Unit], words[len(words)-1]) {
+		words = words[:len(words)-1]
+	}
+
+	namespace, ok := namespaces[info.EntityLevel]
+	if !ok {
+		namespace = namespaces[dcgm.FE_NONE]
+	}
+	if len(words) > len(namespace) && slices.Equal(words[:len(namespace)], namespace) {
+		words = words[len(namespace):]
+	}
+
+	return "dcgm." + strings.Join(namespace, "_") + "." + strings.Join(words, "_")
+}
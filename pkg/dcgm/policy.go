@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -117,11 +118,18 @@ type PolicyConfig struct {
 type PolicyViolation struct {
 	// GPU is the ID of the GPU that triggered the violation
 	GPU uint
+	// UUID is the UUID of the GPU, or empty when it could not be read
+	UUID string
+	// Serial is the serial number of the GPU, or empty when it could not be read
+	Serial string
 	// Condition specifies the type of policy that was violated
 	Condition PolicyCondition
 	// Timestamp indicates when the violation occurred
 	Timestamp time.Time
-	// Data contains violation-specific details
+	// Data contains violation-specific details: one of DbePolicyCondition,
+	// PciPolicyCondition, RetiredPagesPolicyCondition, ThermalPolicyCondition,
+	// PowerPolicyCondition, NvlinkPolicyCondition, XidPolicyCondition or
+	// RulePolicyCondition. The typed accessors such as Xid return them.
 	Data any
 }
 
@@ -145,55 +153,65 @@ type policyConditionParam struct {
 // DbePolicyCondition contains details about a Double-bit ECC error
 type DbePolicyCondition struct {
 	// Location specifies where the ECC error occurred
-	Location string
+	Location string `json:"location"`
 	// NumErrors indicates the number of errors detected
-	NumErrors uint
+	NumErrors uint `json:"num_errors"`
 }
 
 // PciPolicyCondition contains details about a PCI error
 type PciPolicyCondition struct {
 	// ReplayCounter indicates the number of PCI replays
-	ReplayCounter uint
+	ReplayCounter uint `json:"replay_counter"`
 }
 
 // RetiredPagesPolicyCondition contains details about retired memory pages
 type RetiredPagesPolicyCondition struct {
 	// SbePages indicates the number of pages retired due to single-bit errors
-	SbePages uint
+	SbePages uint `json:"sbe_pages"`
 	// DbePages indicates the number of pages retired due to double-bit errors
-	DbePages uint
+	DbePages uint `json:"dbe_pages"`
 }
 
 // ThermalPolicyCondition contains details about a thermal violation
 type ThermalPolicyCondition struct {
 	// ThermalViolation indicates the severity of the thermal violation
-	ThermalViolation uint
+	ThermalViolation uint `json:"thermal_violation"`
 }
 
 // PowerPolicyCondition contains details about a power violation
 type PowerPolicyCondition struct {
 	// PowerViolation indicates the severity of the power violation
-	PowerViolation uint
+	PowerViolation uint `json:"power_violation"`
 }
 
 // NvlinkPolicyCondition contains details about an NVLink error
 type NvlinkPolicyCondition struct {
 	// FieldId identifies the specific NVLink field that had an error
-	FieldId uint16
+	FieldId uint16 `json:"field_id"`
 	// Counter indicates the number of errors detected
-	Counter uint
+	Counter uint `json:"counter"`
 }
 
 // XidPolicyCondition contains details about an XID error
 type XidPolicyCondition struct {
 	// ErrNum is the XID error number
-	ErrNum uint
+	ErrNum uint `json:"err_num"`
+	// Info describes the error. It is zero when the XID is not known to LookupXID.
+	Info XIDInfo `json:"info,omitzero"`
 }
 
-var policyCallbacks = newPolicyDispatcher()
+var policyCallbacks = func() *policyDispatcher {
+	d := newPolicyDispatcher()
+	d.identify = gpuIdentities
+	return d
+}()
 
 type translatedPolicyConditions struct {
 	condition C.dcgmPolicyCondition_t
+	// rules are the requested conditions evaluated by StartPolicyRules
+	rules []PolicyCondition
+	// filter drops violations before delivery; nil delivers all
+	filter *PolicyFilter
 }
 
 type policySubscription struct {
@@ -201,7 +219,12 @@ type policySubscription struct {
 	group      GroupHandle
 	groupKey   uintptr
 	conditions C.dcgmPolicyCondition_t
-	ch         chan PolicyViolation
+	rules      []PolicyCondition
+	// filter drops violations before delivery; nil delivers all
+	filter *PolicyFilter
+	// delivered are the counters of the violations that passed the filter
+	delivered map[policyCounterKey]uint
+	ch        chan PolicyViolation
 }
 
 type policyRegistration struct {
@@ -225,6 +248,16 @@ type policyDispatcher struct {
 	registrations     map[uint64]policyRegistration
 	registeredByGroup map[uintptr]C.dcgmPolicyCondition_t
 
+	// rules are the conditions of the running StartPolicyRules evaluations
+	rules map[PolicyCondition]struct{}
+	// identities enrich the violations of the GPUs of subscribed groups
+	identities map[uint]gpuIdentity
+	// identify reads the identities of GPUs missing from identities, e.g.
+	// added to a group after it was subscribed; nil leaves them unenriched.
+	// It calls DCGM, so it runs in its own goroutine rather than on the DCGM
+	// callback thread.
+	identify func([]GroupEntityPair) map[uint]gpuIdentity
+
 	drops atomic.Uint64
 }
 
@@ -234,6 +267,8 @@ func newPolicyDispatcher() *policyDispatcher {
 		subscriptions:     make(map[uint64]*policySubscription),
 		registrations:     make(map[uint64]policyRegistration),
 		registeredByGroup: make(map[uintptr]C.dcgmPolicyCondition_t),
+		rules:             make(map[PolicyCondition]struct{}),
+		identities:        make(map[uint]gpuIdentity),
 	}
 }
 
@@ -247,10 +282,23 @@ func (d *policyDispatcher) nextLocked() uint64 {
 }
 
 // addSubscription records a listener and returns any missing DCGM registration.
+// Rule conditions are delivered by deliverGroup and need no registration.
 func (d *policyDispatcher) addSubscription(
 	group GroupHandle,
 	conditions C.dcgmPolicyCondition_t,
 	buffer int,
+	rules ...PolicyCondition,
+) (subID uint64, ch chan PolicyViolation, registration *policyRegistration) {
+	return d.addFilteredSubscription(group, conditions, buffer, nil, rules...)
+}
+
+// addFilteredSubscription records a listener whose violations pass through filter.
+func (d *policyDispatcher) addFilteredSubscription(
+	group GroupHandle,
+	conditions C.dcgmPolicyCondition_t,
+	buffer int,
+	filter *PolicyFilter,
+	rules ...PolicyCondition,
 ) (subID uint64, ch chan PolicyViolation, registration *policyRegistration) {
 	if buffer < 1 {
 		buffer = 1
@@ -268,6 +316,9 @@ func (d *policyDispatcher) addSubscription(
 		group:      group,
 		groupKey:   groupKey,
 		conditions: conditions,
+		rules:      rules,
+		filter:     filter,
+		delivered:  make(map[policyCounterKey]uint),
 		ch:         ch,
 	}
 
@@ -436,11 +487,45 @@ func (d *policyDispatcher) deliver(registrationID uint64, violation PolicyViolat
 	if !exists {
 		return
 	}
+	d.identifyLocked(violation.GPU)
+	d.enrichLocked(&violation)
 
 	for _, subscription := range d.subscriptions {
 		if subscription.groupKey != registration.groupKey || subscription.conditions&condition == 0 {
 			continue
 		}
+		if subscription.filter != nil && !subscription.filter.allows(violation, subscription.delivered) {
+			continue
+		}
+
+		select {
+		case subscription.ch <- violation:
+		default:
+			d.drops.Add(1)
+		}
+	}
+}
+
+// deliverGroup fans out a rule or replayed violation to the group's subscribers of its condition without blocking.
+func (d *policyDispatcher) deliverGroup(group GroupHandle, violation PolicyViolation) {
+	groupKey := group.GetHandle()
+	condition, _ := policyConditionMask(violation.Condition)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.identifyLocked(violation.GPU)
+	d.enrichLocked(&violation)
+	for _, subscription := range d.subscriptions {
+		if subscription.groupKey != groupKey {
+			continue
+		}
+		if subscription.conditions&condition == 0 && !slices.Contains(subscription.rules, violation.Condition) {
+			continue
+		}
+		if subscription.filter != nil && !subscription.filter.allows(violation, subscription.delivered) {
+			continue
+		}
 
 		select {
 		case subscription.ch <- violation:
@@ -450,6 +535,81 @@ func (d *policyDispatcher) deliver(registrationID uint64, violation PolicyViolat
 	}
 }
 
+// setIdentities records the UUID and serial number of GPUs for enrichLocked.
+func (d *policyDispatcher) setIdentities(identities map[uint]gpuIdentity) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for gpu, identity := range identities {
+		d.identities[gpu] = identity
+	}
+}
+
+// identifyLocked starts reading the identity of a GPU that is not known yet,
+// so that its later violations are enriched; d.mu must be held. The GPU is
+// recorded with an empty identity until the read completes, and keeps it if
+// the read fails, so that a lost GPU is not read again for every violation.
+func (d *policyDispatcher) identifyLocked(gpu uint) {
+	if _, known := d.identities[gpu]; known || d.identify == nil {
+		return
+	}
+	d.identities[gpu] = gpuIdentity{}
+
+	identify := d.identify
+	go func() {
+		d.setIdentities(identify([]GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: gpu}}))
+	}()
+}
+
+// enrichLocked adds the known UUID and serial number of its GPU to a violation; d.mu must be held.
+func (d *policyDispatcher) enrichLocked(violation *PolicyViolation) {
+	identity, ok := d.identities[violation.GPU]
+	if !ok {
+		return
+	}
+	if violation.UUID == "" {
+		violation.UUID = identity.uuid
+	}
+	if violation.Serial == "" {
+		violation.Serial = identity.serial
+	}
+}
+
+// addRules records running rule conditions; a condition may only be evaluated once at a time.
+func (d *policyDispatcher) addRules(conditions []PolicyCondition) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, condition := range conditions {
+		if _, running := d.rules[condition]; running || slices.Contains(conditions[:i], condition) {
+			return fmt.Errorf("policy rule %q is already running", condition)
+		}
+	}
+	for _, condition := range conditions {
+		d.rules[condition] = struct{}{}
+	}
+	return nil
+}
+
+// removeRules forgets rule conditions whose evaluation stopped.
+func (d *policyDispatcher) removeRules(conditions []PolicyCondition) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, condition := range conditions {
+		delete(d.rules, condition)
+	}
+}
+
+// hasRule reports whether a rule condition is being evaluated.
+func (d *policyDispatcher) hasRule(condition PolicyCondition) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, running := d.rules[condition]
+	return running
+}
+
 // dropped returns the process-local count of best-effort delivery drops.
 func (d *policyDispatcher) dropped() uint64 {
 	return d.drops.Load()
@@ -492,7 +652,11 @@ func translateConditions(conditions []PolicyCondition) (translatedPolicyConditio
 	for _, condition := range conditions {
 		mask, ok := policyConditionInfo(condition)
 		if !ok {
-			return translatedPolicyConditions{}, fmt.Errorf("unknown policy condition: %s", condition)
+			if !policyCallbacks.hasRule(condition) {
+				return translatedPolicyConditions{}, fmt.Errorf("unknown policy condition: %s", condition)
+			}
+			translated.rules = append(translated.rules, condition)
+			continue
 		}
 		translated.condition |= mask
 	}
@@ -719,8 +883,11 @@ func ViolationRegistration(data unsafe.Pointer, userData C.uint64_t) C.int {
 		xid := (*C.dcgmPolicyConditionXID_t)(unsafe.Pointer(&response.val))
 		con = XidPolicy
 		timestamp = createTimeStamp(xid.timestamp)
+		errNum := *uintPtr(xid.errnum)
+		info, _ := LookupXID(errNum)
 		val = XidPolicyCondition{
-			ErrNum: *uintPtr(xid.errnum),
+			ErrNum: errNum,
+			Info:   info,
 		}
 	}
 
@@ -874,6 +1041,15 @@ func getPolicyForGroup(groupID GroupHandle) (*PolicyStatus, error) {
 	return status, nil
 }
 
+// policyTrigger runs one iteration of policy evaluation
+func policyTrigger() error {
+	result := C.dcgmPolicyTrigger(handle.handle)
+	if err := errorString(result); err != nil {
+		return &Error{msg: fmt.Sprintf("error triggering policy evaluation: %s", err), Code: result}
+	}
+	return nil
+}
+
 func clearPolicyForGroup(groupID GroupHandle) error {
 	// Clear all policies by setting condition to 0 (no conditions enabled)
 	var policy C.dcgmPolicy_t
@@ -1007,8 +1183,8 @@ func setPolicyForGroupWithConfig(groupID GroupHandle, configs ...PolicyConfig) e
 	return setPolicyInternal(groupID, condition, internalConfigs, action, validation)
 }
 
-// registerPolicy configures requested policy conditions before subscribing.
-func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...PolicyCondition) (<-chan PolicyViolation, error) {
+// registerPolicy configures requested policy conditions before subscribing; a nil filter delivers all violations.
+func registerPolicy(ctx context.Context, groupID GroupHandle, filter *PolicyFilter, typ ...PolicyCondition) (<-chan PolicyViolation, error) {
 	if ctx == nil {
 		return nil, errors.New("context must not be nil")
 	}
@@ -1017,14 +1193,28 @@ func registerPolicy(ctx context.Context, groupID GroupHandle, typ ...PolicyCondi
 	if err != nil {
 		return nil, err
 	}
+	if filter != nil {
+		if err := filter.validate(); err != nil {
+			return nil, err
+		}
+		translated.filter = filter.clone()
+	}
 
-	return subscribePolicy(ctx, groupID, translated.condition, len(typ), func() error {
-		return ensurePolicyForListen(groupID, typ)
+	hardware := slices.DeleteFunc(slices.Clone(typ), func(condition PolicyCondition) bool {
+		return slices.Contains(translated.rules, condition)
 	})
+	var setup func() error
+	if len(hardware) > 0 {
+		setup = func() error {
+			return ensurePolicyForListen(groupID, hardware)
+		}
+	}
+
+	return subscribePolicy(ctx, groupID, translated, len(typ), setup)
 }
 
-// registerPolicyOnly subscribes to existing policy conditions without changing thresholds.
-func registerPolicyOnly(ctx context.Context, groupID GroupHandle, typ ...PolicyCondition) (<-chan PolicyViolation, error) {
+// registerPolicyOnly subscribes to existing policy conditions without changing thresholds; a nil filter delivers all violations.
+func registerPolicyOnly(ctx context.Context, groupID GroupHandle, filter *PolicyFilter, typ ...PolicyCondition) (<-chan PolicyViolation, error) {
 	if ctx == nil {
 		return nil, errors.New("context must not be nil")
 	}
@@ -1033,15 +1223,21 @@ func registerPolicyOnly(ctx context.Context, groupID GroupHandle, typ ...PolicyC
 	if err != nil {
 		return nil, err
 	}
+	if filter != nil {
+		if err := filter.validate(); err != nil {
+			return nil, err
+		}
+		translated.filter = filter.clone()
+	}
 
-	return subscribePolicy(ctx, groupID, translated.condition, len(typ), nil)
+	return subscribePolicy(ctx, groupID, translated, len(typ), nil)
 }
 
 // subscribePolicy serializes local subscription setup and DCGM registration.
 func subscribePolicy(
 	ctx context.Context,
 	groupID GroupHandle,
-	condition C.dcgmPolicyCondition_t,
+	translated translatedPolicyConditions,
 	buffer int,
 	setup func() error,
 ) (<-chan PolicyViolation, error) {
@@ -1058,8 +1254,16 @@ func subscribePolicy(
 		}
 	}
 
-	subID, violation, registration := policyCallbacks.addSubscription(groupID, condition, buffer)
+	subID, violation, registration := policyCallbacks.addFilteredSubscription(
+		groupID, translated.condition, buffer, translated.filter, translated.rules...,
+	)
 	if registration != nil {
+		// Violations are delivered from the DCGM callback thread, so the GPU
+		// identities are read up front rather than per violation. GPUs added
+		// to the group later are read in the background after their first
+		// violation.
+		policyCallbacks.setIdentities(groupGPUIdentities(groupID))
+
 		result := C.dcgmPolicyRegister_v2(
 			handle.handle,
 			groupID.handle,
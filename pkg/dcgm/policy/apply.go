@@ -0,0 +1,321 @@
+package policy
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Backend is the subset of the dcgm package used to diff and apply documents.
+// DCGM returns the implementation backed by libdcgm; tests supply a fake.
+type Backend interface {
+	GetSupportedDevices() ([]uint, error)
+	GetDeviceInfo(gpuID uint) (dcgm.Device, error)
+	CreateGroup(name string) (dcgm.GroupHandle, error)
+	AddEntityToGroup(group dcgm.GroupHandle, entityGroup dcgm.Field_Entity_Group, entityID uint) error
+	DestroyGroup(group dcgm.GroupHandle) error
+	GetPolicyForGroup(group dcgm.GroupHandle) (*dcgm.PolicyStatus, error)
+	SetPolicyForGroup(group dcgm.GroupHandle, configs ...dcgm.PolicyConfig) error
+}
+
+type dcgmBackend struct{}
+
+// DCGM returns the Backend that calls the dcgm package directly.
+// dcgm.Init must have been called before the backend is used.
+func DCGM() Backend {
+	return dcgmBackend{}
+}
+
+func (dcgmBackend) GetSupportedDevices() ([]uint, error) { return dcgm.GetSupportedDevices() }
+
+func (dcgmBackend) GetDeviceInfo(gpuID uint) (dcgm.Device, error) { return dcgm.GetDeviceInfo(gpuID) }
+
+func (dcgmBackend) CreateGroup(name string) (dcgm.GroupHandle, error) { return dcgm.CreateGroup(name) }
+
+func (dcgmBackend) AddEntityToGroup(group dcgm.GroupHandle, entityGroup dcgm.Field_Entity_Group, entityID uint) error {
+	return dcgm.AddEntityToGroup(group, entityGroup, entityID)
+}
+
+func (dcgmBackend) DestroyGroup(group dcgm.GroupHandle) error { return dcgm.DestroyGroup(group) }
+
+func (dcgmBackend) GetPolicyForGroup(group dcgm.GroupHandle) (*dcgm.PolicyStatus, error) {
+	return dcgm.GetPolicyForGroup(group)
+}
+
+func (dcgmBackend) SetPolicyForGroup(group dcgm.GroupHandle, configs ...dcgm.PolicyConfig) error {
+	return dcgm.SetPolicyForGroup(group, configs...)
+}
+
+// Change is a GPU whose current policy differs from its policy in the document
+type Change struct {
+	// Policy is the name of the policy targeting the GPU
+	Policy string
+	GPU    uint
+	UUID   string
+	// Current is the policy set on the GPU. Its Conditions are empty when no
+	// policy is set.
+	Current dcgm.PolicyStatus
+	// Desired is the policy of the document
+	Desired dcgm.PolicyStatus
+}
+
+// String describes the differences between the current and desired policy,
+// e.g. `policy "training" on GPU 0: action none -> gpu_reset, +xid, max_temperature 100 -> 90`
+func (c Change) String() string {
+	var diffs []string
+	if c.Current.Action != c.Desired.Action {
+		diffs = append(diffs, fmt.Sprintf("action %s -> %s", actionName(c.Current.Action), actionName(c.Desired.Action)))
+	}
+	if c.Current.Validation != c.Desired.Validation {
+		diffs = append(diffs, fmt.Sprintf("validation %s -> %s",
+			validationName(c.Current.Validation), validationName(c.Desired.Validation)))
+	}
+	for _, condition := range conditionOrder {
+		current, hasCurrent := c.Current.Conditions[condition.condition]
+		desired, hasDesired := c.Desired.Conditions[condition.condition]
+		switch {
+		case hasCurrent && !hasDesired:
+			diffs = append(diffs, "-"+condition.name)
+		case !hasCurrent && hasDesired && condition.threshold:
+			diffs = append(diffs, fmt.Sprintf("+%s %v", condition.name, desired))
+		case !hasCurrent && hasDesired:
+			diffs = append(diffs, "+"+condition.name)
+		case hasCurrent && current != desired:
+			diffs = append(diffs, fmt.Sprintf("%s %v -> %v", condition.name, current, desired))
+		}
+	}
+	return fmt.Sprintf("policy %q on GPU %d: %s", c.Policy, c.GPU, strings.Join(diffs, ", "))
+}
+
+// conditionOrder lists the conditions by their names in documents
+var conditionOrder = []struct {
+	condition dcgm.PolicyCondition
+	name      string
+	threshold bool
+}{
+	{dcgm.DbePolicy, "dbe", false},
+	{dcgm.PCIePolicy, "pcie", false},
+	{dcgm.MaxRtPgPolicy, "max_retired_pages", true},
+	{dcgm.ThermalPolicy, "max_temperature", true},
+	{dcgm.PowerPolicy, "max_power", true},
+	{dcgm.NvlinkPolicy, "nvlink", false},
+	{dcgm.XidPolicy, "xid", false},
+}
+
+func actionName(action dcgm.PolicyAction) string {
+	for name, a := range actionNames {
+		if a == action {
+			return name
+		}
+	}
+	return strconv.FormatUint(uint64(action), 10)
+}
+
+func validationName(validation dcgm.PolicyValidation) string {
+	for name, v := range validationNames {
+		if v == validation {
+			return name
+		}
+	}
+	return strconv.FormatUint(uint64(validation), 10)
+}
+
+// target is a GPU resolved from a document
+type target struct {
+	policy int
+	gpu    uint
+	uuid   string
+}
+
+// Diff returns the GPUs whose current policy differs from the document, ordered
+// by GPU. GPUs the document does not target are not compared.
+func Diff(backend Backend, doc *Document) ([]Change, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	targets, err := resolve(backend, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for _, t := range targets {
+		current, err := currentPolicy(backend, t.gpu)
+		if err != nil {
+			return nil, err
+		}
+		desired := doc.Policies[t.policy].status()
+		if current.Action == desired.Action && current.Validation == desired.Validation &&
+			maps.Equal(current.Conditions, desired.Conditions) {
+			continue
+		}
+		changes = append(changes, Change{
+			Policy:  doc.Policies[t.policy].Name,
+			GPU:     t.gpu,
+			UUID:    t.uuid,
+			Current: current,
+			Desired: desired,
+		})
+	}
+	return changes, nil
+}
+
+// Apply sets the policies of the document on the GPUs whose current policy
+// differs and returns the changes it made. Applying a document that is already
+// in effect changes nothing. When a policy cannot be set, Apply stops and
+// returns the changes of the policies set before it along with the error.
+func Apply(backend Backend, doc *Document) ([]Change, error) {
+	changes, err := Diff(backend, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	byPolicy := make(map[string][]Change)
+	for _, change := range changes {
+		byPolicy[change.Policy] = append(byPolicy[change.Policy], change)
+	}
+
+	var applied []Change
+	for _, p := range doc.Policies {
+		policyChanges := byPolicy[p.Name]
+		if len(policyChanges) == 0 {
+			continue
+		}
+		gpus := make([]uint, len(policyChanges))
+		for i, change := range policyChanges {
+			gpus[i] = change.GPU
+		}
+		if err := setPolicy(backend, p, gpus); err != nil {
+			return applied, fmt.Errorf("error applying policy %q: %w", p.Name, err)
+		}
+		applied = append(applied, policyChanges...)
+	}
+	return applied, nil
+}
+
+func setPolicy(backend Backend, p Policy, gpus []uint) (err error) {
+	group, err := backend.CreateGroup(fmt.Sprintf("policy%d", rand.Uint64()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, backend.DestroyGroup(group))
+	}()
+
+	for _, gpu := range gpus {
+		if err := backend.AddEntityToGroup(group, dcgm.FE_GPU, gpu); err != nil {
+			return err
+		}
+	}
+	return backend.SetPolicyForGroup(group, p.Configs()...)
+}
+
+// resolve maps the GPUs targeted by each policy to GPU IDs. A GPU may be
+// targeted by one policy only.
+func resolve(backend Backend, doc *Document) ([]target, error) {
+	supported, err := backend.GetSupportedDevices()
+	if err != nil {
+		return nil, fmt.Errorf("error getting GPUs: %w", err)
+	}
+
+	uuids := make(map[uint]string, len(supported))
+	for _, gpu := range supported {
+		device, err := backend.GetDeviceInfo(gpu)
+		if err != nil {
+			return nil, fmt.Errorf("error getting info for GPU %d: %w", gpu, err)
+		}
+		uuids[gpu] = device.UUID
+	}
+
+	owner := make(map[uint]string)
+	var targets []target
+	for i, p := range doc.Policies {
+		gpus := supported
+		if len(p.GPUs) > 0 {
+			gpus = make([]uint, 0, len(p.GPUs))
+			for _, name := range p.GPUs {
+				gpu, err := lookup(name, supported, uuids)
+				if err != nil {
+					return nil, fmt.Errorf("policy %q: %w", p.Name, err)
+				}
+				gpus = append(gpus, gpu)
+			}
+		}
+
+		for _, gpu := range gpus {
+			if other, ok := owner[gpu]; ok {
+				return nil, fmt.Errorf("GPU %d is targeted by policies %q and %q", gpu, other, p.Name)
+			}
+			owner[gpu] = p.Name
+			targets = append(targets, target{policy: i, gpu: gpu, uuid: uuids[gpu]})
+		}
+	}
+
+	slices.SortFunc(targets, func(a, b target) int { return cmp.Compare(a.gpu, b.gpu) })
+	return targets, nil
+}
+
+func lookup(name string, supported []uint, uuids map[uint]string) (uint, error) {
+	index, uuid, err := parseTarget(name)
+	if err != nil {
+		return 0, err
+	}
+	if uuid == "" {
+		if !slices.Contains(supported, index) {
+			return 0, fmt.Errorf("GPU %d not found", index)
+		}
+		return index, nil
+	}
+	for gpu, u := range uuids {
+		if u == uuid {
+			return gpu, nil
+		}
+	}
+	return 0, fmt.Errorf("GPU %s not found", uuid)
+}
+
+// currentPolicy reads the policy set on a GPU
+func currentPolicy(backend Backend, gpu uint) (status dcgm.PolicyStatus, err error) {
+	group, err := backend.CreateGroup(fmt.Sprintf("policy%d", rand.Uint64()))
+	if err != nil {
+		return status, err
+	}
+	defer func() {
+		err = errors.Join(err, backend.DestroyGroup(group))
+	}()
+
+	if err = backend.AddEntityToGroup(group, dcgm.FE_GPU, gpu); err != nil {
+		return status, err
+	}
+
+	current, err := backend.GetPolicyForGroup(group)
+	if err != nil {
+		if !notConfigured(err) {
+			return status, fmt.Errorf("error getting policy of GPU %d: %w", gpu, err)
+		}
+		current = &dcgm.PolicyStatus{}
+	}
+
+	status = *current
+	if status.Conditions == nil {
+		status.Conditions = make(map[dcgm.PolicyCondition]interface{})
+	}
+	return status, nil
+}
+
+// notConfigured reports whether reading a policy failed because none is set
+func notConfigured(err error) bool {
+	var dcgmErr *dcgm.Error
+	if !errors.As(err, &dcgmErr) {
+		return false
+	}
+	return dcgmErr.Code == dcgm.DCGM_ST_NOT_CONFIGURED || dcgmErr.Code == dcgm.DCGM_ST_INSUFFICIENT_SIZE
+}
@@ -0,0 +1,186 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// fakeBackend keeps the policy of each GPU the way nv-hostengine does
+type fakeBackend struct {
+	uuids    map[uint]string
+	policies map[uint]*dcgm.PolicyStatus
+	groups   map[uintptr][]uint
+	next     uintptr
+	sets     int
+	// setErr fails the set of the policy with this GPU
+	setErr map[uint]error
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		uuids:    map[uint]string{0: "GPU-aaaa", 1: "GPU-bbbb", 2: "GPU-cccc"},
+		policies: make(map[uint]*dcgm.PolicyStatus),
+		groups:   make(map[uintptr][]uint),
+	}
+}
+
+func (f *fakeBackend) GetSupportedDevices() ([]uint, error) {
+	return []uint{0, 1, 2}, nil
+}
+
+func (f *fakeBackend) GetDeviceInfo(gpuID uint) (dcgm.Device, error) {
+	return dcgm.Device{GPU: gpuID, UUID: f.uuids[gpuID]}, nil
+}
+
+func (f *fakeBackend) CreateGroup(string) (dcgm.GroupHandle, error) {
+	f.next++
+	var group dcgm.GroupHandle
+	group.SetHandle(f.next)
+	f.groups[f.next] = nil
+	return group, nil
+}
+
+func (f *fakeBackend) AddEntityToGroup(group dcgm.GroupHandle, _ dcgm.Field_Entity_Group, entityID uint) error {
+	f.groups[group.GetHandle()] = append(f.groups[group.GetHandle()], entityID)
+	return nil
+}
+
+func (f *fakeBackend) DestroyGroup(group dcgm.GroupHandle) error {
+	delete(f.groups, group.GetHandle())
+	return nil
+}
+
+func (f *fakeBackend) GetPolicyForGroup(group dcgm.GroupHandle) (*dcgm.PolicyStatus, error) {
+	status, ok := f.policies[f.groups[group.GetHandle()][0]]
+	if !ok {
+		return nil, &dcgm.Error{Code: dcgm.DCGM_ST_NOT_CONFIGURED}
+	}
+	return status, nil
+}
+
+func (f *fakeBackend) SetPolicyForGroup(group dcgm.GroupHandle, configs ...dcgm.PolicyConfig) error {
+	for _, gpu := range f.groups[group.GetHandle()] {
+		if err := f.setErr[gpu]; err != nil {
+			return err
+		}
+	}
+	f.sets++
+	status := &dcgm.PolicyStatus{
+		Action:     *configs[0].Action,
+		Validation: *configs[0].Validation,
+		Conditions: make(map[dcgm.PolicyCondition]interface{}),
+	}
+	for _, config := range configs {
+		switch {
+		case config.MaxRetiredPages != nil:
+			status.Conditions[config.Condition] = *config.MaxRetiredPages
+		case config.MaxTemperature != nil:
+			status.Conditions[config.Condition] = *config.MaxTemperature
+		case config.MaxPower != nil:
+			status.Conditions[config.Condition] = *config.MaxPower
+		default:
+			status.Conditions[config.Condition] = true
+		}
+	}
+	for _, gpu := range f.groups[group.GetHandle()] {
+		f.policies[gpu] = status
+	}
+	return nil
+}
+
+func TestApplyIsIdempotent(t *testing.T) {
+	backend := newFakeBackend()
+	// GPU 1 already has the training policy, except for the temperature threshold
+	backend.policies[1] = &dcgm.PolicyStatus{
+		Action:     dcgm.PolicyActionGPUReset,
+		Validation: dcgm.PolicyValidationShort,
+		Conditions: map[dcgm.PolicyCondition]interface{}{
+			dcgm.DbePolicy:     true,
+			dcgm.XidPolicy:     true,
+			dcgm.ThermalPolicy: uint32(100),
+			dcgm.NvlinkPolicy:  true,
+		},
+	}
+
+	doc, err := Load("testdata/policies.yaml")
+	require.NoError(t, err)
+
+	changes, err := Diff(backend, doc)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	assert.Equal(t, 0, backend.sets)
+
+	assert.Equal(t, "training", changes[0].Policy)
+	assert.Equal(t, uint(0), changes[0].GPU)
+	assert.Empty(t, changes[0].Current.Conditions)
+	assert.Equal(t,
+		`policy "training" on GPU 0: action none -> gpu_reset, validation none -> short, +dbe, +max_temperature 90, +xid`,
+		changes[0].String())
+
+	assert.Equal(t, "GPU-bbbb", changes[1].UUID)
+	assert.Equal(t, `policy "training" on GPU 1: max_temperature 100 -> 90, -nvlink`, changes[1].String())
+
+	assert.Equal(t, "inference", changes[2].Policy)
+	assert.Equal(t, uint(2), changes[2].GPU)
+
+	applied, err := Apply(backend, doc)
+	require.NoError(t, err)
+	assert.Equal(t, changes, applied)
+	// One group per policy
+	assert.Equal(t, 2, backend.sets)
+	assert.Empty(t, backend.groups)
+
+	applied, err = Apply(backend, doc)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+	assert.Equal(t, 2, backend.sets)
+}
+
+func TestApplyReturnsAppliedChangesOnError(t *testing.T) {
+	backend := newFakeBackend()
+	// GPU 2 has the second policy of the document
+	backend.setErr = map[uint]error{2: &dcgm.Error{Code: dcgm.DCGM_ST_NOT_SUPPORTED}}
+
+	doc, err := Load("testdata/policies.yaml")
+	require.NoError(t, err)
+
+	applied, err := Apply(backend, doc)
+	require.ErrorContains(t, err, `error applying policy "inference"`)
+	require.Len(t, applied, 2)
+	for _, change := range applied {
+		assert.Equal(t, "training", change.Policy)
+	}
+	assert.Equal(t, 1, backend.sets)
+	assert.Contains(t, backend.policies, uint(0))
+	assert.NotContains(t, backend.policies, uint(2))
+}
+
+func TestDiffRejectsInvalidTargets(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{"unknown index", `policies: [{name: a, gpus: ["7"], conditions: {dbe: true}}]`, "GPU 7 not found"},
+		{"unknown UUID", `policies: [{name: a, gpus: [GPU-ffff], conditions: {dbe: true}}]`, "GPU GPU-ffff not found"},
+		{"overlap", `policies: [{name: a, gpus: ["1"], conditions: {dbe: true}}, {name: b, conditions: {xid: true}}]`,
+			`GPU 1 is targeted by policies "a" and "b"`},
+		{"overlap by UUID", `policies: [{name: a, gpus: ["1"], conditions: {dbe: true}}, ` +
+			`{name: b, gpus: [GPU-bbbb], conditions: {xid: true}}]`, `GPU 1 is targeted by policies "a" and "b"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tt.doc))
+			require.NoError(t, err)
+
+			_, err = Diff(newFakeBackend(), doc)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
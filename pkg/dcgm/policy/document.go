@@ -0,0 +1,289 @@
+// Package policy configures DCGM policies from declarative YAML or JSON
+// documents.
+//
+// A document lists policies, each with the GPUs it targets, the conditions
+// nv-hostengine watches for them and the action and validation to run on a
+// violation:
+//
+//	policies:
+//	  - name: training
+//	    gpus: ["0", "1", "GPU-8a1b2c3d-..."]
+//	    action: gpu_reset
+//	    validation: short
+//	    conditions:
+//	      dbe: true
+//	      xid: true
+//	      max_temperature: 90
+//
+// Load parses and validates a document. Diff compares it with the policies
+// currently set on the GPUs, and Apply sets the policies of the GPUs that
+// differ, so applying the same document again changes nothing:
+//
+//	doc, err := policy.Load("policies.yaml")
+//	...
+//	changes, err := policy.Apply(policy.DCGM(), doc)
+package policy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Action names accepted in documents
+var actionNames = map[string]dcgm.PolicyAction{
+	"none":      dcgm.PolicyActionNone,
+	"gpu_reset": dcgm.PolicyActionGPUReset,
+}
+
+// Validation names accepted in documents
+var validationNames = map[string]dcgm.PolicyValidation{
+	"none":   dcgm.PolicyValidationNone,
+	"short":  dcgm.PolicyValidationShort,
+	"medium": dcgm.PolicyValidationMedium,
+	"long":   dcgm.PolicyValidationLong,
+}
+
+// Document is a set of policies to configure
+type Document struct {
+	Policies []Policy `json:"policies" yaml:"policies"`
+}
+
+// Policy is the policy of a set of GPUs
+type Policy struct {
+	// Name identifies the policy in errors and changes
+	Name string `json:"name" yaml:"name"`
+	// GPUs lists the targeted GPUs by index ("0") or UUID ("GPU-..."). An empty
+	// list targets all GPUs supported by DCGM.
+	GPUs []string `json:"gpus,omitempty" yaml:"gpus,omitempty"`
+	// Action is the action taken on a violation: "none" (default) or "gpu_reset"
+	Action string `json:"action,omitempty" yaml:"action,omitempty"`
+	// Validation is the validation run after the action: "none" (default),
+	// "short", "medium" or "long"
+	Validation string `json:"validation,omitempty" yaml:"validation,omitempty"`
+	// Conditions are the conditions watched by nv-hostengine
+	Conditions Conditions `json:"conditions" yaml:"conditions"`
+}
+
+// Conditions selects the policy conditions to watch. The thresholds enable the
+// condition they configure.
+type Conditions struct {
+	// DBE watches double-bit ECC errors
+	DBE bool `json:"dbe,omitempty" yaml:"dbe,omitempty"`
+	// PCIe watches PCI errors
+	PCIe bool `json:"pcie,omitempty" yaml:"pcie,omitempty"`
+	// NVLink watches NVLink errors
+	NVLink bool `json:"nvlink,omitempty" yaml:"nvlink,omitempty"`
+	// XID watches XID errors
+	XID bool `json:"xid,omitempty" yaml:"xid,omitempty"`
+	// MaxRetiredPages is the number of retired pages that is a violation
+	MaxRetiredPages *uint32 `json:"max_retired_pages,omitempty" yaml:"max_retired_pages,omitempty"`
+	// MaxTemperature is the temperature in Celsius that is a violation
+	MaxTemperature *uint32 `json:"max_temperature,omitempty" yaml:"max_temperature,omitempty"`
+	// MaxPower is the power in watts that is a violation
+	MaxPower *uint32 `json:"max_power,omitempty" yaml:"max_power,omitempty"`
+}
+
+// Load reads and validates the policy document at path
+func Load(path string) (*Document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy document: %w", err)
+	}
+	doc, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return doc, nil
+}
+
+// Parse decodes and validates a policy document in YAML or JSON. Unknown keys
+// are rejected.
+func Parse(data []byte) (*Document, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var doc Document
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("error parsing policy document: %w", err)
+	}
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Validate checks the policies are well-formed. It does not check that the
+// targeted GPUs exist; Diff and Apply do.
+func (d *Document) Validate() error {
+	if len(d.Policies) == 0 {
+		return errors.New("policy document has no policies")
+	}
+
+	var errs []error
+	names := make(map[string]bool, len(d.Policies))
+	for i, p := range d.Policies {
+		if p.Name == "" {
+			errs = append(errs, fmt.Errorf("policy %d has no name", i))
+		} else if names[p.Name] {
+			errs = append(errs, fmt.Errorf("policy %q is defined more than once", p.Name))
+		}
+		names[p.Name] = true
+
+		if err := p.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("policy %q: %w", p.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p Policy) validate() error {
+	if _, err := p.action(); err != nil {
+		return err
+	}
+	if _, err := p.validation(); err != nil {
+		return err
+	}
+
+	for i, gpu := range p.GPUs {
+		if _, _, err := parseTarget(gpu); err != nil {
+			return err
+		}
+		if slices.Contains(p.GPUs[:i], gpu) {
+			return fmt.Errorf("GPU %s is listed more than once", gpu)
+		}
+	}
+
+	c := p.Conditions
+	if !c.DBE && !c.PCIe && !c.NVLink && !c.XID &&
+		c.MaxRetiredPages == nil && c.MaxTemperature == nil && c.MaxPower == nil {
+		return errors.New("no conditions")
+	}
+	for _, threshold := range []struct {
+		name  string
+		value *uint32
+	}{
+		{"max_retired_pages", c.MaxRetiredPages},
+		{"max_temperature", c.MaxTemperature},
+		{"max_power", c.MaxPower},
+	} {
+		if threshold.value != nil && *threshold.value == 0 {
+			return fmt.Errorf("%s must be positive", threshold.name)
+		}
+	}
+	return nil
+}
+
+func (p Policy) action() (dcgm.PolicyAction, error) {
+	if p.Action == "" {
+		return dcgm.PolicyActionNone, nil
+	}
+	action, ok := actionNames[p.Action]
+	if !ok {
+		return 0, fmt.Errorf("invalid action %q, must be one of %s", p.Action,
+			strings.Join(slices.Sorted(maps.Keys(actionNames)), ", "))
+	}
+	return action, nil
+}
+
+func (p Policy) validation() (dcgm.PolicyValidation, error) {
+	if p.Validation == "" {
+		return dcgm.PolicyValidationNone, nil
+	}
+	validation, ok := validationNames[p.Validation]
+	if !ok {
+		return 0, fmt.Errorf("invalid validation %q, must be one of %s", p.Validation,
+			strings.Join(slices.Sorted(maps.Keys(validationNames)), ", "))
+	}
+	return validation, nil
+}
+
+// Configs returns the configs to pass to dcgm.SetPolicyForGroup for the policy.
+// The policy must be valid.
+func (p Policy) Configs() []dcgm.PolicyConfig {
+	c := p.Conditions
+	var configs []dcgm.PolicyConfig
+	if c.DBE {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.DbePolicy})
+	}
+	if c.PCIe {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.PCIePolicy})
+	}
+	if c.MaxRetiredPages != nil {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.MaxRtPgPolicy, MaxRetiredPages: c.MaxRetiredPages})
+	}
+	if c.MaxTemperature != nil {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.ThermalPolicy, MaxTemperature: c.MaxTemperature})
+	}
+	if c.MaxPower != nil {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.PowerPolicy, MaxPower: c.MaxPower})
+	}
+	if c.NVLink {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.NvlinkPolicy})
+	}
+	if c.XID {
+		configs = append(configs, dcgm.PolicyConfig{Condition: dcgm.XidPolicy})
+	}
+
+	// The action and validation of the first config apply to the whole policy
+	action, _ := p.action()
+	validation, _ := p.validation()
+	configs[0].Action = &action
+	configs[0].Validation = &validation
+	return configs
+}
+
+// status returns the dcgm.PolicyStatus that GetPolicyForGroup reports once the
+// policy is set. The policy must be valid.
+func (p Policy) status() dcgm.PolicyStatus {
+	action, _ := p.action()
+	validation, _ := p.validation()
+	status := dcgm.PolicyStatus{
+		Action:     action,
+		Validation: validation,
+		Conditions: make(map[dcgm.PolicyCondition]interface{}),
+	}
+
+	c := p.Conditions
+	for condition, enabled := range map[dcgm.PolicyCondition]bool{
+		dcgm.DbePolicy:    c.DBE,
+		dcgm.PCIePolicy:   c.PCIe,
+		dcgm.NvlinkPolicy: c.NVLink,
+		dcgm.XidPolicy:    c.XID,
+	} {
+		if enabled {
+			status.Conditions[condition] = true
+		}
+	}
+	for condition, threshold := range map[dcgm.PolicyCondition]*uint32{
+		dcgm.MaxRtPgPolicy: c.MaxRetiredPages,
+		dcgm.ThermalPolicy: c.MaxTemperature,
+		dcgm.PowerPolicy:   c.MaxPower,
+	} {
+		if threshold != nil {
+			status.Conditions[condition] = *threshold
+		}
+	}
+	return status
+}
+
+// parseTarget parses a GPU index or UUID
+func parseTarget(target string) (index uint, uuid string, err error) {
+	if strings.HasPrefix(target, "GPU-") {
+		return 0, target, nil
+	}
+	n, err := strconv.ParseUint(target, 10, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid GPU %q, must be an index or a UUID starting with GPU-", target)
+	}
+	return uint(n), "", nil
+}
@@ -0,0 +1,99 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+func uint32Ptr(v uint32) *uint32 {
+	return &v
+}
+
+func TestLoad(t *testing.T) {
+	want := &Document{Policies: []Policy{
+		{
+			Name:       "training",
+			GPUs:       []string{"0", "GPU-bbbb"},
+			Action:     "gpu_reset",
+			Validation: "short",
+			Conditions: Conditions{DBE: true, XID: true, MaxTemperature: uint32Ptr(90)},
+		},
+		{
+			Name:       "inference",
+			GPUs:       []string{"2"},
+			Conditions: Conditions{PCIe: true, MaxPower: uint32Ptr(300)},
+		},
+	}}
+
+	for _, path := range []string{"testdata/policies.yaml", "testdata/policies.json"} {
+		doc, err := Load(path)
+		require.NoError(t, err, path)
+		assert.Equal(t, want, doc, path)
+	}
+
+	_, err := Load("testdata/missing.yaml")
+	require.Error(t, err)
+}
+
+func TestParseRejectsInvalidDocuments(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		err  string
+	}{
+		{"unknown key", "policies: [{name: a, conditions: {dbe: true, ecc: true}}]", "field ecc not found"},
+		{"empty", "policies: []", "no policies"},
+		{"no name", "policies: [{conditions: {dbe: true}}]", "has no name"},
+		{"duplicate name", "policies: [{name: a, conditions: {dbe: true}}, {name: a, conditions: {xid: true}}]",
+			"defined more than once"},
+		{"no conditions", "policies: [{name: a}]", "no conditions"},
+		{"action", "policies: [{name: a, action: reboot, conditions: {dbe: true}}]",
+			`invalid action "reboot", must be one of gpu_reset, none`},
+		{"validation", "policies: [{name: a, validation: full, conditions: {dbe: true}}]", `invalid validation "full"`},
+		{"target", "policies: [{name: a, gpus: [first], conditions: {dbe: true}}]", `invalid GPU "first"`},
+		{"duplicate target", `policies: [{name: a, gpus: ["1", "1"], conditions: {dbe: true}}]`, "listed more than once"},
+		{"zero threshold", "policies: [{name: a, conditions: {max_power: 0}}]", "max_power must be positive"},
+		{"negative threshold", "policies: [{name: a, conditions: {max_power: -1}}]", "cannot unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.doc))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestPolicyConfigs(t *testing.T) {
+	p := Policy{
+		Name:       "training",
+		Action:     "gpu_reset",
+		Validation: "long",
+		Conditions: Conditions{XID: true, DBE: true, MaxRetiredPages: uint32Ptr(5)},
+	}
+	require.NoError(t, p.validate())
+
+	configs := p.Configs()
+	require.Len(t, configs, 3)
+	assert.Equal(t, dcgm.DbePolicy, configs[0].Condition)
+	assert.Equal(t, dcgm.PolicyActionGPUReset, *configs[0].Action)
+	assert.Equal(t, dcgm.PolicyValidationLong, *configs[0].Validation)
+	assert.Equal(t, dcgm.MaxRtPgPolicy, configs[1].Condition)
+	assert.Equal(t, uint32(5), *configs[1].MaxRetiredPages)
+	assert.Equal(t, dcgm.XidPolicy, configs[2].Condition)
+
+	assert.Equal(t, dcgm.PolicyStatus{
+		Action:     dcgm.PolicyActionGPUReset,
+		Validation: dcgm.PolicyValidationLong,
+		Conditions: map[dcgm.PolicyCondition]interface{}{
+			dcgm.DbePolicy:     true,
+			dcgm.XidPolicy:     true,
+			dcgm.MaxRtPgPolicy: uint32(5),
+		},
+	}, p.status())
+}
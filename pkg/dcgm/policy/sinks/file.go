@@ -0,0 +1,148 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+const (
+	// DefaultFileMaxSize is the size in bytes at which a File is rotated
+	DefaultFileMaxSize = 100 << 20
+	// DefaultFileMaxBackups is the number of rotated files kept
+	DefaultFileMaxBackups = 5
+)
+
+// FileConfig configures a File sink
+type FileConfig struct {
+	// Path of the file. Rotated files are named Path.1 (the newest) to Path.N.
+	Path string
+	// MaxSize is the size in bytes at which the file is rotated. Defaults to
+	// DefaultFileMaxSize; a negative value disables rotation.
+	MaxSize int64
+	// MaxBackups is the number of rotated files kept. Defaults to DefaultFileMaxBackups.
+	MaxBackups int
+}
+
+// File appends each violation as a line of JSON to a file and rotates the file
+// when it grows over FileConfig.MaxSize
+type File struct {
+	cfg  FileConfig
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewFile opens the file, creating it if needed
+func NewFile(cfg FileConfig) (*File, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("file sink requires a path")
+	}
+	if cfg.MaxSize == 0 {
+		cfg.MaxSize = DefaultFileMaxSize
+	}
+	if cfg.MaxBackups <= 0 {
+		cfg.MaxBackups = DefaultFileMaxBackups
+	}
+
+	f := &File{cfg: cfg}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *File) open() error {
+	file, err := os.OpenFile(f.cfg.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening file sink: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("error opening file sink: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// Send appends the batch, rotating the file before a line that would take it
+// over the maximum size
+func (f *File) Send(_ context.Context, violations []dcgm.PolicyViolation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return errors.New("file sink is closed")
+	}
+
+	for _, violation := range violations {
+		line, err := json.Marshal(violation)
+		if err != nil {
+			return fmt.Errorf("error encoding violation: %w", err)
+		}
+		line = append(line, '\n')
+
+		if f.cfg.MaxSize > 0 && f.size > 0 && f.size+int64(len(line)) > f.cfg.MaxSize {
+			if err := f.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := f.file.Write(line)
+		f.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("error writing to file sink: %w", err)
+		}
+	}
+	return nil
+}
+
+// rotate shifts Path.N-1 to Path.N, ..., Path to Path.1 and opens a new Path.
+// When the backups cannot be shifted, Path is reopened for appending so that
+// the sink stays usable and rotation is retried by the next Send.
+func (f *File) rotate() error {
+	err := f.file.Close()
+	f.file = nil
+	if err == nil {
+		err = f.shiftBackups()
+	}
+	if err != nil {
+		err = fmt.Errorf("error rotating file sink: %w", err)
+		if openErr := f.open(); openErr != nil {
+			return errors.Join(err, openErr)
+		}
+		return err
+	}
+	return f.open()
+}
+
+func (f *File) shiftBackups() error {
+	backup := func(n int) string { return f.cfg.Path + "." + strconv.Itoa(n) }
+	for n := f.cfg.MaxBackups - 1; n >= 1; n-- {
+		if err := os.Rename(backup(n), backup(n+1)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return os.Rename(f.cfg.Path, backup(1))
+}
+
+// Close closes the file
+func (f *File) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
@@ -0,0 +1,104 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+func readLines(t *testing.T, path string) []dcgm.PolicyViolation {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var violations []dcgm.PolicyViolation
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var v dcgm.PolicyViolation
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &v))
+		violations = append(violations, v)
+	}
+	require.NoError(t, scanner.Err())
+	return violations
+}
+
+func TestFileAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "violations.jsonl")
+
+	for gpu := range uint(2) {
+		sink, err := NewFile(FileConfig{Path: path})
+		require.NoError(t, err)
+		require.NoError(t, sink.Send(context.Background(), []dcgm.PolicyViolation{violation(gpu, dcgm.XidPolicy)}))
+		require.NoError(t, sink.Close())
+	}
+
+	violations := readLines(t, path)
+	require.Len(t, violations, 2)
+	assert.Equal(t, uint(0), violations[0].GPU)
+	assert.Equal(t, uint(1), violations[1].GPU)
+}
+
+func TestFileRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "violations.jsonl")
+	line, err := json.Marshal(violation(0, dcgm.XidPolicy))
+	require.NoError(t, err)
+
+	// Two lines fit in a file
+	sink, err := NewFile(FileConfig{Path: path, MaxSize: int64(2 * (len(line) + 1)), MaxBackups: 2})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	for gpu := range uint(7) {
+		require.NoError(t, sink.Send(context.Background(), []dcgm.PolicyViolation{violation(gpu, dcgm.XidPolicy)}))
+	}
+
+	gpus := func(path string) []uint {
+		var gpus []uint
+		for _, v := range readLines(t, path) {
+			gpus = append(gpus, v.GPU)
+		}
+		return gpus
+	}
+	assert.Equal(t, []uint{6}, gpus(path))
+	assert.Equal(t, []uint{4, 5}, gpus(path+".1"))
+	assert.Equal(t, []uint{2, 3}, gpus(path+".2"))
+	assert.NoFileExists(t, path+".3")
+}
+
+func TestFileKeepsAppendingWhenRotationFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "violations.jsonl")
+	// A non-empty directory cannot be replaced by the rotated file
+	require.NoError(t, os.MkdirAll(filepath.Join(path+".1", "busy"), 0o755))
+
+	sink, err := NewFile(FileConfig{Path: path, MaxSize: 1, MaxBackups: 1})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	send := func(gpu uint) error {
+		return sink.Send(context.Background(), []dcgm.PolicyViolation{violation(gpu, dcgm.XidPolicy)})
+	}
+	require.NoError(t, send(0))
+	require.ErrorContains(t, send(1), "error rotating file sink")
+	require.ErrorContains(t, send(2), "error rotating file sink", "the sink is not closed")
+
+	require.NoError(t, os.RemoveAll(path+".1"))
+	require.NoError(t, send(3))
+	assert.Equal(t, uint(0), readLines(t, path+".1")[0].GPU)
+	assert.Equal(t, uint(3), readLines(t, path)[0].GPU)
+}
+
+func TestFileSendAfterClose(t *testing.T) {
+	sink, err := NewFile(FileConfig{Path: filepath.Join(t.TempDir(), "violations.jsonl")})
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+	require.Error(t, sink.Send(context.Background(), []dcgm.PolicyViolation{violation(0, dcgm.XidPolicy)}))
+}
@@ -0,0 +1,195 @@
+// Package sinks ships DCGM policy violations to external systems.
+//
+// A Router reads violations from the channel returned by
+// dcgm.ListenForPolicyViolations or dcgm.WatchPolicyViolationsForGroup, drops
+// repeats of the same GPU and condition within a rate limit, groups the rest
+// into batches and hands each batch to every Sink:
+//
+//	violations, err := dcgm.ListenForPolicyViolations(ctx, dcgm.XidPolicy, dcgm.DbePolicy)
+//	...
+//	file, err := sinks.NewFile(sinks.FileConfig{Path: "/var/log/dcgm/violations.jsonl"})
+//	...
+//	router := sinks.NewRouter(sinks.Config{RateLimit: time.Minute}, sinks.NewWebhook(sinks.WebhookConfig{URL: url}), file)
+//	err = router.Run(ctx, violations)
+package sinks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+const (
+	// DefaultBatchSize is the maximum number of violations per batch
+	DefaultBatchSize = 100
+	// DefaultFlushInterval is how long a violation waits for its batch to fill
+	DefaultFlushInterval = time.Second
+)
+
+// Sink delivers batches of policy violations
+type Sink interface {
+	// Send delivers a batch. It is not called concurrently.
+	Send(ctx context.Context, violations []dcgm.PolicyViolation) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// Config configures a Router
+type Config struct {
+	// BatchSize is the maximum number of violations per batch. Defaults to DefaultBatchSize.
+	BatchSize int
+	// FlushInterval is how long a violation waits for its batch to fill. Defaults to DefaultFlushInterval.
+	FlushInterval time.Duration
+	// RateLimit drops violations of a GPU and condition that follow the last one
+	// forwarded by less than RateLimit. Zero forwards every violation.
+	RateLimit time.Duration
+	// OnError is called when a sink fails to deliver a batch. It may be called
+	// concurrently for different sinks.
+	OnError func(sink Sink, violations []dcgm.PolicyViolation, err error)
+}
+
+// Stats counts the violations handled by a Router
+type Stats struct {
+	// Received is the number of violations read from the channel
+	Received uint64
+	// RateLimited is the number of violations dropped by the rate limit
+	RateLimited uint64
+	// Delivered is the number of violations delivered, counted once per sink
+	Delivered uint64
+	// Failed is the number of violations a sink failed to deliver, counted once per sink
+	Failed uint64
+	// DispatcherDropped is dcgm.PolicyViolationDropCount: the violations dropped
+	// before reaching the channel because it was full
+	DispatcherDropped uint64
+}
+
+type rateKey struct {
+	gpu       uint
+	condition dcgm.PolicyCondition
+}
+
+// Router batches policy violations and delivers them to sinks
+type Router struct {
+	cfg   Config
+	sinks []Sink
+	last  map[rateKey]time.Time
+
+	received    atomic.Uint64
+	rateLimited atomic.Uint64
+	delivered   atomic.Uint64
+	failed      atomic.Uint64
+}
+
+// NewRouter returns a Router delivering to the sinks
+func NewRouter(cfg Config, sinks ...Sink) *Router {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = DefaultFlushInterval
+	}
+	return &Router{
+		cfg:   cfg,
+		sinks: sinks,
+		last:  make(map[rateKey]time.Time),
+	}
+}
+
+// Run delivers the violations read from the channel until it is closed or the
+// context is canceled, then flushes the pending batch and closes the sinks.
+// Delivery failures are reported to Config.OnError and counted in Stats; Run
+// only returns the errors of closing the sinks.
+func (r *Router) Run(ctx context.Context, violations <-chan dcgm.PolicyViolation) error {
+	var batch []dcgm.PolicyViolation
+	timer := time.NewTimer(r.cfg.FlushInterval)
+	timer.Stop()
+
+	flush := func(ctx context.Context) {
+		if len(batch) > 0 {
+			r.deliver(ctx, batch)
+			batch = nil
+		}
+		timer.Stop()
+	}
+
+loop:
+	for {
+		select {
+		case violation, ok := <-violations:
+			if !ok {
+				break loop
+			}
+			r.received.Add(1)
+			if !r.allow(violation, time.Now()) {
+				r.rateLimited.Add(1)
+				continue
+			}
+			if len(batch) == 0 {
+				timer.Reset(r.cfg.FlushInterval)
+			}
+			batch = append(batch, violation)
+			if len(batch) >= r.cfg.BatchSize {
+				flush(ctx)
+			}
+		case <-timer.C:
+			flush(ctx)
+		case <-ctx.Done():
+			break loop
+		}
+	}
+
+	// The pending batch is delivered even though ctx may be canceled
+	flush(context.WithoutCancel(ctx))
+
+	var errs []error
+	for _, sink := range r.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// allow applies the rate limit to a violation received at now
+func (r *Router) allow(violation dcgm.PolicyViolation, now time.Time) bool {
+	if r.cfg.RateLimit <= 0 {
+		return true
+	}
+	key := rateKey{gpu: violation.GPU, condition: violation.Condition}
+	if last, ok := r.last[key]; ok && now.Sub(last) < r.cfg.RateLimit {
+		return false
+	}
+	r.last[key] = now
+	return true
+}
+
+// deliver sends a batch to every sink concurrently and waits for them
+func (r *Router) deliver(ctx context.Context, batch []dcgm.PolicyViolation) {
+	var wg sync.WaitGroup
+	for _, sink := range r.sinks {
+		wg.Go(func() {
+			if err := sink.Send(ctx, batch); err != nil {
+				r.failed.Add(uint64(len(batch)))
+				if r.cfg.OnError != nil {
+					r.cfg.OnError(sink, batch, err)
+				}
+				return
+			}
+			r.delivered.Add(uint64(len(batch)))
+		})
+	}
+	wg.Wait()
+}
+
+// Stats returns the counters of the Router
+func (r *Router) Stats() Stats {
+	return Stats{
+		Received:          r.received.Load(),
+		RateLimited:       r.rateLimited.Load(),
+		Delivered:         r.delivered.Load(),
+		Failed:            r.failed.Load(),
+		DispatcherDropped: dcgm.PolicyViolationDropCount(),
+	}
+}
@@ -0,0 +1,148 @@
+package sinks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]dcgm.PolicyViolation
+	err     error
+	closed  bool
+}
+
+func (f *fakeSink) Send(_ context.Context, violations []dcgm.PolicyViolation) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, violations)
+	return f.err
+}
+
+func (f *fakeSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.closed = true
+	return nil
+}
+
+func violation(gpu uint, condition dcgm.PolicyCondition) dcgm.PolicyViolation {
+	return dcgm.PolicyViolation{GPU: gpu, Condition: condition, Timestamp: time.Unix(1700000000, 0)}
+}
+
+func TestRouterBatches(t *testing.T) {
+	sink := &fakeSink{}
+	router := NewRouter(Config{BatchSize: 2, FlushInterval: time.Hour}, sink)
+
+	violations := make(chan dcgm.PolicyViolation, 3)
+	violations <- violation(0, dcgm.XidPolicy)
+	violations <- violation(1, dcgm.XidPolicy)
+	violations <- violation(2, dcgm.XidPolicy)
+	close(violations)
+
+	require.NoError(t, router.Run(context.Background(), violations))
+
+	require.Len(t, sink.batches, 2)
+	assert.Len(t, sink.batches[0], 2)
+	assert.Len(t, sink.batches[1], 1, "the pending batch is flushed when the channel closes")
+	assert.True(t, sink.closed)
+
+	stats := router.Stats()
+	assert.Equal(t, uint64(3), stats.Received)
+	assert.Equal(t, uint64(3), stats.Delivered)
+	assert.Zero(t, stats.Failed)
+}
+
+func TestRouterFlushInterval(t *testing.T) {
+	sink := &fakeSink{}
+	router := NewRouter(Config{FlushInterval: 10 * time.Millisecond}, sink)
+
+	violations := make(chan dcgm.PolicyViolation)
+	done := make(chan error)
+	go func() { done <- router.Run(context.Background(), violations) }()
+
+	violations <- violation(0, dcgm.DbePolicy)
+	assert.Eventually(t, func() bool {
+		sink.mu.Lock()
+		defer sink.mu.Unlock()
+		return len(sink.batches) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	close(violations)
+	require.NoError(t, <-done)
+}
+
+func TestRouterRateLimit(t *testing.T) {
+	sink := &fakeSink{}
+	router := NewRouter(Config{RateLimit: time.Hour}, sink)
+
+	violations := make(chan dcgm.PolicyViolation, 4)
+	violations <- violation(0, dcgm.XidPolicy)
+	violations <- violation(0, dcgm.XidPolicy)
+	violations <- violation(0, dcgm.DbePolicy)
+	violations <- violation(1, dcgm.XidPolicy)
+	close(violations)
+
+	require.NoError(t, router.Run(context.Background(), violations))
+
+	require.Len(t, sink.batches, 1)
+	assert.Equal(t, []dcgm.PolicyViolation{
+		violation(0, dcgm.XidPolicy),
+		violation(0, dcgm.DbePolicy),
+		violation(1, dcgm.XidPolicy),
+	}, sink.batches[0])
+	assert.Equal(t, uint64(1), router.Stats().RateLimited)
+}
+
+func TestRouterReportsFailures(t *testing.T) {
+	failing := &fakeSink{err: errors.New("unreachable")}
+	working := &fakeSink{}
+
+	var reported []error
+	router := NewRouter(Config{
+		OnError: func(sink Sink, violations []dcgm.PolicyViolation, err error) {
+			assert.Same(t, failing, sink)
+			assert.Len(t, violations, 2)
+			reported = append(reported, err)
+		},
+	}, failing, working)
+
+	violations := make(chan dcgm.PolicyViolation, 2)
+	violations <- violation(0, dcgm.XidPolicy)
+	violations <- violation(1, dcgm.XidPolicy)
+	close(violations)
+
+	require.NoError(t, router.Run(context.Background(), violations))
+
+	require.Len(t, reported, 1)
+	require.EqualError(t, reported[0], "unreachable")
+	stats := router.Stats()
+	assert.Equal(t, uint64(2), stats.Failed)
+	assert.Equal(t, uint64(2), stats.Delivered)
+	assert.Len(t, working.batches, 1)
+}
+
+func TestRouterFlushesOnCancel(t *testing.T) {
+	sink := &fakeSink{}
+	router := NewRouter(Config{FlushInterval: time.Hour}, sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	violations := make(chan dcgm.PolicyViolation)
+	done := make(chan error)
+	go func() { done <- router.Run(ctx, violations) }()
+
+	violations <- violation(0, dcgm.PowerPolicy)
+	cancel()
+	require.NoError(t, <-done)
+
+	require.Len(t, sink.batches, 1)
+	assert.True(t, sink.closed)
+}
@@ -0,0 +1,192 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+// Syslog facilities
+const (
+	FacilityUser   = 1
+	FacilityDaemon = 3
+	FacilityLocal0 = 16
+	FacilityLocal7 = 23
+)
+
+// syslogTimestamp is the RFC 5424 TIMESTAMP layout. TIME-SECFRAC allows at
+// most 6 fractional digits, so time.RFC3339Nano cannot be used.
+const syslogTimestamp = "2006-01-02T15:04:05.000000Z07:00"
+
+// syslogSeverities maps the severity of a violation to a syslog severity
+var syslogSeverities = map[dcgm.PolicySeverity]int{
+	dcgm.PolicySeverityCritical: 2,
+	dcgm.PolicySeverityError:    3,
+	dcgm.PolicySeverityWarning:  4,
+}
+
+// SyslogConfig configures a Syslog sink
+type SyslogConfig struct {
+	// Network is "udp", "tcp" or "unix"
+	Network string
+	// Address is the address of the syslog server, e.g. "localhost:514"
+	Address string
+	// Facility of the messages. Defaults to FacilityDaemon.
+	Facility int
+	// AppName is the APP-NAME of the messages. Defaults to "dcgm".
+	AppName string
+	// Hostname is the HOSTNAME of the messages. Defaults to os.Hostname.
+	Hostname string
+}
+
+// Syslog sends each violation as an RFC 5424 message whose MSG is the JSON
+// encoding of the violation and whose severity is that of the violation:
+// critical, error or warning. Messages over TCP are framed by octet counting
+// (RFC 6587).
+type Syslog struct {
+	cfg  SyslogConfig
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewSyslog connects to the syslog server
+func NewSyslog(cfg SyslogConfig) (*Syslog, error) {
+	if cfg.Facility == 0 {
+		cfg.Facility = FacilityDaemon
+	}
+	if cfg.Facility < 0 || cfg.Facility > FacilityLocal7 {
+		return nil, fmt.Errorf("invalid syslog facility %d", cfg.Facility)
+	}
+	if cfg.AppName == "" {
+		cfg.AppName = "dcgm"
+	}
+	if cfg.Hostname == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("error getting hostname: %w", err)
+		}
+		cfg.Hostname = hostname
+	}
+
+	s := &Syslog{cfg: cfg}
+	if err := s.connect(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Syslog) connect() error {
+	conn, err := net.Dial(s.cfg.Network, s.cfg.Address)
+	if err != nil {
+		return fmt.Errorf("error connecting to syslog: %w", err)
+	}
+	s.conn = conn
+	return nil
+}
+
+// Send writes one message per violation. A stream connection that fails is
+// reopened once.
+func (s *Syslog) Send(_ context.Context, violations []dcgm.PolicyViolation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, violation := range violations {
+		message, err := s.format(violation)
+		if err != nil {
+			return err
+		}
+		if s.cfg.Network == "tcp" {
+			message = append([]byte(strconv.Itoa(len(message))+" "), message...)
+		}
+
+		if err := s.write(message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Syslog) write(message []byte) error {
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.conn.Write(message); err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		if err := s.connect(); err != nil {
+			return err
+		}
+		if _, err := s.conn.Write(message); err != nil {
+			return fmt.Errorf("error writing to syslog: %w", err)
+		}
+	}
+	return nil
+}
+
+// format returns the RFC 5424 message of a violation:
+// <PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG
+func (s *Syslog) format(violation dcgm.PolicyViolation) ([]byte, error) {
+	msg, err := json.Marshal(violation)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding violation: %w", err)
+	}
+
+	timestamp := violation.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	severity, ok := syslogSeverities[violation.Severity()]
+	if !ok {
+		severity = syslogSeverities[dcgm.PolicySeverityWarning]
+	}
+	priority := s.cfg.Facility*8 + severity
+	header := fmt.Sprintf("<%d>1 %s %s %s %d %s - ", priority, timestamp.UTC().Format(syslogTimestamp),
+		headerField(s.cfg.Hostname, 255), headerField(s.cfg.AppName, 48), os.Getpid(), "policy")
+	return append([]byte(header), msg...), nil
+}
+
+// headerField makes a value a valid header field: printable ASCII without
+// spaces and at most maxLen characters, or the NILVALUE "-" when empty
+func headerField(value string, maxLen int) string {
+	value = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return "-"
+	}
+	if len(value) > maxLen {
+		value = value[:maxLen]
+	}
+	return value
+}
+
+// Close closes the connection to the syslog server
+func (s *Syslog) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	if err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
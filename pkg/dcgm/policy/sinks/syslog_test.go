@@ -0,0 +1,109 @@
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+var syslogMessage = regexp.MustCompile(`^<(26|27|28)>1 2023-11-14T22:13:20\.000000Z node-1 dcgm \d+ policy - \{"gpu":3,.*\}$`)
+
+func TestSyslogUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := NewSyslog(SyslogConfig{Network: "udp", Address: conn.LocalAddr().String(), Hostname: "node-1"})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Send(context.Background(), []dcgm.PolicyViolation{violation(3, dcgm.XidPolicy)}))
+
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Regexp(t, syslogMessage, string(buf[:n]))
+}
+
+func TestSyslogTCPFraming(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	messages := make(chan string, 2)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		for range 2 {
+			length, err := reader.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSuffix(length, " "))
+			if err != nil {
+				return
+			}
+			message := make([]byte, n)
+			if _, err := io.ReadFull(reader, message); err != nil {
+				return
+			}
+			messages <- string(message)
+		}
+	}()
+
+	sink, err := NewSyslog(SyslogConfig{Network: "tcp", Address: listener.Addr().String(), Hostname: "node-1"})
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Send(context.Background(), []dcgm.PolicyViolation{
+		violation(3, dcgm.XidPolicy),
+		violation(3, dcgm.DbePolicy),
+	}))
+	xid := <-messages
+	assert.Regexp(t, syslogMessage, xid)
+	assert.True(t, strings.HasPrefix(xid, "<27>"), "XID errors are errors")
+	dbe := <-messages
+	assert.Regexp(t, syslogMessage, dbe)
+	assert.True(t, strings.HasPrefix(dbe, "<26>"), "double-bit ECC errors are critical")
+}
+
+// rfc5424Header matches HEADER SP STRUCTURED-DATA SP of RFC 5424
+var rfc5424Header = regexp.MustCompile(`^<(\d{1,3})>1 ` +
+	`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d{1,6})?(Z|[+-]\d{2}:\d{2}) ` +
+	`[!-~]{1,255} [!-~]{1,48} [!-~]{1,128} [!-~]{1,32} (-|\[.*\]) `)
+
+func TestSyslogHeaderGrammar(t *testing.T) {
+	sink := &Syslog{cfg: SyslogConfig{Facility: FacilityLocal0, AppName: "dcgm", Hostname: "node-1"}}
+	v := violation(3, dcgm.ThermalPolicy)
+	v.Timestamp = time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.FixedZone("CET", 3600))
+
+	message, err := sink.format(v)
+	require.NoError(t, err)
+	header := rfc5424Header.FindStringSubmatch(string(message))
+	require.NotNil(t, header, string(message))
+	assert.Equal(t, "132", header[1])
+	assert.Equal(t, ".123456", header[2])
+	assert.Contains(t, string(message), " 2023-11-14T21:13:20.123456Z ")
+}
+
+func TestHeaderField(t *testing.T) {
+	assert.Equal(t, "-", headerField("", 10))
+	assert.Equal(t, "my_host", headerField("my host", 10))
+	assert.Equal(t, "abc", headerField("abcdef", 3))
+}
@@ -0,0 +1,114 @@
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+const (
+	// DefaultWebhookRetries is the number of times a failed webhook request is retried
+	DefaultWebhookRetries = 3
+	// DefaultWebhookBackoff is the wait before the first retry; it doubles on each retry
+	DefaultWebhookBackoff = 500 * time.Millisecond
+	// DefaultWebhookTimeout is the timeout of each webhook request
+	DefaultWebhookTimeout = 10 * time.Second
+)
+
+// WebhookConfig configures a Webhook
+type WebhookConfig struct {
+	// URL receives the batches as a JSON array in a POST request
+	URL string
+	// Headers are added to every request, e.g. for authorization
+	Headers map[string]string
+	// Retries is the number of retries of a failed request. Defaults to
+	// DefaultWebhookRetries; a negative value disables retries.
+	Retries int
+	// Backoff is the wait before the first retry. Defaults to DefaultWebhookBackoff.
+	Backoff time.Duration
+	// Client sends the requests. Defaults to a client with DefaultWebhookTimeout.
+	Client *http.Client
+}
+
+// Webhook posts batches of violations to an HTTP endpoint
+type Webhook struct {
+	cfg WebhookConfig
+}
+
+// NewWebhook returns a Webhook sink
+func NewWebhook(cfg WebhookConfig) *Webhook {
+	if cfg.Retries == 0 {
+		cfg.Retries = DefaultWebhookRetries
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = DefaultWebhookBackoff
+	}
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{Timeout: DefaultWebhookTimeout}
+	}
+	return &Webhook{cfg: cfg}
+}
+
+// Send posts the batch, retrying with exponential backoff on connection errors,
+// 5xx responses and 429 Too Many Requests
+func (w *Webhook) Send(ctx context.Context, violations []dcgm.PolicyViolation) error {
+	body, err := json.Marshal(violations)
+	if err != nil {
+		return fmt.Errorf("error encoding violations: %w", err)
+	}
+
+	backoff := w.cfg.Backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= w.cfg.Retries {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		}
+		backoff *= 2
+	}
+}
+
+// post sends one request and reports whether a failure may be retried
+func (w *Webhook) post(ctx context.Context, body []byte) (retry bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("error creating webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range w.cfg.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := w.cfg.Client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("error posting to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry = resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook returned %s", resp.Status)
+}
+
+// Close does nothing; a Webhook holds no resources
+func (w *Webhook) Close() error {
+	return nil
+}
@@ -0,0 +1,73 @@
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+func TestWebhookRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		var violations []json.RawMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&violations))
+		assert.Len(t, violations, 2)
+
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(WebhookConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer token"},
+		Backoff: time.Millisecond,
+	})
+	err := webhook.Send(context.Background(), []dcgm.PolicyViolation{
+		violation(0, dcgm.XidPolicy),
+		violation(1, dcgm.DbePolicy),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestWebhookDoesNotRetryClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(WebhookConfig{URL: server.URL, Backoff: time.Millisecond})
+	err := webhook.Send(context.Background(), []dcgm.PolicyViolation{violation(0, dcgm.XidPolicy)})
+	require.ErrorContains(t, err, "400 Bad Request")
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestWebhookGivesUp(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(WebhookConfig{URL: server.URL, Retries: 2, Backoff: time.Millisecond})
+	err := webhook.Send(context.Background(), []dcgm.PolicyViolation{violation(0, dcgm.XidPolicy)})
+	require.ErrorContains(t, err, "500 Internal Server Error")
+	assert.Equal(t, int32(3), requests.Load())
+}
@@ -3,6 +3,7 @@
 package dcgm
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
@@ -443,6 +444,52 @@ func TestPolicyViolationDropCount(t *testing.T) {
 	_ = receivePolicyViolation(t, ch)
 }
 
+func TestPolicyDispatcherRuleConditions(t *testing.T) {
+	previousCallbacks := policyCallbacks
+	dispatcher := newPolicyDispatcher()
+	policyCallbacks = dispatcher
+	t.Cleanup(func() {
+		policyCallbacks = previousCallbacks
+	})
+
+	const hot = PolicyCondition("GPU hot")
+	_, err := translateConditions([]PolicyCondition{hot})
+	require.Error(t, err)
+
+	require.NoError(t, dispatcher.addRules([]PolicyCondition{hot}))
+	require.Error(t, dispatcher.addRules([]PolicyCondition{hot}))
+
+	translated, err := translateConditions([]PolicyCondition{XidPolicy, hot})
+	require.NoError(t, err)
+	xidCondition, _ := policyConditionMask(XidPolicy)
+	assert.Equal(t, xidCondition, translated.condition)
+	assert.Equal(t, []PolicyCondition{hot}, translated.rules)
+
+	group := policyTestGroupHandle(1010)
+	other := policyTestGroupHandle(1011)
+
+	// Rule-only subscriptions need no DCGM registration
+	ctx, cancel := context.WithCancel(context.Background())
+	violations, err := WatchPolicyViolationsForGroup(ctx, group, hot)
+	require.NoError(t, err)
+	assert.Empty(t, dispatcher.registrations)
+
+	violation := PolicyViolation{GPU: 1, Condition: hot, Data: RulePolicyCondition{Values: []float64{95}}}
+	dispatcher.deliverGroup(other, violation)
+	dispatcher.deliverGroup(group, violation)
+	assert.Equal(t, violation, receivePolicyViolation(t, violations))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		_, open := <-violations
+		return !open
+	}, time.Second, 10*time.Millisecond)
+
+	dispatcher.removeRules([]PolicyCondition{hot})
+	_, err = translateConditions([]PolicyCondition{hot})
+	require.Error(t, err)
+}
+
 func policyTestGroupHandle(id uintptr) GroupHandle {
 	var group GroupHandle
 	group.SetHandle(id)
@@ -473,3 +520,168 @@ func assertNoPolicyViolation(t *testing.T, ch <-chan PolicyViolation) {
 	case <-time.After(50 * time.Millisecond):
 	}
 }
+
+func TestPolicyDispatcherEnrichesViolations(t *testing.T) {
+	dispatcher := newPolicyDispatcher()
+	group := policyTestGroupHandle(1010)
+	xidCondition, ok := policyConditionMask(XidPolicy)
+	require.True(t, ok)
+
+	dispatcher.setIdentities(map[uint]gpuIdentity{1: {uuid: "GPU-bbbb", serial: "1324"}})
+	_, ch, registration := dispatcher.addSubscription(group, xidCondition, 2)
+	require.NotNil(t, registration)
+
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 1, Condition: XidPolicy})
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 2, Condition: XidPolicy})
+
+	enriched := <-ch
+	assert.Equal(t, "GPU-bbbb", enriched.UUID)
+	assert.Equal(t, "1324", enriched.Serial)
+
+	unknown := <-ch
+	assert.Empty(t, unknown.UUID)
+	assert.Empty(t, unknown.Serial)
+}
+
+func TestPolicyDispatcherReadsUnknownIdentities(t *testing.T) {
+	dispatcher := newPolicyDispatcher()
+	lookups := make(chan []GroupEntityPair, 4)
+	dispatcher.identify = func(entities []GroupEntityPair) map[uint]gpuIdentity {
+		defer func() { lookups <- entities }()
+		if entities[0].EntityId == 4 {
+			return nil
+		}
+		return map[uint]gpuIdentity{3: {uuid: "GPU-cccc", serial: "5768"}}
+	}
+	group := policyTestGroupHandle(1011)
+	xidCondition, ok := policyConditionMask(XidPolicy)
+	require.True(t, ok)
+
+	_, ch, registration := dispatcher.addSubscription(group, xidCondition, 4)
+	require.NotNil(t, registration)
+
+	// Violations of unknown registrations do not read identities
+	dispatcher.deliver(registration.id+1, PolicyViolation{GPU: 3, Condition: XidPolicy})
+
+	// The identity is read in the background, so the first violation is
+	// delivered without it
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 3, Condition: XidPolicy})
+	assert.Empty(t, (<-ch).UUID)
+	assert.Equal(t, []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: 3}}, <-lookups)
+	require.Eventually(t, func() bool {
+		dispatcher.mu.Lock()
+		defer dispatcher.mu.Unlock()
+		return dispatcher.identities[3].uuid != ""
+	}, time.Second, time.Millisecond)
+
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 3, Condition: XidPolicy})
+	added := <-ch
+	assert.Equal(t, "GPU-cccc", added.UUID)
+	assert.Equal(t, "5768", added.Serial)
+
+	// A failed read is not repeated
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 4, Condition: XidPolicy})
+	assert.Equal(t, []GroupEntityPair{{EntityGroupId: FE_GPU, EntityId: 4}}, <-lookups)
+	dispatcher.deliver(registration.id, PolicyViolation{GPU: 4, Condition: XidPolicy})
+	for range 2 {
+		assert.Empty(t, (<-ch).UUID)
+	}
+	assert.Empty(t, lookups)
+}
+
+func TestNewGPUIdentities(t *testing.T) {
+	gpu0 := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 0}
+	gpu1 := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 1}
+	gpu2 := GroupEntityPair{EntityGroupId: FE_GPU, EntityId: 2}
+	values := entityValues{
+		entityTestValue(gpu0, DCGM_FI_DEV_GPU_UUID, DCGM_FT_STRING, append([]byte("GPU-aaaa"), 0)),
+		entityTestValue(gpu0, DCGM_FI_DEV_BOARD_SERIAL, DCGM_FT_STRING, append([]byte("1234"), 0)),
+		entityTestValue(gpu1, DCGM_FI_DEV_GPU_UUID, DCGM_FT_STRING, append([]byte("GPU-bbbb"), 0)),
+		entityTestValue(gpu1, DCGM_FI_DEV_BOARD_SERIAL, DCGM_FT_STRING, append([]byte("<<<NOT_SUPPORTED>>>"), 0)),
+	}
+
+	assert.Equal(t, map[uint]gpuIdentity{
+		0: {uuid: "GPU-aaaa", serial: "1234"},
+		1: {uuid: "GPU-bbbb"},
+	}, newGPUIdentities([]GroupEntityPair{gpu0, gpu1, gpu2}, values))
+}
+
+func TestReplayPolicyViolations(t *testing.T) {
+	previousCallbacks := policyCallbacks
+	dispatcher := newPolicyDispatcher()
+	policyCallbacks = dispatcher
+	t.Cleanup(func() {
+		policyCallbacks = previousCallbacks
+	})
+
+	group := policyTestGroupHandle(1012)
+	other := policyTestGroupHandle(1013)
+	xidCondition, ok := policyConditionMask(XidPolicy)
+	require.True(t, ok)
+
+	_, ch, registration := dispatcher.addSubscription(group, xidCondition, 4)
+	require.NotNil(t, registration)
+	_, otherCh, _ := dispatcher.addSubscription(other, xidCondition, 4)
+
+	xid := PolicyViolation{GPU: 0, Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: 79}}
+	ReplayPolicyViolations(group,
+		xid,
+		PolicyViolation{GPU: 0, Condition: DbePolicy, Data: DbePolicyCondition{NumErrors: 1}},
+		PolicyViolation{GPU: 0, Condition: PolicyCondition("not a rule")},
+	)
+
+	assert.Equal(t, xid, receivePolicyViolation(t, ch))
+	assertNoPolicyViolation(t, ch)
+	assertNoPolicyViolation(t, otherCh)
+}
+
+func TestPolicyDispatcherFilteredSubscription(t *testing.T) {
+	dispatcher := newPolicyDispatcher()
+	group := policyTestGroupHandle(1014)
+	xidCondition, ok := policyConditionMask(XidPolicy)
+	require.True(t, ok)
+
+	_, filtered, registration := dispatcher.addFilteredSubscription(group, xidCondition, 2,
+		&PolicyFilter{XIDExclude: []uint{43}})
+	require.NotNil(t, registration)
+	_, unfiltered, _ := dispatcher.addSubscription(group, xidCondition, 2)
+
+	appError := PolicyViolation{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: 43}}
+	offTheBus := PolicyViolation{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: 79}}
+	dispatcher.deliver(registration.id, appError)
+	dispatcher.deliver(registration.id, offTheBus)
+
+	assert.Equal(t, offTheBus, receivePolicyViolation(t, filtered))
+	assertNoPolicyViolation(t, filtered)
+	assert.Equal(t, appError, receivePolicyViolation(t, unfiltered))
+	assert.Equal(t, offTheBus, receivePolicyViolation(t, unfiltered))
+	assert.Zero(t, dispatcher.dropped())
+}
+
+func TestPolicyDispatcherFiltersCounterIncrease(t *testing.T) {
+	dispatcher := newPolicyDispatcher()
+	group := policyTestGroupHandle(1016)
+	nvlinkCondition, ok := policyConditionMask(NvlinkPolicy)
+	require.True(t, ok)
+
+	_, filtered, registration := dispatcher.addFilteredSubscription(group, nvlinkCondition, 4,
+		&PolicyFilter{MinNvlinkErrors: 5})
+	require.NotNil(t, registration)
+
+	violation := func(n uint) PolicyViolation {
+		return PolicyViolation{GPU: 2, Condition: NvlinkPolicy, Data: NvlinkPolicyCondition{Counter: n}}
+	}
+	for _, n := range []uint{100, 101, 103, 105, 106} {
+		dispatcher.deliver(registration.id, violation(n))
+	}
+
+	assert.Equal(t, violation(100), receivePolicyViolation(t, filtered))
+	assert.Equal(t, violation(105), receivePolicyViolation(t, filtered))
+	assertNoPolicyViolation(t, filtered)
+}
+
+func TestWatchPolicyViolationsWithInvalidFilter(t *testing.T) {
+	_, err := WatchPolicyViolationsWithFilter(context.Background(), policyTestGroupHandle(1015),
+		PolicyFilter{XIDInclude: []uint{43}, XIDExclude: []uint{43}}, XidPolicy)
+	require.ErrorContains(t, err, "both includes and excludes XID 43")
+}
@@ -0,0 +1,90 @@
+package dcgm
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PolicyFilter drops policy violations before they are delivered to a
+// listener. The PCIe, NVLink and DBE conditions of dcgmPolicy_t can only be
+// switched on or off and the XID condition fires for every XID, so their
+// thresholds are applied on the client side.
+//
+// The PCIe, NVLink and DBE violations report cumulative counters. Their
+// minimums apply to the increase of the counter of the GPU since the last
+// violation delivered to the listener, or since zero for the first one, so
+// that errors accumulated before a burst are not counted again.
+type PolicyFilter struct {
+	// XIDInclude delivers only the XID violations of these XIDs. An empty list
+	// delivers all XIDs.
+	XIDInclude []uint
+	// XIDExclude drops the XID violations of these XIDs, e.g. 43 for
+	// application errors that do not affect other applications
+	XIDExclude []uint
+	// MinPCIeReplays drops the PCIe violations reporting fewer new replays
+	MinPCIeReplays uint
+	// MinNvlinkErrors drops the NVLink violations reporting fewer new errors
+	MinNvlinkErrors uint
+	// MinDBEErrors drops the double-bit ECC violations reporting fewer new
+	// errors
+	MinDBEErrors uint
+}
+
+// policyCounterKey identifies the cumulative counter of a GPU reported by the
+// violations of a condition
+type policyCounterKey struct {
+	gpu       uint
+	condition PolicyCondition
+}
+
+// validate rejects XIDs that are both included and excluded
+func (f PolicyFilter) validate() error {
+	for _, xid := range f.XIDExclude {
+		if slices.Contains(f.XIDInclude, xid) {
+			return fmt.Errorf("policy filter both includes and excludes XID %d", xid)
+		}
+	}
+	return nil
+}
+
+// clone copies the filter for the dispatcher, which reads it from the DCGM
+// callback thread, so that the caller may reuse its XID slices
+func (f PolicyFilter) clone() *PolicyFilter {
+	f.XIDInclude = slices.Clone(f.XIDInclude)
+	f.XIDExclude = slices.Clone(f.XIDExclude)
+	return &f
+}
+
+// allows reports whether a violation passes the filter. delivered holds the
+// counters of the violations that passed before and is updated when a
+// counter violation passes. Violations of other conditions always pass.
+func (f PolicyFilter) allows(violation PolicyViolation, delivered map[policyCounterKey]uint) bool {
+	var counter, minimum uint
+	switch data := violation.Data.(type) {
+	case XidPolicyCondition:
+		if len(f.XIDInclude) > 0 && !slices.Contains(f.XIDInclude, data.ErrNum) {
+			return false
+		}
+		return !slices.Contains(f.XIDExclude, data.ErrNum)
+	case PciPolicyCondition:
+		counter, minimum = data.ReplayCounter, f.MinPCIeReplays
+	case NvlinkPolicyCondition:
+		counter, minimum = data.Counter, f.MinNvlinkErrors
+	case DbePolicyCondition:
+		counter, minimum = data.NumErrors, f.MinDBEErrors
+	default:
+		return true
+	}
+
+	key := policyCounterKey{gpu: violation.GPU, condition: violation.Condition}
+	// A counter lower than the last delivered one was reset and counts from zero
+	increase := counter
+	if previous := delivered[key]; counter >= previous {
+		increase = counter - previous
+	}
+	if increase < minimum {
+		return false
+	}
+	delivered[key] = counter
+	return true
+}
@@ -0,0 +1,91 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyFilterAllows(t *testing.T) {
+	xid := func(n uint) PolicyViolation {
+		return PolicyViolation{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: n}}
+	}
+
+	tests := []struct {
+		name      string
+		filter    PolicyFilter
+		violation PolicyViolation
+		expected  bool
+	}{
+		{"empty filter", PolicyFilter{}, xid(43), true},
+		{"excluded XID", PolicyFilter{XIDExclude: []uint{43}}, xid(43), false},
+		{"other XID", PolicyFilter{XIDExclude: []uint{43}}, xid(79), true},
+		{"included XID", PolicyFilter{XIDInclude: []uint{48, 79}}, xid(79), true},
+		{"not included XID", PolicyFilter{XIDInclude: []uint{48, 79}}, xid(13), false},
+		{
+			"few PCIe replays", PolicyFilter{MinPCIeReplays: 10},
+			PolicyViolation{Condition: PCIePolicy, Data: PciPolicyCondition{ReplayCounter: 9}}, false,
+		},
+		{
+			"enough PCIe replays", PolicyFilter{MinPCIeReplays: 10},
+			PolicyViolation{Condition: PCIePolicy, Data: PciPolicyCondition{ReplayCounter: 10}}, true,
+		},
+		{
+			"few NVLink errors", PolicyFilter{MinNvlinkErrors: 5},
+			PolicyViolation{Condition: NvlinkPolicy, Data: NvlinkPolicyCondition{Counter: 1}}, false,
+		},
+		{
+			"few DBE errors", PolicyFilter{MinDBEErrors: 2},
+			PolicyViolation{Condition: DbePolicy, Data: DbePolicyCondition{NumErrors: 1}}, false,
+		},
+		{
+			"other condition", PolicyFilter{XIDInclude: []uint{79}, MinPCIeReplays: 10},
+			PolicyViolation{Condition: ThermalPolicy, Data: ThermalPolicyCondition{ThermalViolation: 101}}, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.allows(tt.violation, make(map[policyCounterKey]uint)))
+		})
+	}
+}
+
+func TestPolicyFilterCounterIncrease(t *testing.T) {
+	replays := func(gpu, n uint) PolicyViolation {
+		return PolicyViolation{GPU: gpu, Condition: PCIePolicy, Data: PciPolicyCondition{ReplayCounter: n}}
+	}
+	filter := PolicyFilter{MinPCIeReplays: 10, MinDBEErrors: 1}
+	delivered := make(map[policyCounterKey]uint)
+
+	assert.True(t, filter.allows(replays(0, 12), delivered), "the first violation counts from zero")
+	assert.False(t, filter.allows(replays(0, 13), delivered), "one new replay")
+	assert.False(t, filter.allows(replays(0, 20), delivered), "eight new replays")
+	assert.True(t, filter.allows(replays(0, 22), delivered), "ten replays since the last delivery")
+	assert.False(t, filter.allows(replays(0, 23), delivered))
+	assert.True(t, filter.allows(replays(1, 10), delivered), "GPUs are counted separately")
+	assert.False(t, filter.allows(replays(0, 4), delivered), "a reset counts from zero")
+	assert.True(t, filter.allows(replays(0, 11), delivered))
+
+	dbe := PolicyViolation{GPU: 0, Condition: DbePolicy, Data: DbePolicyCondition{NumErrors: 1}}
+	assert.True(t, filter.allows(dbe, delivered), "conditions are counted separately")
+	assert.False(t, filter.allows(dbe, delivered), "no new error")
+}
+
+func TestPolicyFilterValidate(t *testing.T) {
+	require.NoError(t, PolicyFilter{XIDInclude: []uint{79}, XIDExclude: []uint{43}}.validate())
+	require.EqualError(t, PolicyFilter{XIDInclude: []uint{43}, XIDExclude: []uint{43}}.validate(),
+		"policy filter both includes and excludes XID 43")
+}
+
+func TestPolicyFilterClone(t *testing.T) {
+	include := []uint{79}
+	filter := PolicyFilter{XIDInclude: include, XIDExclude: []uint{43}, MinDBEErrors: 2}
+	cloned := filter.clone()
+	assert.Equal(t, filter, *cloned)
+
+	include[0] = 48
+	filter.XIDExclude[0] = 13
+	assert.Equal(t, []uint{79}, cloned.XIDInclude)
+	assert.Equal(t, []uint{43}, cloned.XIDExclude)
+}
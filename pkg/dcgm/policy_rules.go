@@ -0,0 +1,382 @@
+package dcgm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// RuleOperator compares a field value against the threshold of a rule clause
+type RuleOperator int
+
+const (
+	// RuleAbove holds when the value is greater than the threshold
+	RuleAbove RuleOperator = iota
+	// RuleBelow holds when the value is less than the threshold
+	RuleBelow
+	// RuleEqual holds when the value equals the threshold
+	RuleEqual
+	// RuleNotEqual holds when the value differs from the threshold
+	RuleNotEqual
+)
+
+// String returns the comparison symbol of the operator
+func (o RuleOperator) String() string {
+	switch o {
+	case RuleAbove:
+		return ">"
+	case RuleBelow:
+		return "<"
+	case RuleEqual:
+		return "=="
+	case RuleNotEqual:
+		return "!="
+	default:
+		return fmt.Sprintf("RuleOperator(%d)", int(o))
+	}
+}
+
+func (o RuleOperator) holds(value, threshold float64) bool {
+	switch o {
+	case RuleAbove:
+		return value > threshold
+	case RuleBelow:
+		return value < threshold
+	case RuleEqual:
+		return value == threshold
+	case RuleNotEqual:
+		return value != threshold
+	}
+	return false
+}
+
+// RuleClause compares one field of a GPU against a threshold
+type RuleClause struct {
+	// Field is the field to compare
+	Field Short
+	// Operator is the comparison to apply
+	Operator RuleOperator
+	// Threshold is the value the field is compared against
+	Threshold float64
+	// Rate compares the per-second rate of change of the field between two
+	// samples instead of its value, e.g. for error counters
+	Rate bool
+}
+
+// PolicyRule is a policy condition evaluated by the Go side over watched field
+// values rather than by nv-hostengine. A rule is violated on a GPU when all of
+// its clauses have held for at least For.
+//
+// For example, an SM clock below 900 MHz for a minute while utilization is
+// above 80%:
+//
+//	dcgm.PolicyRule{
+//		Condition: "SM clock throttled",
+//		When: []dcgm.RuleClause{
+//			{Field: dcgm.DCGM_FI_DEV_SM_CLOCK_HERTZ, Operator: dcgm.RuleBelow, Threshold: 900e6},
+//			{Field: dcgm.DCGM_FI_DEV_GPU_UTIL_RATIO, Operator: dcgm.RuleAbove, Threshold: 0.8},
+//		},
+//		For: time.Minute,
+//	}
+type PolicyRule struct {
+	// Condition names the rule in the violations it reports. It must differ from
+	// the hardware policy conditions.
+	Condition PolicyCondition
+	// When lists the clauses that must all hold
+	When []RuleClause
+	// For is how long the clauses must hold before the rule is violated. Zero
+	// reports a violation on the first sample where they hold.
+	For time.Duration
+}
+
+// RulePolicyCondition contains details about a PolicyRule violation
+type RulePolicyCondition struct {
+	// Values are the values compared by each clause of the rule, in order.
+	// Rate clauses report the rate per second.
+	Values []float64 `json:"values"`
+	// Since is when the clauses started to hold
+	Since time.Time `json:"since"`
+}
+
+// validate checks the rule is well-formed
+func (r PolicyRule) validate() error {
+	if r.Condition == "" {
+		return errors.New("policy rule must have a condition name")
+	}
+	if _, ok := policyConditionInfo(r.Condition); ok {
+		return fmt.Errorf("policy rule %q uses the name of a hardware policy condition", r.Condition)
+	}
+	if len(r.When) == 0 {
+		return fmt.Errorf("policy rule %q has no clauses", r.Condition)
+	}
+	if r.For < 0 {
+		return fmt.Errorf("policy rule %q has a negative duration", r.Condition)
+	}
+	for _, clause := range r.When {
+		info, ok := GetFieldInfo(clause.Field)
+		if !ok {
+			return fmt.Errorf("policy rule %q: unknown field %d", r.Condition, clause.Field)
+		}
+		if info.Kind == FieldKindInfo {
+			return fmt.Errorf("policy rule %q: field %s is not numeric", r.Condition, info.Name)
+		}
+		if clause.Operator < RuleAbove || clause.Operator > RuleNotEqual {
+			return fmt.Errorf("policy rule %q: invalid operator %v", r.Condition, clause.Operator)
+		}
+	}
+	return nil
+}
+
+// ruleSample is the last value of a field seen for a GPU
+type ruleSample struct {
+	value     float64
+	timestamp time.Time
+	// rate is the per-second change from the sample before, valid when hasRate is set
+	rate    float64
+	hasRate bool
+}
+
+type ruleSampleKey struct {
+	gpu   uint
+	field Short
+}
+
+type ruleStateKey struct {
+	rule int
+	gpu  uint
+}
+
+// ruleState tracks how long the clauses of a rule have held for a GPU
+type ruleState struct {
+	since    time.Time
+	violated bool
+}
+
+// ruleEvaluator turns successive field values into rule violations
+type ruleEvaluator struct {
+	rules   []PolicyRule
+	samples map[ruleSampleKey]ruleSample
+	states  map[ruleStateKey]ruleState
+}
+
+func newRuleEvaluator(rules []PolicyRule) *ruleEvaluator {
+	return &ruleEvaluator{
+		rules:   rules,
+		samples: make(map[ruleSampleKey]ruleSample),
+		states:  make(map[ruleStateKey]ruleState),
+	}
+}
+
+// fields returns the fields the rules read, without duplicates
+func (e *ruleEvaluator) fields() []Short {
+	var fields []Short
+	for _, rule := range e.rules {
+		for _, clause := range rule.When {
+			if !slices.Contains(fields, clause.Field) {
+				fields = append(fields, clause.Field)
+			}
+		}
+	}
+	return fields
+}
+
+// update records the latest GPU field values and returns the rules that became
+// violated. A rule is reported once per GPU until its clauses stop holding.
+func (e *ruleEvaluator) update(values []TypedValue) []PolicyViolation {
+	gpus := make(map[uint]time.Time)
+	for _, value := range values {
+		if value.EntityGroupId != FE_GPU {
+			continue
+		}
+		key := ruleSampleKey{gpu: value.EntityID, field: value.FieldID}
+		if n, ok := value.Number(); ok {
+			e.record(key, n, value.Timestamp)
+		} else {
+			// Blank or unsupported values stop the clauses reading them from holding
+			delete(e.samples, key)
+		}
+		gpus[value.EntityID] = laterTime(gpus[value.EntityID], value.Timestamp)
+	}
+
+	ids := make([]uint, 0, len(gpus))
+	for gpu := range gpus {
+		ids = append(ids, gpu)
+	}
+	slices.Sort(ids)
+
+	var violations []PolicyViolation
+	for _, gpu := range ids {
+		now := gpus[gpu]
+		for i, rule := range e.rules {
+			key := ruleStateKey{rule: i, gpu: gpu}
+			compared, holds := e.evaluate(rule, gpu)
+			if !holds {
+				delete(e.states, key)
+				continue
+			}
+
+			state, ok := e.states[key]
+			if !ok {
+				state.since = now
+			}
+			if !state.violated && now.Sub(state.since) >= rule.For {
+				state.violated = true
+				violations = append(violations, PolicyViolation{
+					GPU:       gpu,
+					Condition: rule.Condition,
+					Timestamp: now,
+					Data:      RulePolicyCondition{Values: compared, Since: state.since},
+				})
+			}
+			e.states[key] = state
+		}
+	}
+	return violations
+}
+
+// record stores a sample, deriving the rate when the timestamp advanced
+func (e *ruleEvaluator) record(key ruleSampleKey, value float64, timestamp time.Time) {
+	previous, ok := e.samples[key]
+	if ok && !timestamp.After(previous.timestamp) {
+		return
+	}
+	sample := ruleSample{value: value, timestamp: timestamp}
+	if ok {
+		sample.rate = (value - previous.value) / timestamp.Sub(previous.timestamp).Seconds()
+		sample.hasRate = true
+	}
+	e.samples[key] = sample
+}
+
+// evaluate returns the values compared by the clauses of the rule and whether they all hold
+func (e *ruleEvaluator) evaluate(rule PolicyRule, gpu uint) ([]float64, bool) {
+	compared := make([]float64, len(rule.When))
+	for i, clause := range rule.When {
+		sample, ok := e.samples[ruleSampleKey{gpu: gpu, field: clause.Field}]
+		if !ok {
+			return nil, false
+		}
+		value := sample.value
+		if clause.Rate {
+			if !sample.hasRate {
+				return nil, false
+			}
+			value = sample.rate
+		}
+		if !clause.Operator.holds(value, clause.Threshold) {
+			return nil, false
+		}
+		compared[i] = value
+	}
+	return compared, true
+}
+
+func laterTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// StartPolicyRules evaluates the rules over the GPUs of the group every interval
+// until the context is canceled. Violations are delivered on the channels of
+// WatchPolicyViolationsForGroup and ListenForPolicyViolationsForGroup for the same
+// group, subscribed with the rule's Condition; subscriptions must be made after
+// the rules are started.
+//
+// The fields read by the rules are watched on the group at the same interval.
+func StartPolicyRules(ctx context.Context, group GroupHandle, interval time.Duration, rules ...PolicyRule) error {
+	if ctx == nil {
+		return errors.New("context must not be nil")
+	}
+	if interval <= 0 {
+		return errors.New("policy rule interval must be positive")
+	}
+	if len(rules) == 0 {
+		return errors.New("at least one policy rule must be provided")
+	}
+
+	conditions := make([]PolicyCondition, len(rules))
+	for i, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return err
+		}
+		conditions[i] = rule.Condition
+	}
+
+	groupInfo, err := GetGroupInfo(group)
+	if err != nil {
+		return fmt.Errorf("error getting group info: %w", err)
+	}
+	var gpus []GroupEntityPair
+	for _, entity := range groupInfo.EntityList {
+		if entity.EntityGroupId == FE_GPU {
+			gpus = append(gpus, entity)
+		}
+	}
+	if len(gpus) == 0 {
+		return errors.New("cannot evaluate policy rules for a group with no GPUs")
+	}
+
+	evaluator := newRuleEvaluator(slices.Clone(rules))
+	fields := evaluator.fields()
+
+	if err = policyCallbacks.addRules(conditions); err != nil {
+		return err
+	}
+	policyCallbacks.setIdentities(gpuIdentities(gpus))
+
+	fieldGroup, err := FieldGroupCreate(fmt.Sprintf("policyrules%d", rand.Uint64()), fields)
+	if err != nil {
+		policyCallbacks.removeRules(conditions)
+		return err
+	}
+	// Rate clauses need the previous sample, so keep two
+	if err = WatchFieldsWithGroupEx(fieldGroup, group, interval.Microseconds(), 0, 2); err != nil {
+		_ = FieldGroupDestroy(fieldGroup)
+		policyCallbacks.removeRules(conditions)
+		return err
+	}
+
+	go func() {
+		defer func() {
+			policyCallbacks.removeRules(conditions)
+			if err := UnwatchFields(fieldGroup, group); err != nil {
+				log.Printf("error unwatching policy rule fields: %v", err)
+			}
+			if err := FieldGroupDestroy(fieldGroup); err != nil {
+				log.Printf("error destroying policy rule field group: %v", err)
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			values, err := EntitiesGetLatestValues(gpus, fields, 0)
+			if err != nil {
+				log.Printf("error reading policy rule fields: %v", err)
+			} else {
+				typed := make([]TypedValue, len(values))
+				for i, value := range values {
+					typed[i] = value.TypedValue()
+				}
+				for _, violation := range evaluator.update(typed) {
+					policyCallbacks.deliverGroup(group, violation)
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
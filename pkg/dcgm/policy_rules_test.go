@@ -0,0 +1,133 @@
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ruleTestValue(gpu uint, field Short, value float64, ts time.Time) TypedValue {
+	return TypedValue{
+		FieldID:       field,
+		Type:          DCGM_FT_DOUBLE,
+		EntityGroupId: FE_GPU,
+		EntityID:      gpu,
+		State:         ValueStateOK,
+		Timestamp:     ts,
+		floatValue:    value,
+	}
+}
+
+func TestRuleEvaluatorDuration(t *testing.T) {
+	rule := PolicyRule{
+		Condition: "SM clock throttled",
+		When: []RuleClause{
+			{Field: DCGM_FI_DEV_SM_CLOCK_HERTZ, Operator: RuleBelow, Threshold: 900e6},
+			{Field: DCGM_FI_DEV_GPU_UTIL_RATIO, Operator: RuleAbove, Threshold: 0.8},
+		},
+		For: time.Minute,
+	}
+	require.NoError(t, rule.validate())
+
+	evaluator := newRuleEvaluator([]PolicyRule{rule})
+	assert.Equal(t, []Short{DCGM_FI_DEV_SM_CLOCK_HERTZ, DCGM_FI_DEV_GPU_UTIL_RATIO}, evaluator.fields())
+
+	start := time.Unix(1000, 0)
+	sample := func(offset time.Duration, clock, util float64) []PolicyViolation {
+		ts := start.Add(offset)
+		return evaluator.update([]TypedValue{
+			ruleTestValue(0, DCGM_FI_DEV_SM_CLOCK_HERTZ, clock*1e6, ts),
+			ruleTestValue(0, DCGM_FI_DEV_GPU_UTIL_RATIO, util/100, ts),
+		})
+	}
+
+	assert.Empty(t, sample(0, 800, 90))
+	assert.Empty(t, sample(30*time.Second, 800, 90))
+	violations := sample(time.Minute, 850, 95)
+	require.Len(t, violations, 1)
+	assert.Equal(t, uint(0), violations[0].GPU)
+	assert.Equal(t, rule.Condition, violations[0].Condition)
+	assert.Equal(t, start.Add(time.Minute), violations[0].Timestamp)
+	assert.Equal(t, RulePolicyCondition{Values: []float64{850e6, 0.95}, Since: start}, violations[0].Data)
+
+	// Reported once while the clauses keep holding
+	assert.Empty(t, sample(90*time.Second, 800, 90))
+
+	// Utilization dropping resets the duration
+	assert.Empty(t, sample(2*time.Minute, 800, 50))
+	assert.Empty(t, sample(150*time.Second, 800, 90))
+	assert.Empty(t, sample(200*time.Second, 800, 90))
+	assert.Len(t, sample(210*time.Second, 800, 90), 1)
+}
+
+func TestRuleEvaluatorRate(t *testing.T) {
+	evaluator := newRuleEvaluator([]PolicyRule{{
+		Condition: "PCIe replays",
+		When:      []RuleClause{{Field: DCGM_FI_DEV_PCIE_REPLAY_TOTAL, Operator: RuleAbove, Threshold: 1, Rate: true}},
+	}})
+
+	start := time.Unix(1000, 0)
+	update := func(gpu uint, offset time.Duration, count float64) []PolicyViolation {
+		return evaluator.update([]TypedValue{ruleTestValue(gpu, DCGM_FI_DEV_PCIE_REPLAY_TOTAL, count, start.Add(offset))})
+	}
+
+	// The first sample has no rate
+	assert.Empty(t, update(1, 0, 100))
+	assert.Empty(t, update(1, 10*time.Second, 105))
+	// A repeated sample keeps the previous rate
+	assert.Empty(t, update(1, 10*time.Second, 105))
+	violations := update(1, 20*time.Second, 125)
+	require.Len(t, violations, 1)
+	assert.Equal(t, uint(1), violations[0].GPU)
+	assert.Equal(t, []float64{2}, violations[0].Data.(RulePolicyCondition).Values)
+
+	// Other GPUs are tracked separately
+	assert.Empty(t, update(2, 20*time.Second, 500))
+}
+
+func TestRuleEvaluatorInvalidValues(t *testing.T) {
+	evaluator := newRuleEvaluator([]PolicyRule{{
+		Condition: "row remap pending",
+		When:      []RuleClause{{Field: DCGM_FI_DEV_ROW_REMAP_PENDING, Operator: RuleNotEqual, Threshold: 0}},
+	}})
+
+	start := time.Unix(1000, 0)
+	blank := ruleTestValue(0, DCGM_FI_DEV_ROW_REMAP_PENDING, 0, start)
+	blank.State = ValueStateBlank
+	assert.Empty(t, evaluator.update([]TypedValue{blank}))
+
+	assert.Len(t, evaluator.update([]TypedValue{ruleTestValue(0, DCGM_FI_DEV_ROW_REMAP_PENDING, 1, start)}), 1)
+
+	blank.Timestamp = start.Add(time.Second)
+	assert.Empty(t, evaluator.update([]TypedValue{blank}))
+	// The rule was reset by the blank value and is reported again
+	assert.Len(t, evaluator.update([]TypedValue{ruleTestValue(0, DCGM_FI_DEV_ROW_REMAP_PENDING, 1, start.Add(2*time.Second))}), 1)
+}
+
+func TestPolicyRuleValidate(t *testing.T) {
+	clause := RuleClause{Field: DCGM_FI_DEV_GPU_TEMP_CELSIUS, Operator: RuleAbove, Threshold: 90}
+
+	tests := []struct {
+		rule PolicyRule
+		err  string
+	}{
+		{PolicyRule{When: []RuleClause{clause}}, "condition name"},
+		{PolicyRule{Condition: ThermalPolicy, When: []RuleClause{clause}}, "hardware policy condition"},
+		{PolicyRule{Condition: "hot"}, "no clauses"},
+		{PolicyRule{Condition: "hot", When: []RuleClause{clause}, For: -time.Second}, "negative duration"},
+		{PolicyRule{Condition: "hot", When: []RuleClause{{Field: 65000}}}, "unknown field"},
+		{PolicyRule{Condition: "hot", When: []RuleClause{{Field: DCGM_FI_DEV_UUID}}}, "not numeric"},
+		{PolicyRule{Condition: "hot", When: []RuleClause{{Field: DCGM_FI_DEV_GPU_TEMP_CELSIUS, Operator: 9}}}, "invalid operator"},
+	}
+
+	for _, tt := range tests {
+		err := tt.rule.validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tt.err)
+	}
+
+	assert.NoError(t, PolicyRule{Condition: "hot", When: []RuleClause{clause}}.validate())
+	assert.Equal(t, "<", RuleBelow.String())
+}
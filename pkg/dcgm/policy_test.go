@@ -283,6 +283,44 @@ func TestPolicyErrors(t *testing.T) {
 	}
 }
 
+func TestInjectPolicyViolation(t *testing.T) {
+	cleanup, err := Init(Embedded)
+	require.NoError(t, err)
+	defer cleanup()
+
+	fakeGPUs, err := withInjectionGPUs(t, 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, fakeGPUs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	violations, err := ListenForPolicyViolations(ctx, ThermalPolicy, XidPolicy)
+	require.NoError(t, err)
+
+	require.NoError(t, InjectPolicyViolation(fakeGPUs[0], ThermalPolicy, DefaultMaxTemperature+5))
+	require.NoError(t, InjectPolicyViolation(fakeGPUs[0], XidPolicy, 79))
+	require.Error(t, InjectPolicyViolation(fakeGPUs[0], PolicyCondition("unknown"), 1))
+
+	received := make(map[PolicyCondition]PolicyViolation)
+	for len(received) < 2 {
+		select {
+		case violation := <-violations:
+			received[violation.Condition] = violation
+		case <-time.After(20 * time.Second):
+			require.FailNowf(t, "policy callback never happened", "received %v", received)
+		}
+	}
+
+	thermal, ok := received[ThermalPolicy].Thermal()
+	require.True(t, ok)
+	assert.Equal(t, uint(DefaultMaxTemperature+5), thermal.ThermalViolation)
+	xid, ok := received[XidPolicy].Xid()
+	require.True(t, ok)
+	assert.Equal(t, uint(79), xid.ErrNum)
+	assert.Equal(t, XIDActionDrainNode, xid.Info.Action)
+}
+
 func injectCounterIncrease(gpu uint, fieldID Short) error {
 	timestamp := time.Now().Add(time.Minute)
 	if err := InjectFieldValue(gpu, fieldID, DCGM_FT_INT64, 0, timestamp.UnixMicro(), int64(0)); err != nil {
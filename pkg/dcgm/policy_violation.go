@@ -0,0 +1,300 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// PolicySeverity classifies how urgently a policy violation needs attention
+type PolicySeverity int
+
+const (
+	// PolicySeverityWarning is a condition to monitor, e.g. a thermal or power limit
+	PolicySeverityWarning PolicySeverity = iota + 1
+	// PolicySeverityError is an error that may need the GPU to be drained
+	PolicySeverityError
+	// PolicySeverityCritical is an uncorrectable error that needs the GPU to be reset
+	PolicySeverityCritical
+)
+
+var policySeverityNames = map[PolicySeverity]string{
+	PolicySeverityWarning:  "warning",
+	PolicySeverityError:    "error",
+	PolicySeverityCritical: "critical",
+}
+
+// String returns "warning", "error" or "critical"
+func (s PolicySeverity) String() string {
+	if name, ok := policySeverityNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("PolicySeverity(%d)", int(s))
+}
+
+// MarshalText encodes the severity as its name
+func (s PolicySeverity) MarshalText() ([]byte, error) {
+	name, ok := policySeverityNames[s]
+	if !ok {
+		return nil, fmt.Errorf("invalid policy severity %d", int(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a severity name
+func (s *PolicySeverity) UnmarshalText(text []byte) error {
+	for severity, name := range policySeverityNames {
+		if name == string(text) {
+			*s = severity
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid policy severity %q", text)
+}
+
+// policyViolationTypes are the JSON discriminators of the hardware conditions
+var policyViolationTypes = map[PolicyCondition]string{
+	DbePolicy:     "dbe",
+	PCIePolicy:    "pcie",
+	MaxRtPgPolicy: "retired_pages",
+	ThermalPolicy: "thermal",
+	PowerPolicy:   "power",
+	NvlinkPolicy:  "nvlink",
+	XidPolicy:     "xid",
+}
+
+// policyViolationTypeRule is the JSON discriminator of the conditions of policy rules
+const policyViolationTypeRule = "rule"
+
+// Severity returns the severity of violations of the condition. Conditions of
+// policy rules are warnings.
+func (c PolicyCondition) Severity() PolicySeverity {
+	switch c {
+	case DbePolicy:
+		return PolicySeverityCritical
+	case MaxRtPgPolicy, NvlinkPolicy, XidPolicy:
+		return PolicySeverityError
+	default:
+		return PolicySeverityWarning
+	}
+}
+
+// Severity returns the severity of the violated condition. The severity of an
+// XID known to LookupXID follows its recommended action instead.
+func (v PolicyViolation) Severity() PolicySeverity {
+	if xid, ok := v.Xid(); ok {
+		if info, known := LookupXID(xid.ErrNum); known {
+			return info.Severity()
+		}
+	}
+	return v.Condition.Severity()
+}
+
+// Dbe returns the details of a DbePolicy violation
+func (v PolicyViolation) Dbe() (DbePolicyCondition, bool) {
+	data, ok := v.Data.(DbePolicyCondition)
+	return data, ok
+}
+
+// Pci returns the details of a PCIePolicy violation
+func (v PolicyViolation) Pci() (PciPolicyCondition, bool) {
+	data, ok := v.Data.(PciPolicyCondition)
+	return data, ok
+}
+
+// RetiredPages returns the details of a MaxRtPgPolicy violation
+func (v PolicyViolation) RetiredPages() (RetiredPagesPolicyCondition, bool) {
+	data, ok := v.Data.(RetiredPagesPolicyCondition)
+	return data, ok
+}
+
+// Thermal returns the details of a ThermalPolicy violation
+func (v PolicyViolation) Thermal() (ThermalPolicyCondition, bool) {
+	data, ok := v.Data.(ThermalPolicyCondition)
+	return data, ok
+}
+
+// Power returns the details of a PowerPolicy violation
+func (v PolicyViolation) Power() (PowerPolicyCondition, bool) {
+	data, ok := v.Data.(PowerPolicyCondition)
+	return data, ok
+}
+
+// Nvlink returns the details of a NvlinkPolicy violation
+func (v PolicyViolation) Nvlink() (NvlinkPolicyCondition, bool) {
+	data, ok := v.Data.(NvlinkPolicyCondition)
+	return data, ok
+}
+
+// Xid returns the details of a XidPolicy violation
+func (v PolicyViolation) Xid() (XidPolicyCondition, bool) {
+	data, ok := v.Data.(XidPolicyCondition)
+	return data, ok
+}
+
+// Rule returns the details of a violation of a policy rule
+func (v PolicyViolation) Rule() (RulePolicyCondition, bool) {
+	data, ok := v.Data.(RulePolicyCondition)
+	return data, ok
+}
+
+type policyViolationJSON struct {
+	GPU       uint            `json:"gpu"`
+	UUID      string          `json:"uuid,omitempty"`
+	Serial    string          `json:"serial,omitempty"`
+	Type      string          `json:"type"`
+	Condition PolicyCondition `json:"condition"`
+	Severity  PolicySeverity  `json:"severity"`
+	Timestamp time.Time       `json:"timestamp"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+// MarshalJSON encodes the violation with a "type" discriminator naming the
+// condition ("dbe", "pcie", "retired_pages", "thermal", "power", "nvlink",
+// "xid" or "rule") and the details of the condition in "data":
+//
+//	{"gpu":0,"uuid":"GPU-...","type":"xid","condition":"XID Error","severity":"error",
+//	 "timestamp":"2024-01-02T03:04:05Z","data":{"err_num":79}}
+func (v PolicyViolation) MarshalJSON() ([]byte, error) {
+	encoded := policyViolationJSON{
+		GPU:       v.GPU,
+		UUID:      v.UUID,
+		Serial:    v.Serial,
+		Type:      policyViolationType(v.Condition),
+		Condition: v.Condition,
+		Severity:  v.Severity(),
+		Timestamp: v.Timestamp,
+	}
+	if v.Data != nil {
+		data, err := json.Marshal(v.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding %s violation data: %w", encoded.Type, err)
+		}
+		encoded.Data = data
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes a violation encoded by MarshalJSON, restoring Data to
+// the details type of the condition
+func (v *PolicyViolation) UnmarshalJSON(b []byte) error {
+	var decoded policyViolationJSON
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		return err
+	}
+
+	violation := PolicyViolation{
+		GPU:       decoded.GPU,
+		UUID:      decoded.UUID,
+		Serial:    decoded.Serial,
+		Condition: decoded.Condition,
+		Timestamp: decoded.Timestamp,
+	}
+	if len(decoded.Data) > 0 && string(decoded.Data) != "null" {
+		var err error
+		violation.Data, err = decodePolicyViolationData(decoded.Type, decoded.Data)
+		if err != nil {
+			return err
+		}
+	}
+	*v = violation
+	return nil
+}
+
+func policyViolationType(condition PolicyCondition) string {
+	if typ, ok := policyViolationTypes[condition]; ok {
+		return typ
+	}
+	return policyViolationTypeRule
+}
+
+func decodePolicyViolationData(typ string, data json.RawMessage) (any, error) {
+	switch typ {
+	case "dbe":
+		return decodeViolationData[DbePolicyCondition](typ, data)
+	case "pcie":
+		return decodeViolationData[PciPolicyCondition](typ, data)
+	case "retired_pages":
+		return decodeViolationData[RetiredPagesPolicyCondition](typ, data)
+	case "thermal":
+		return decodeViolationData[ThermalPolicyCondition](typ, data)
+	case "power":
+		return decodeViolationData[PowerPolicyCondition](typ, data)
+	case "nvlink":
+		return decodeViolationData[NvlinkPolicyCondition](typ, data)
+	case "xid":
+		return decodeViolationData[XidPolicyCondition](typ, data)
+	case policyViolationTypeRule:
+		return decodeViolationData[RulePolicyCondition](typ, data)
+	default:
+		return nil, fmt.Errorf("unknown policy violation type %q", typ)
+	}
+}
+
+func decodeViolationData[T any](typ string, data json.RawMessage) (any, error) {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("error decoding %s violation data: %w", typ, err)
+	}
+	return value, nil
+}
+
+// gpuIdentity is the UUID and serial number added to the violations of a GPU
+type gpuIdentity struct {
+	uuid   string
+	serial string
+}
+
+// groupGPUIdentities looks up the identities of the GPUs of a group
+func groupGPUIdentities(groupID GroupHandle) map[uint]gpuIdentity {
+	info, err := GetGroupInfo(groupID)
+	if err != nil {
+		return nil
+	}
+	return gpuIdentities(info.EntityList)
+}
+
+// gpuIdentityFields are read for the UUID and serial number of the GPUs
+var gpuIdentityFields = []Short{DCGM_FI_DEV_GPU_UUID, DCGM_FI_DEV_BOARD_SERIAL}
+
+// gpuIdentities reads the identities of the GPU entities with a single
+// request. GPUs whose UUID cannot be read are left out; their violations are
+// not enriched.
+func gpuIdentities(entities []GroupEntityPair) map[uint]gpuIdentity {
+	var gpus []GroupEntityPair
+	for _, entity := range entities {
+		if entity.EntityGroupId == FE_GPU {
+			gpus = append(gpus, entity)
+		}
+	}
+	if len(gpus) == 0 {
+		return nil
+	}
+
+	fieldValues, err := EntitiesGetLatestValues(gpus, gpuIdentityFields, DCGM_FV_FLAG_LIVE_DATA)
+	if err != nil {
+		return nil
+	}
+	values := make(entityValues, len(fieldValues))
+	for i, value := range fieldValues {
+		values[i] = value.TypedValue()
+	}
+	return newGPUIdentities(gpus, values)
+}
+
+// newGPUIdentities builds the identities of the GPUs from their UUID and
+// serial number fields
+func newGPUIdentities(gpus []GroupEntityPair, values entityValues) map[uint]gpuIdentity {
+	identities := make(map[uint]gpuIdentity)
+	for _, gpu := range gpus {
+		value, _ := values.value(gpu, DCGM_FI_DEV_GPU_UUID)
+		uuid, ok := value.Str()
+		if !ok || uuid == "" {
+			continue
+		}
+		value, _ = values.value(gpu, DCGM_FI_DEV_BOARD_SERIAL)
+		serial, _ := value.Str()
+		identities[gpu.EntityId] = gpuIdentity{uuid: uuid, serial: serial}
+	}
+	return identities
+}
@@ -0,0 +1,142 @@
+package dcgm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyViolationAccessors(t *testing.T) {
+	violation := PolicyViolation{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: 79}}
+
+	xid, ok := violation.Xid()
+	require.True(t, ok)
+	assert.Equal(t, uint(79), xid.ErrNum)
+
+	_, ok = violation.Dbe()
+	assert.False(t, ok)
+	_, ok = violation.Rule()
+	assert.False(t, ok)
+}
+
+func TestPolicyConditionSeverity(t *testing.T) {
+	tests := []struct {
+		condition PolicyCondition
+		expected  PolicySeverity
+	}{
+		{DbePolicy, PolicySeverityCritical},
+		{MaxRtPgPolicy, PolicySeverityError},
+		{NvlinkPolicy, PolicySeverityError},
+		{XidPolicy, PolicySeverityError},
+		{PCIePolicy, PolicySeverityWarning},
+		{ThermalPolicy, PolicySeverityWarning},
+		{PowerPolicy, PolicySeverityWarning},
+		{PolicyCondition("hot and idle"), PolicySeverityWarning},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.condition.Severity(), tt.condition)
+	}
+}
+
+func TestPolicyViolationSeverityFollowsXID(t *testing.T) {
+	tests := []struct {
+		xid      uint
+		expected PolicySeverity
+	}{
+		{13, PolicySeverityWarning},
+		{48, PolicySeverityError},
+		{79, PolicySeverityCritical},
+		{999, PolicySeverityError},
+	}
+	for _, tt := range tests {
+		violation := PolicyViolation{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: tt.xid}}
+		assert.Equal(t, tt.expected, violation.Severity(), tt.xid)
+	}
+}
+
+func TestPolicySeverityText(t *testing.T) {
+	for _, severity := range []PolicySeverity{PolicySeverityWarning, PolicySeverityError, PolicySeverityCritical} {
+		text, err := severity.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, severity.String(), string(text))
+
+		var decoded PolicySeverity
+		require.NoError(t, decoded.UnmarshalText(text))
+		assert.Equal(t, severity, decoded)
+	}
+
+	assert.Equal(t, "PolicySeverity(9)", PolicySeverity(9).String())
+	_, err := PolicySeverity(9).MarshalText()
+	require.Error(t, err)
+	var decoded PolicySeverity
+	require.Error(t, decoded.UnmarshalText([]byte("fatal")))
+}
+
+func TestPolicyViolationJSON(t *testing.T) {
+	violation := PolicyViolation{
+		GPU:       1,
+		UUID:      "GPU-bbbb",
+		Serial:    "1324",
+		Condition: XidPolicy,
+		Timestamp: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Data:      XidPolicyCondition{ErrNum: 79},
+	}
+
+	encoded, err := json.Marshal(violation)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"gpu": 1,
+		"uuid": "GPU-bbbb",
+		"serial": "1324",
+		"type": "xid",
+		"condition": "XID Error",
+		"severity": "critical",
+		"timestamp": "2024-01-02T03:04:05Z",
+		"data": {"err_num": 79}
+	}`, string(encoded))
+
+	var decoded PolicyViolation
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, violation, decoded)
+}
+
+func TestPolicyViolationJSONRoundTrip(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	violations := []PolicyViolation{
+		{Condition: DbePolicy, Data: DbePolicyCondition{Location: "Device", NumErrors: 2}},
+		{Condition: PCIePolicy, Data: PciPolicyCondition{ReplayCounter: 12}},
+		{Condition: MaxRtPgPolicy, Data: RetiredPagesPolicyCondition{SbePages: 3, DbePages: 1}},
+		{Condition: ThermalPolicy, Data: ThermalPolicyCondition{ThermalViolation: 95}},
+		{Condition: PowerPolicy, Data: PowerPolicyCondition{PowerViolation: 310}},
+		{Condition: NvlinkPolicy, Data: NvlinkPolicyCondition{FieldId: 1100, Counter: 4}},
+		{Condition: PolicyCondition("hot and idle"), Data: RulePolicyCondition{Values: []float64{90, 0.05}, Since: timestamp}},
+		{Condition: XidPolicy},
+		{Condition: XidPolicy, Data: XidPolicyCondition{ErrNum: 48, Info: xidInfo(t, 48)}},
+	}
+
+	for _, violation := range violations {
+		violation.Timestamp = timestamp
+		encoded, err := json.Marshal(violation)
+		require.NoError(t, err)
+
+		var decoded PolicyViolation
+		require.NoError(t, json.Unmarshal(encoded, &decoded))
+		assert.Equal(t, violation, decoded, string(encoded))
+	}
+}
+
+func TestPolicyViolationJSONUnknownType(t *testing.T) {
+	var decoded PolicyViolation
+	err := json.Unmarshal([]byte(`{"gpu":0,"type":"ecc","condition":"x","data":{}}`), &decoded)
+	require.ErrorContains(t, err, `unknown policy violation type "ecc"`)
+}
+
+func xidInfo(t *testing.T, xid uint) XIDInfo {
+	t.Helper()
+	info, ok := LookupXID(xid)
+	require.True(t, ok)
+	return info
+}
@@ -1,6 +1,7 @@
 package dcgm
 
 /*
+#include <stdbool.h>
 #include "dcgm_agent.h"
 #include "dcgm_structs.h"
 */
@@ -8,8 +9,10 @@ import "C"
 
 import (
 	"fmt"
+	"log"
 	"math/rand"
 	"os"
+	"slices"
 	"strings"
 	"time"
 	"unsafe"
@@ -64,6 +67,79 @@ type XIDErrorInfo struct {
 	NumErrors int
 	// Timestamp contains the timestamps of when XID errors occurred
 	Timestamp []uint64
+	// Errors are the errors of Timestamp whose XID is still kept in the
+	// history of DCGM_FI_DEV_XID_ERROR, in the same order
+	Errors []XIDError
+}
+
+// XIDError is an XID error that occurred while a process was running
+type XIDError struct {
+	// Timestamp is when the error occurred, in microseconds since the epoch
+	Timestamp uint64
+	// XID is the XID error number
+	XID uint
+	// Info describes the error. It is zero when the XID is not known to LookupXID.
+	Info XIDInfo
+}
+
+// xidErrors looks up the XIDs reported at the timestamps in the history of
+// DCGM_FI_DEV_XID_ERROR, read with GetValuesSince from the earliest timestamp.
+// Timestamps without a sample are left out.
+func xidErrors(gpu uint, timestamps []uint64) ([]XIDError, error) {
+	if len(timestamps) == 0 {
+		return nil, nil
+	}
+
+	fieldsID, err := FieldGroupCreate(fmt.Sprintf("xidFields%d", rand.Uint64()), []Short{DCGM_FI_DEV_XID_ERROR})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := FieldGroupDestroy(fieldsID); ret != nil {
+			log.Printf("error destroying field group: %v", ret)
+		}
+	}()
+
+	group, err := CreateGroup(fmt.Sprintf("xid%d", rand.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if ret := DestroyGroup(group); ret != nil {
+			log.Printf("error destroying group: %v", ret)
+		}
+	}()
+	if err := AddToGroup(group, gpu); err != nil {
+		return nil, err
+	}
+
+	values, _, err := GetValuesSince(group, fieldsID, time.UnixMicro(int64(slices.Min(timestamps))))
+	if err != nil {
+		return nil, fmt.Errorf("error reading XID history of GPU %d: %w", gpu, err)
+	}
+	return xidsAt(values, timestamps), nil
+}
+
+// xidsAt returns the XIDs of the samples of DCGM_FI_DEV_XID_ERROR taken at the
+// timestamps, in the order of the timestamps
+func xidsAt(values []FieldValue_v2, timestamps []uint64) []XIDError {
+	xids := make(map[uint64]uint, len(values))
+	for _, value := range values {
+		if value.FieldID == DCGM_FI_DEV_XID_ERROR && value.Status == DCGM_ST_OK && !IsInt64Blank(value.Int64()) {
+			xids[uint64(value.TS)] = uint(value.Int64())
+		}
+	}
+
+	var errs []XIDError
+	for _, ts := range timestamps {
+		xid, ok := xids[ts]
+		if !ok {
+			continue
+		}
+		info, _ := LookupXID(xid)
+		errs = append(errs, XIDError{Timestamp: ts, XID: xid, Info: info})
+	}
+	return errs
 }
 
 // ProcessInfo contains comprehensive information about a GPU process
@@ -224,9 +300,14 @@ func getProcessInfo(groupID GroupHandle, pid uint) (processInfo []ProcessInfo, e
 		for j := 0; j < numErrs; j++ {
 			ts[j] = uint64(pidInfo.gpus[i].xidCriticalErrorsTs[j])
 		}
+		errs, xidErr := xidErrors(uint(pidInfo.gpus[i].gpuId), ts)
+		if xidErr != nil {
+			log.Printf("error looking up the XIDs of process %d: %v", pid, xidErr)
+		}
 		xidErrs := XIDErrorInfo{
 			NumErrors: numErrs,
 			Timestamp: ts,
+			Errors:    errs,
 		}
 
 		processInfo[i].GPU = uint(pidInfo.gpus[i].gpuId)
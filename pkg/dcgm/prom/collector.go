@@ -0,0 +1,338 @@
+// Package prom exports DCGM field values, health incidents and policy
+// violations as Prometheus metrics.
+//
+// A Collector owns the DCGM group, field group and watches it needs and
+// releases them on Close:
+//
+//	cleanup, err := dcgm.Init(dcgm.Embedded)
+//	...
+//	defer cleanup()
+//
+//	collector, err := prom.New(prom.DCGM(), prom.Config{
+//		Fields:        []string{"DCGM_FI_DEV_GPU_TEMP", "DCGM_FI_DEV_POWER_USAGE"},
+//		HealthSystems: dcgm.DCGM_HEALTH_WATCH_ALL,
+//	})
+//	...
+//	defer collector.Close()
+//	prometheus.MustRegister(collector)
+package prom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm/internal/fieldwatch"
+)
+
+// Backend is the subset of the dcgm package used by the Collector.
+// DCGM returns the implementation backed by libdcgm; tests supply a fake.
+type Backend = fieldwatch.Backend
+
+// DCGM returns the Backend that calls the dcgm package directly.
+// dcgm.Init must have been called before the backend is used.
+func DCGM() Backend {
+	return fieldwatch.DCGM()
+}
+
+// entityLabels are the labels attached to every per-entity metric. Labels that
+// do not apply to an entity are left empty.
+var entityLabels = []string{"gpu", "uuid", "gpu_instance", "compute_instance", "link", "cpu", "cpu_core"}
+
+// Config configures a Collector
+type Config struct {
+	// Fields lists the fields to export, by numeric ID ("150") or by name ("DCGM_FI_DEV_GPU_TEMP")
+	Fields []string
+	// EntityGroups selects the entities to watch. Supported groups are FE_GPU, FE_GPU_I,
+	// FE_GPU_CI, FE_LINK, FE_CPU and FE_CPU_CORE. Defaults to FE_GPU.
+	EntityGroups []dcgm.Field_Entity_Group
+	// UpdateInterval is how often DCGM samples the fields. Defaults to 30 seconds.
+	UpdateInterval time.Duration
+	// HealthSystems enables health watches and the dcgm_health_* metrics when non-zero
+	HealthSystems dcgm.HealthSystem
+	// PolicyConditions enables dcgm_policy_violations_total for the listed conditions
+	PolicyConditions []dcgm.PolicyCondition
+}
+
+type fieldMetric struct {
+	desc      *prometheus.Desc
+	valueType prometheus.ValueType
+}
+
+type violationKey struct {
+	gpu       uint
+	condition dcgm.PolicyCondition
+}
+
+// Collector is a prometheus.Collector for DCGM fields, health and policy violations
+type Collector struct {
+	backend  Backend
+	watch    *fieldwatch.Watch
+	metrics  map[dcgm.Short]fieldMetric
+	entities map[dcgm.GroupEntityPair]fieldwatch.Entity
+
+	health          bool
+	healthDesc      *prometheus.Desc
+	incidentsDesc   *prometheus.Desc
+	violationsDesc  *prometheus.Desc
+	scrapeErrorDesc *prometheus.Desc
+
+	mu         sync.Mutex
+	violations map[violationKey]uint64
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// ParseField resolves a field given by numeric ID or by name
+func ParseField(field string) (dcgm.Short, error) {
+	return fieldwatch.ParseField(field)
+}
+
+// New creates a Collector that watches cfg.Fields on the selected entities.
+// The caller must call Close to release the DCGM resources it creates.
+func New(backend Backend, cfg Config) (*Collector, error) {
+	fields, err := fieldwatch.ParseFields(cfg.Fields)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Collector{
+		backend:    backend,
+		metrics:    make(map[dcgm.Short]fieldMetric, len(cfg.Fields)),
+		entities:   make(map[dcgm.GroupEntityPair]fieldwatch.Entity),
+		violations: make(map[violationKey]uint64),
+		health:     cfg.HealthSystems != 0,
+		healthDesc: prometheus.NewDesc("dcgm_health_status",
+			"Overall DCGM health of the watched entities (0 pass, 10 warn, 20 fail)", nil, nil),
+		incidentsDesc: prometheus.NewDesc("dcgm_health_incidents",
+			"Number of open DCGM health incidents", slices.Concat(entityLabels, []string{"system", "result", "error"}), nil),
+		violationsDesc: prometheus.NewDesc("dcgm_policy_violations_total",
+			"Number of DCGM policy violations received", []string{"gpu", "uuid", "condition"}, nil),
+		scrapeErrorDesc: prometheus.NewDesc("dcgm_scrape_error",
+			"Error reading values from DCGM", nil, nil),
+	}
+
+	for _, id := range fields {
+		metric, err := newFieldMetric(id)
+		if err != nil {
+			return nil, err
+		}
+		c.metrics[id] = metric
+	}
+
+	entityGroups := cfg.EntityGroups
+	if len(entityGroups) == 0 {
+		entityGroups = []dcgm.Field_Entity_Group{dcgm.FE_GPU}
+	}
+	entities, err := fieldwatch.Discover(backend, entityGroups)
+	if err != nil {
+		return nil, err
+	}
+	for _, entity := range entities {
+		c.entities[entity.GroupEntityPair] = entity
+	}
+
+	c.watch, err = fieldwatch.Start(backend, entities, fields, cfg.UpdateInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.setup(cfg); err != nil {
+		return nil, errors.Join(err, c.Close())
+	}
+
+	return c, nil
+}
+
+func newFieldMetric(id dcgm.Short) (fieldMetric, error) {
+	info, ok := dcgm.GetFieldInfo(id)
+	if !ok {
+		return fieldMetric{}, fmt.Errorf("no metadata for field %d", id)
+	}
+
+	var valueType prometheus.ValueType
+	switch info.Kind {
+	case dcgm.FieldKindGauge:
+		valueType = prometheus.GaugeValue
+	case dcgm.FieldKindCounter:
+		valueType = prometheus.CounterValue
+	default:
+		return fieldMetric{}, fmt.Errorf("field %s is not numeric", info.Name)
+	}
+
+	help := info.Description
+	if help == "" {
+		help = info.Name
+	}
+	if info.Unit != dcgm.UnitNone {
+		help += " (" + string(info.Unit) + ")"
+	}
+
+	return fieldMetric{
+		desc:      prometheus.NewDesc(info.Name, help, entityLabels, nil),
+		valueType: valueType,
+	}, nil
+}
+
+// setup enables the health watches and the policy listener on the watched group
+func (c *Collector) setup(cfg Config) error {
+	group := c.watch.Group
+	if c.health {
+		if err := c.backend.HealthSet(group, cfg.HealthSystems); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.PolicyConditions) > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		violations, err := c.backend.WatchPolicyViolationsForGroup(ctx, group, cfg.PolicyConditions...)
+		if err != nil {
+			cancel()
+			return err
+		}
+		c.cancel = cancel
+		c.done = make(chan struct{})
+		go c.countViolations(violations)
+	}
+
+	return nil
+}
+
+func (c *Collector) countViolations(violations <-chan dcgm.PolicyViolation) {
+	defer close(c.done)
+	for violation := range violations {
+		c.mu.Lock()
+		c.violations[violationKey{gpu: violation.GPU, condition: violation.Condition}]++
+		c.mu.Unlock()
+	}
+}
+
+// Close stops the policy listener and releases the watches and groups created by New
+func (c *Collector) Close() error {
+	c.closeOnce.Do(func() {
+		if c.cancel != nil {
+			c.cancel()
+			<-c.done
+		}
+
+		c.closeErr = c.watch.Close()
+	})
+	return c.closeErr
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, id := range c.watch.Fields {
+		ch <- c.metrics[id].desc
+	}
+	if c.health {
+		ch <- c.healthDesc
+		ch <- c.incidentsDesc
+	}
+	if c.cancel != nil {
+		ch <- c.violationsDesc
+	}
+	ch <- c.scrapeErrorDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.collectFields(ch)
+	if c.health {
+		c.collectHealth(ch)
+	}
+	if c.cancel != nil {
+		c.collectViolations(ch)
+	}
+}
+
+func (c *Collector) collectFields(ch chan<- prometheus.Metric) {
+	values, err := c.watch.Latest()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.scrapeErrorDesc, fmt.Errorf("error reading field values: %w", err))
+		return
+	}
+
+	for _, value := range values {
+		metric, ok := c.metrics[value.FieldID]
+		if !ok {
+			continue
+		}
+		entity, ok := c.entities[dcgm.GroupEntityPair{EntityGroupId: value.EntityGroupId, EntityId: value.EntityID}]
+		if !ok {
+			continue
+		}
+		number, ok := value.TypedValue().Number()
+		if !ok {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(metric.desc, metric.valueType, number, labelValues(entity)...)
+	}
+}
+
+type incidentKey struct {
+	entity dcgm.GroupEntityPair
+	system dcgm.HealthSystem
+	result dcgm.HealthResult
+	code   dcgm.HealthCheckErrorCode
+}
+
+func (c *Collector) collectHealth(ch chan<- prometheus.Metric) {
+	response, err := c.backend.HealthCheck(c.watch.Group)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.healthDesc, fmt.Errorf("error checking health: %w", err))
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.healthDesc, prometheus.GaugeValue, float64(response.OverallHealth))
+
+	counts := make(map[incidentKey]int)
+	var order []incidentKey
+	for _, incident := range response.Incidents {
+		key := incidentKey{
+			entity: incident.EntityInfo,
+			system: incident.System,
+			result: incident.Health,
+			code:   incident.Error.Code,
+		}
+		if counts[key] == 0 {
+			order = append(order, key)
+		}
+		counts[key]++
+	}
+
+	for _, key := range order {
+		labels := append(labelValues(c.entities[key.entity]),
+			key.system.String(), strings.ToLower(key.result.String()), key.code.String())
+		ch <- prometheus.MustNewConstMetric(c.incidentsDesc, prometheus.GaugeValue, float64(counts[key]), labels...)
+	}
+}
+
+func (c *Collector) collectViolations(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, count := range c.violations {
+		gpu := c.entities[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: key.gpu}]
+		ch <- prometheus.MustNewConstMetric(c.violationsDesc, prometheus.CounterValue, float64(count),
+			strconv.FormatUint(uint64(key.gpu), 10), gpu.UUID, string(key.condition))
+	}
+}
+
+// labelValues returns the values of entityLabels for an entity
+func labelValues(entity fieldwatch.Entity) []string {
+	return []string{
+		entity.GPU, entity.UUID, entity.GPUInstance, entity.ComputeInstance, entity.Link, entity.CPU, entity.CPUCore,
+	}
+}
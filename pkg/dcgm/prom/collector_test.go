@@ -0,0 +1,209 @@
+package prom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm/internal/fieldwatch"
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm/internal/fieldwatch/fieldwatchtest"
+)
+
+func TestParseField(t *testing.T) {
+	id, err := ParseField("DCGM_FI_DEV_GPU_TEMP")
+	require.NoError(t, err)
+	assert.Equal(t, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, id)
+
+	id, err = ParseField("311")
+	require.NoError(t, err)
+	assert.Equal(t, dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, id)
+
+	_, err = ParseField("DCGM_FI_NOT_A_FIELD")
+	require.Error(t, err)
+
+	_, err = ParseField("65000")
+	require.Error(t, err)
+}
+
+func TestCollectorExportsGaugesAndCounters(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.Values = []dcgm.FieldValue_v2{
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 0, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, 41),
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 1, dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, 43),
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 0, dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, 2),
+		// Blank values are skipped
+		fieldwatchtest.Int64Value(dcgm.FE_GPU, 1, dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL, dcgm.DCGM_FT_INT64_BLANK),
+	}
+
+	collector, err := New(backend, Config{
+		Fields:         []string{"DCGM_FI_DEV_GPU_TEMP", "311", "DCGM_FI_DEV_GPU_TEMP_CELSIUS"},
+		UpdateInterval: 5 * time.Second,
+	})
+	require.NoError(t, err)
+	defer collector.Close()
+
+	assert.Equal(t, []dcgm.Short{dcgm.DCGM_FI_DEV_GPU_TEMP_CELSIUS, dcgm.DCGM_FI_DEV_ECC_DBE_VOL_TOTAL}, backend.WatchedFields)
+	assert.Equal(t, int64(5000000), backend.UpdateFreq)
+	assert.Equal(t, []dcgm.GroupEntityPair{{EntityGroupId: dcgm.FE_GPU, EntityId: 0}, {EntityGroupId: dcgm.FE_GPU, EntityId: 1}},
+		backend.GroupEntities)
+
+	expected := `
+# HELP DCGM_FI_DEV_ECC_DBE_VOL_TOTAL Total double bit volatile ECC errors
+# TYPE DCGM_FI_DEV_ECC_DBE_VOL_TOTAL counter
+DCGM_FI_DEV_ECC_DBE_VOL_TOTAL{compute_instance="",cpu="",cpu_core="",gpu="0",gpu_instance="",link="",uuid="GPU-aaaa"} 2
+# HELP DCGM_FI_DEV_GPU_TEMP_CELSIUS Current temperature readings for the device, in degrees C (celsius)
+# TYPE DCGM_FI_DEV_GPU_TEMP_CELSIUS gauge
+DCGM_FI_DEV_GPU_TEMP_CELSIUS{compute_instance="",cpu="",cpu_core="",gpu="0",gpu_instance="",link="",uuid="GPU-aaaa"} 41
+DCGM_FI_DEV_GPU_TEMP_CELSIUS{compute_instance="",cpu="",cpu_core="",gpu="1",gpu_instance="",link="",uuid="GPU-bbbb"} 43
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected)))
+}
+
+func TestCollectorLabelsMIGLinkAndCPUEntities(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.MIG.Count = 2
+	backend.MIG.EntityList[0] = dcgm.MigHierarchyInfo_v2{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 7},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 1},
+		Info:   dcgm.MigEntityInfo{GpuUuid: "GPU-bbbb", NvmlInstanceId: 3},
+	}
+	backend.MIG.EntityList[1] = dcgm.MigHierarchyInfo_v2{
+		Entity: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 9},
+		Parent: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 7},
+		Info:   dcgm.MigEntityInfo{GpuUuid: "GPU-bbbb", NvmlInstanceId: 3, NvmlComputeInstanceId: 0},
+	}
+	backend.Links = []dcgm.NvLinkStatus{
+		{ParentId: 0, ParentType: dcgm.FE_GPU, State: dcgm.LS_UP, Index: 2},
+		{ParentId: 0, ParentType: dcgm.FE_GPU, State: dcgm.LS_DOWN, Index: 3},
+	}
+	backend.CPUs.NumCPUs = 1
+	backend.CPUs.CPUs[0] = dcgm.CPUHierarchyCPU_v2{CPUID: 0, OwnedCores: []uint64{0, 1 << 2}}
+
+	collector, err := New(backend, Config{
+		Fields:       []string{"DCGM_FI_PROF_GR_ENGINE_ACTIVE"},
+		EntityGroups: []dcgm.Field_Entity_Group{dcgm.FE_GPU_I, dcgm.FE_GPU_CI, dcgm.FE_LINK, dcgm.FE_CPU_CORE},
+	})
+	require.NoError(t, err)
+	defer collector.Close()
+
+	link := fieldwatch.LinkEntityID(backend.Links[0])
+	assert.Equal(t, []string{"1", "GPU-bbbb", "3", "", "", "", ""},
+		labelValues(collector.entities[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_I, EntityId: 7}]))
+	assert.Equal(t, []string{"1", "GPU-bbbb", "3", "0", "", "", ""},
+		labelValues(collector.entities[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU_CI, EntityId: 9}]))
+	assert.Equal(t, []string{"0", "GPU-aaaa", "", "", "2", "", ""},
+		labelValues(collector.entities[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_LINK, EntityId: link}]))
+	assert.Equal(t, []string{"", "", "", "", "", "0", "66"},
+		labelValues(collector.entities[dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_CPU_CORE, EntityId: 66}]))
+	assert.Len(t, collector.watch.Entities, 4)
+
+	backend.Values = []dcgm.FieldValue_v2{
+		fieldwatchtest.Float64Value(dcgm.FE_GPU_CI, 9, dcgm.DCGM_FI_PROF_GR_ENGINE_UTIL_RATIO, 0.5),
+	}
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "DCGM_FI_PROF_GR_ENGINE_UTIL_RATIO"))
+}
+
+func TestCollectorHealthAndPolicyViolations(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.Health = dcgm.HealthResponse{
+		OverallHealth: dcgm.DCGM_HEALTH_RESULT_WARN,
+		Incidents: []dcgm.Incident{
+			{
+				System:     dcgm.DCGM_HEALTH_WATCH_PCIE,
+				Health:     dcgm.DCGM_HEALTH_RESULT_WARN,
+				Error:      dcgm.DiagErrorDetail{Code: dcgm.DCGM_FR_PCI_REPLAY_RATE},
+				EntityInfo: dcgm.GroupEntityPair{EntityGroupId: dcgm.FE_GPU, EntityId: 1},
+			},
+		},
+	}
+
+	collector, err := New(backend, Config{
+		Fields:           []string{"DCGM_FI_DEV_GPU_TEMP"},
+		HealthSystems:    dcgm.DCGM_HEALTH_WATCH_ALL,
+		PolicyConditions: []dcgm.PolicyCondition{dcgm.XidPolicy},
+	})
+	require.NoError(t, err)
+	defer collector.Close()
+
+	assert.Equal(t, dcgm.DCGM_HEALTH_WATCH_ALL, backend.HealthSystems)
+	assert.Equal(t, []dcgm.PolicyCondition{dcgm.XidPolicy}, backend.Conditions)
+
+	backend.Violations <- dcgm.PolicyViolation{GPU: 0, Condition: dcgm.XidPolicy}
+	backend.Violations <- dcgm.PolicyViolation{GPU: 0, Condition: dcgm.XidPolicy}
+	violations := `
+# HELP dcgm_policy_violations_total Number of DCGM policy violations received
+# TYPE dcgm_policy_violations_total counter
+dcgm_policy_violations_total{condition="XID Error",gpu="0",uuid="GPU-aaaa"} 2
+`
+	require.Eventually(t, func() bool {
+		return testutil.CollectAndCompare(collector, strings.NewReader(violations), "dcgm_policy_violations_total") == nil
+	}, time.Second, 10*time.Millisecond)
+
+	expected := `
+# HELP dcgm_health_incidents Number of open DCGM health incidents
+# TYPE dcgm_health_incidents gauge
+dcgm_health_incidents{compute_instance="",cpu="",cpu_core="",error="DCGM_FR_PCI_REPLAY_RATE",gpu="1",gpu_instance="",link="",result="warn",system="pcie",uuid="GPU-bbbb"} 1
+# HELP dcgm_health_status Overall DCGM health of the watched entities (0 pass, 10 warn, 20 fail)
+# TYPE dcgm_health_status gauge
+dcgm_health_status 10
+`
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"dcgm_health_incidents", "dcgm_health_status"))
+}
+
+func TestCollectorReportsScrapeErrors(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.ValuesErr = errors.New("host engine unavailable")
+
+	collector, err := New(backend, Config{Fields: []string{"DCGM_FI_DEV_GPU_TEMP"}})
+	require.NoError(t, err)
+	defer collector.Close()
+
+	_, err = testutil.CollectAndLint(collector)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "host engine unavailable")
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	_, err := New(fieldwatchtest.NewBackend(), Config{})
+	require.Error(t, err)
+
+	_, err = New(fieldwatchtest.NewBackend(), Config{Fields: []string{"DCGM_FI_DEV_UUID"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not numeric")
+
+	_, err = New(fieldwatchtest.NewBackend(), Config{
+		Fields:       []string{"DCGM_FI_DEV_GPU_TEMP"},
+		EntityGroups: []dcgm.Field_Entity_Group{dcgm.FE_VGPU},
+	})
+	require.Error(t, err)
+}
+
+func TestCloseReleasesResources(t *testing.T) {
+	backend := fieldwatchtest.NewBackend()
+	backend.WatchErr = errors.New("watch failed")
+
+	_, err := New(backend, Config{Fields: []string{"DCGM_FI_DEV_GPU_TEMP"}})
+	require.Error(t, err)
+	assert.Equal(t, 0, backend.Unwatched)
+	assert.Equal(t, 1, backend.FieldsDestroyed)
+	assert.Equal(t, 1, backend.GroupsDestroyed)
+
+	backend = fieldwatchtest.NewBackend()
+	collector, err := New(backend, Config{
+		Fields:           []string{"DCGM_FI_DEV_GPU_TEMP"},
+		PolicyConditions: []dcgm.PolicyCondition{dcgm.XidPolicy},
+	})
+	require.NoError(t, err)
+	require.NoError(t, collector.Close())
+	require.NoError(t, collector.Close())
+	assert.Equal(t, 1, backend.Unwatched)
+	assert.Equal(t, 1, backend.FieldsDestroyed)
+	assert.Equal(t, 1, backend.GroupsDestroyed)
+}
@@ -16,6 +16,8 @@
 
 package dcgm
 
+import "strconv"
+
 // MigProfile represents the Multi-Instance GPU (MIG) profile type
 type MigProfile int
 
@@ -59,3 +61,48 @@ const (
 	// MigProfileComputeInstanceSlice1Rev1 represents compute instance slice 1 revision 1
 	MigProfileComputeInstanceSlice1Rev1 MigProfile = 37 /*!< compute instance slice 1 revision 1 */
 )
+
+var migProfileNames = newEnumNames("MIG profile", map[MigProfile]string{
+	MigProfileNone:                      "None",
+	MigProfileGPUInstanceSlice1:         "GPUInstanceSlice1",
+	MigProfileGPUInstanceSlice2:         "GPUInstanceSlice2",
+	MigProfileGPUInstanceSlice3:         "GPUInstanceSlice3",
+	MigProfileGPUInstanceSlice4:         "GPUInstanceSlice4",
+	MigProfileGPUInstanceSlice7:         "GPUInstanceSlice7",
+	MigProfileGPUInstanceSlice8:         "GPUInstanceSlice8",
+	MigProfileGPUInstanceSlice6:         "GPUInstanceSlice6",
+	MigProfileGPUInstanceSlice1Rev1:     "GPUInstanceSlice1Rev1",
+	MigProfileGPUInstanceSlice2Rev1:     "GPUInstanceSlice2Rev1",
+	MigProfileGPUInstanceSlice1Rev2:     "GPUInstanceSlice1Rev2",
+	MigProfileComputeInstanceSlice1:     "ComputeInstanceSlice1",
+	MigProfileComputeInstanceSlice2:     "ComputeInstanceSlice2",
+	MigProfileComputeInstanceSlice3:     "ComputeInstanceSlice3",
+	MigProfileComputeInstanceSlice4:     "ComputeInstanceSlice4",
+	MigProfileComputeInstanceSlice7:     "ComputeInstanceSlice7",
+	MigProfileComputeInstanceSlice8:     "ComputeInstanceSlice8",
+	MigProfileComputeInstanceSlice6:     "ComputeInstanceSlice6",
+	MigProfileComputeInstanceSlice1Rev1: "ComputeInstanceSlice1Rev1",
+})
+
+// String returns the name of the profile, such as "GPUInstanceSlice1"
+func (p MigProfile) String() string {
+	if name, ok := migProfileNames.name(p); ok {
+		return name
+	}
+	return "MigProfile(" + strconv.Itoa(int(p)) + ")"
+}
+
+// MarshalText encodes the profile as its name
+func (p MigProfile) MarshalText() ([]byte, error) {
+	return migProfileNames.text(p), nil
+}
+
+// UnmarshalText decodes a profile name or number
+func (p *MigProfile) UnmarshalText(text []byte) error {
+	profile, err := migProfileNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*p = profile
+	return nil
+}
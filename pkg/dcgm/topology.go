@@ -9,6 +9,7 @@ import "C"
 import (
 	"fmt"
 	"math/bits"
+	"strconv"
 	"unsafe"
 )
 
@@ -45,37 +46,77 @@ const (
 	FourNVLINKLinks
 )
 
+// p2pLinkTypeNames are the nvidia-smi topo names of the link types
+var p2pLinkTypeNames = newEnumNames("P2P link type", newP2PLinkTypeNameMap())
+
+func newP2PLinkTypeNameMap() map[P2PLinkType]string {
+	names := map[P2PLinkType]string{
+		P2PLinkUnknown:      "N/A",
+		P2PLinkCrossCPU:     "SYS",
+		P2PLinkSameCPU:      "NODE",
+		P2PLinkHostBridge:   "PHB",
+		P2PLinkMultiSwitch:  "PXB",
+		P2PLinkSingleSwitch: "PIX",
+		P2PLinkSameBoard:    "PSB",
+	}
+	for count := uint(1); count <= maxNVLinkCount; count++ {
+		names[nvLinkP2PLinkType(count)] = fmt.Sprintf("NV%d", count)
+	}
+	return names
+}
+
 // PCIPaths returns a string representation of the P2P link type
 func (l P2PLinkType) PCIPaths() string {
-	switch l {
-	case P2PLinkSameBoard:
-		return "PSB"
-	case P2PLinkSingleSwitch:
-		return "PIX"
-	case P2PLinkMultiSwitch:
-		return "PXB"
-	case P2PLinkHostBridge:
-		return "PHB"
-	case P2PLinkSameCPU:
-		return "NODE"
-	case P2PLinkCrossCPU:
-		return "SYS"
-	case SingleNVLINKLink:
-		return "NV1"
-	case TwoNVLINKLinks:
-		return "NV2"
-	case ThreeNVLINKLinks:
-		return "NV3"
-	case FourNVLINKLinks:
-		return "NV4"
-	case P2PLinkUnknown:
-	}
-	if count, ok := l.nvLinkCount(); ok {
-		return fmt.Sprintf("NV%d", count)
+	if name, ok := p2pLinkTypeNames.name(l); ok {
+		return name
 	}
 	return "N/A"
 }
 
+// String returns the nvidia-smi topo name of the link type, such as "PIX" or
+// "NV4"
+func (l P2PLinkType) String() string {
+	return l.PCIPaths()
+}
+
+// MarshalText encodes the link type as its nvidia-smi topo name
+func (l P2PLinkType) MarshalText() ([]byte, error) {
+	return p2pLinkTypeNames.text(l), nil
+}
+
+// UnmarshalText decodes a link type name or number
+func (l *P2PLinkType) UnmarshalText(text []byte) error {
+	link, err := p2pLinkTypeNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*l = link
+	return nil
+}
+
+// IsNvLink reports whether the link type is an NVLink connection
+func (l P2PLinkType) IsNvLink() bool {
+	_, ok := l.nvLinkCount()
+	return ok
+}
+
+// BandwidthRank orders link types by bandwidth: PCIe paths from SYS to PSB,
+// then NVLink connections by number of links. Unknown link types rank 0.
+func (l P2PLinkType) BandwidthRank() int {
+	if l <= P2PLinkSameBoard {
+		return int(l)
+	}
+	if _, ok := l.nvLinkCount(); ok {
+		return int(l)
+	}
+	return 0
+}
+
+// Faster reports whether the link type has more bandwidth than other
+func (l P2PLinkType) Faster(other P2PLinkType) bool {
+	return l.BandwidthRank() > other.BandwidthRank()
+}
+
 func (l P2PLinkType) nvLinkCount() (uint, bool) {
 	if l < SingleNVLINKLink {
 		return 0, false
@@ -167,25 +208,41 @@ func populatePeerBusIDs(links []P2PLink, values []FieldValue_v2) error {
 	return nil
 }
 
-func getDeviceTopology(gpuID uint) (links []P2PLink, err error) {
-	var topology C.dcgmDeviceTopology_v2
-	topology.version = makeVersion2(unsafe.Sizeof(topology))
-
-	result := C.dcgmGetDeviceTopology(handle.handle, C.uint(gpuID), &topology)
-	if result == C.DCGM_ST_NOT_SUPPORTED {
+// getGPUBusIDs reads the PCI bus IDs of the GPUs with a single request
+func getGPUBusIDs(gpus []uint) ([]P2PLink, error) {
+	links := make([]P2PLink, len(gpus))
+	for i, gpu := range gpus {
+		links[i].GPU = gpu
+	}
+	if len(links) == 0 {
 		return links, nil
 	}
-	if result != C.DCGM_ST_OK {
-		return links, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+
+	values, err := EntitiesGetLatestValues(peerEntities(links), []Short{DCGM_FI_DEV_PCI_BUS_ID}, DCGM_FV_FLAG_LIVE_DATA)
+	if err != nil {
+		return nil, fmt.Errorf("get GPU bus IDs: %w", err)
 	}
+	if err := populatePeerBusIDs(links, values); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
 
-	links = make([]P2PLink, topology.numGpus)
-	for i := uint(0); i < uint(topology.numGpus); i++ {
-		links[i].GPU = uint(topology.gpuPaths[i].gpuId)
-		links[i].Link = getP2PLink(uint64(topology.gpuPaths[i].path))
+// gpuPCIAddresses maps the PCI addresses of the GPUs to their IDs
+func gpuPCIAddresses(gpus []P2PLink) map[pciAddress]uint {
+	addresses := make(map[pciAddress]uint, len(gpus))
+	for _, gpu := range gpus {
+		if address, ok := parsePCIAddress(gpu.BusID); ok {
+			addresses[address] = gpu.GPU
+		}
 	}
-	if len(links) == 0 {
-		return
+	return addresses
+}
+
+func getDeviceTopology(gpuID uint) ([]P2PLink, error) {
+	links, err := getDeviceTopologyPaths(gpuID)
+	if err != nil || len(links) == 0 {
+		return links, err
 	}
 
 	values, err := EntitiesGetLatestValues(
@@ -199,7 +256,29 @@ func getDeviceTopology(gpuID uint) (links []P2PLink, err error) {
 	if err := populatePeerBusIDs(links, values); err != nil {
 		return nil, err
 	}
-	return
+	return links, nil
+}
+
+// getDeviceTopologyPaths returns the paths from the GPU to its peers, without
+// the bus IDs of the peers
+func getDeviceTopologyPaths(gpuID uint) (links []P2PLink, err error) {
+	var topology C.dcgmDeviceTopology_v2
+	topology.version = makeVersion2(unsafe.Sizeof(topology))
+
+	result := C.dcgmGetDeviceTopology(handle.handle, C.uint(gpuID), &topology)
+	if result == C.DCGM_ST_NOT_SUPPORTED {
+		return links, nil
+	}
+	if result != C.DCGM_ST_OK {
+		return links, &Error{msg: C.GoString(C.errorString(result)), Code: result}
+	}
+
+	links = make([]P2PLink, topology.numGpus)
+	for i := uint(0); i < uint(topology.numGpus); i++ {
+		links[i].GPU = uint(topology.gpuPaths[i].gpuId)
+		links[i].Link = getP2PLink(uint64(topology.gpuPaths[i].path))
+	}
+	return links, nil
 }
 
 // Link_State represents the state of an NVLINK connection
@@ -216,6 +295,36 @@ const (
 	LS_UP
 )
 
+var linkStateNames = newEnumNames("link state", map[Link_State]string{
+	LS_NOT_SUPPORTED: "NotSupported",
+	LS_DISABLED:      "Disabled",
+	LS_DOWN:          "Down",
+	LS_UP:            "Up",
+})
+
+// String returns "NotSupported", "Disabled", "Down" or "Up"
+func (s Link_State) String() string {
+	if name, ok := linkStateNames.name(s); ok {
+		return name
+	}
+	return "Link_State(" + strconv.FormatUint(uint64(s), 10) + ")"
+}
+
+// MarshalText encodes the link state as its name
+func (s Link_State) MarshalText() ([]byte, error) {
+	return linkStateNames.text(s), nil
+}
+
+// UnmarshalText decodes a link state name or number
+func (s *Link_State) UnmarshalText(text []byte) error {
+	state, err := linkStateNames.parse(text)
+	if err != nil {
+		return err
+	}
+	*s = state
+	return nil
+}
+
 // NvLinkStatus contains information about an NVLINK connection status
 type NvLinkStatus struct {
 	// ParentId is the ID of the parent entity (GPU or NVSwitch)
@@ -0,0 +1,629 @@
+package dcgm
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// maxBisectionGPUs bounds the exhaustive search of Topology.Bisection
+const maxBisectionGPUs = 16
+
+// TopologyNodeKind is the kind of device of a topology node
+type TopologyNodeKind uint
+
+const (
+	// TopologyGPU is a GPU
+	TopologyGPU TopologyNodeKind = iota
+	// TopologyNVSwitch is an NVSwitch
+	TopologyNVSwitch
+	// TopologyCPU is a CPU socket or, when DCGM cannot report the CPUs, a NUMA node
+	TopologyCPU
+	// TopologyNIC is a ConnectX network adapter
+	TopologyNIC
+)
+
+var topologyNodeKindNames = map[TopologyNodeKind]string{
+	TopologyGPU:      "GPU",
+	TopologyNVSwitch: "NVSwitch",
+	TopologyCPU:      "CPU",
+	TopologyNIC:      "NIC",
+}
+
+// String returns "GPU", "NVSwitch", "CPU" or "NIC"
+func (k TopologyNodeKind) String() string {
+	if name, ok := topologyNodeKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("TopologyNodeKind(%d)", uint(k))
+}
+
+// TopologyNodeID identifies a node of a Topology
+type TopologyNodeID struct {
+	// Kind is the kind of device
+	Kind TopologyNodeKind
+	// ID is the DCGM entity ID of GPUs, NVSwitches and NICs, and the CPU or
+	// NUMA node number of CPUs
+	ID uint
+}
+
+// String returns the kind followed by the ID, e.g. "GPU0"
+func (id TopologyNodeID) String() string {
+	return id.Kind.String() + strconv.FormatUint(uint64(id.ID), 10)
+}
+
+func (id TopologyNodeID) compare(other TopologyNodeID) int {
+	return cmp.Or(cmp.Compare(id.Kind, other.Kind), cmp.Compare(id.ID, other.ID))
+}
+
+// TopologyNode is a device of a Topology
+type TopologyNode struct {
+	TopologyNodeID
+	// BusID is the PCI bus ID of GPUs and NVSwitches
+	BusID string
+	// CPUs are the cores of CPUs and the cores close to GPUs
+	CPUs CPUSet
+}
+
+// TopologyEdge is a connection between two nodes of a Topology
+type TopologyEdge struct {
+	// A and B are the connected nodes, A ordered before B
+	A, B TopologyNodeID
+	// Link is the type of the connection
+	Link P2PLinkType
+	// Links is the number of NVLinks of an NVLink connection; it is 0 for PCIe paths
+	Links uint
+}
+
+// other returns the node at the other end of the edge
+func (e TopologyEdge) other(id TopologyNodeID) TopologyNodeID {
+	if e.A == id {
+		return e.B
+	}
+	return e.A
+}
+
+// TopologyPath is a route between two nodes of a Topology
+type TopologyPath struct {
+	// Nodes are the nodes of the route, from the first to the last
+	Nodes []TopologyNodeID
+	// Edges are the connections between consecutive nodes
+	Edges []TopologyEdge
+}
+
+// Link returns the slowest connection of the path
+func (p TopologyPath) Link() P2PLinkType {
+	if len(p.Edges) == 0 {
+		return P2PLinkUnknown
+	}
+	link := p.Edges[0].Link
+	for _, edge := range p.Edges[1:] {
+		if edge.Link.BandwidthRank() < link.BandwidthRank() {
+			link = edge.Link
+		}
+	}
+	return link
+}
+
+// TopologyBisection is a split of the GPUs of a Topology into two halves
+type TopologyBisection struct {
+	// Halves are the GPUs of each half; the second half has the extra GPU of
+	// an odd number of GPUs
+	Halves [2][]uint
+	// Links is the number of NVLinks between the halves
+	Links uint
+}
+
+// Topology is the graph of the GPUs, NVSwitches, CPUs and NICs of the system
+// and of their PCIe and NVLink connections
+type Topology struct {
+	Nodes []TopologyNode
+	Edges []TopologyEdge
+}
+
+func (t *Topology) addNode(node TopologyNode) {
+	if _, ok := t.Node(node.TopologyNodeID); ok {
+		return
+	}
+	t.Nodes = append(t.Nodes, node)
+}
+
+// addEdge connects two nodes, keeping the fastest link when they are already
+// connected
+func (t *Topology) addEdge(a, b TopologyNodeID, link P2PLinkType) {
+	if a == b || link.BandwidthRank() == 0 {
+		return
+	}
+	if b.compare(a) < 0 {
+		a, b = b, a
+	}
+	links, _ := link.nvLinkCount()
+	edge := TopologyEdge{A: a, B: b, Link: link, Links: links}
+	for i := range t.Edges {
+		if t.Edges[i].A == a && t.Edges[i].B == b {
+			if link.BandwidthRank() > t.Edges[i].Link.BandwidthRank() {
+				t.Edges[i] = edge
+			}
+			return
+		}
+	}
+	t.Edges = append(t.Edges, edge)
+}
+
+func (t *Topology) sort() {
+	slices.SortFunc(t.Nodes, func(a, b TopologyNode) int { return a.compare(b.TopologyNodeID) })
+	slices.SortFunc(t.Edges, func(a, b TopologyEdge) int { return cmp.Or(a.A.compare(b.A), a.B.compare(b.B)) })
+}
+
+// Node returns a node of the topology
+func (t *Topology) Node(id TopologyNodeID) (TopologyNode, bool) {
+	for _, node := range t.Nodes {
+		if node.TopologyNodeID == id {
+			return node, true
+		}
+	}
+	return TopologyNode{}, false
+}
+
+// Edge returns the direct connection between two nodes
+func (t *Topology) Edge(a, b TopologyNodeID) (TopologyEdge, bool) {
+	if b.compare(a) < 0 {
+		a, b = b, a
+	}
+	for _, edge := range t.Edges {
+		if edge.A == a && edge.B == b {
+			return edge, true
+		}
+	}
+	return TopologyEdge{}, false
+}
+
+// GPUs returns the IDs of the GPUs of the topology
+func (t *Topology) GPUs() []uint {
+	return t.ids(TopologyGPU)
+}
+
+func (t *Topology) ids(kind TopologyNodeKind) []uint {
+	var ids []uint
+	for _, node := range t.Nodes {
+		if node.Kind == kind {
+			ids = append(ids, node.ID)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Link returns the direct connection between two GPUs, P2PLinkUnknown when
+// DCGM did not report one
+func (t *Topology) Link(gpuA, gpuB uint) P2PLinkType {
+	edge, ok := t.Edge(TopologyNodeID{TopologyGPU, gpuA}, TopologyNodeID{TopologyGPU, gpuB})
+	if !ok {
+		return P2PLinkUnknown
+	}
+	return edge.Link
+}
+
+func (t *Topology) adjacency() map[TopologyNodeID][]TopologyEdge {
+	adjacency := make(map[TopologyNodeID][]TopologyEdge, len(t.Nodes))
+	for _, edge := range t.Edges {
+		adjacency[edge.A] = append(adjacency[edge.A], edge)
+		adjacency[edge.B] = append(adjacency[edge.B], edge)
+	}
+	return adjacency
+}
+
+// ShortestPath returns the path with the fewest hops between two nodes,
+// preferring the fastest one among paths of equal length
+func (t *Topology) ShortestPath(from, to TopologyNodeID) (TopologyPath, bool) {
+	return t.path(from, to, 0)
+}
+
+// BestPath returns the path whose slowest connection is the fastest, preferring
+// the one with the fewest hops among paths of equal bandwidth
+func (t *Topology) BestPath(from, to TopologyNodeID) (TopologyPath, bool) {
+	ranks := make([]int, 0, len(t.Edges))
+	for _, edge := range t.Edges {
+		ranks = append(ranks, edge.Link.BandwidthRank())
+	}
+	slices.Sort(ranks)
+	ranks = slices.Compact(ranks)
+	for _, rank := range slices.Backward(ranks) {
+		if path, ok := t.path(from, to, rank); ok {
+			return path, true
+		}
+	}
+	return t.path(from, to, math.MaxInt)
+}
+
+// path searches breadth first for the path with the fewest hops using only
+// links of at least minRank, keeping the fastest path to each node of a hop
+func (t *Topology) path(from, to TopologyNodeID, minRank int) (TopologyPath, bool) {
+	if _, ok := t.Node(from); !ok {
+		return TopologyPath{}, false
+	}
+	if _, ok := t.Node(to); !ok {
+		return TopologyPath{}, false
+	}
+
+	type visit struct {
+		hops int
+		rank int
+		edge TopologyEdge
+	}
+
+	adjacency := t.adjacency()
+	visits := map[TopologyNodeID]visit{from: {rank: math.MaxInt}}
+	frontier := []TopologyNodeID{from}
+	for hops := 1; len(frontier) > 0; hops++ {
+		if _, ok := visits[to]; ok {
+			break
+		}
+		var next []TopologyNodeID
+		for _, id := range frontier {
+			for _, edge := range adjacency[id] {
+				if edge.Link.BandwidthRank() < minRank {
+					continue
+				}
+				peer := edge.other(id)
+				rank := min(visits[id].rank, edge.Link.BandwidthRank())
+				seen, ok := visits[peer]
+				switch {
+				case !ok:
+					next = append(next, peer)
+				case seen.hops < hops || seen.rank >= rank:
+					continue
+				}
+				visits[peer] = visit{hops: hops, rank: rank, edge: edge}
+			}
+		}
+		frontier = next
+	}
+
+	if _, ok := visits[to]; !ok {
+		return TopologyPath{}, false
+	}
+	path := TopologyPath{Nodes: []TopologyNodeID{to}}
+	for id := to; id != from; {
+		edge := visits[id].edge
+		id = edge.other(id)
+		path.Nodes = append(path.Nodes, id)
+		path.Edges = append(path.Edges, edge)
+	}
+	slices.Reverse(path.Nodes)
+	slices.Reverse(path.Edges)
+	return path, true
+}
+
+// Islands returns the groups of GPUs connected by NVLink, directly or through
+// NVSwitches, ordered by their first GPU. A GPU without NVLink connections is
+// an island of its own.
+func (t *Topology) Islands() [][]uint {
+	adjacency := t.adjacency()
+	seen := make(map[TopologyNodeID]bool)
+	var islands [][]uint
+	for _, gpu := range t.GPUs() {
+		start := TopologyNodeID{TopologyGPU, gpu}
+		if seen[start] {
+			continue
+		}
+		seen[start] = true
+		var island []uint
+		stack := []TopologyNodeID{start}
+		for len(stack) > 0 {
+			id := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if id.Kind == TopologyGPU {
+				island = append(island, id.ID)
+			}
+			for _, edge := range adjacency[id] {
+				peer := edge.other(id)
+				if !edge.Link.IsNvLink() || seen[peer] {
+					continue
+				}
+				if peer.Kind != TopologyGPU && peer.Kind != TopologyNVSwitch {
+					continue
+				}
+				seen[peer] = true
+				stack = append(stack, peer)
+			}
+		}
+		slices.Sort(island)
+		islands = append(islands, island)
+	}
+	return islands
+}
+
+// Bisection returns the split of the GPUs into two halves with the fewest
+// NVLinks between them. The NVLinks are counted on the connections between
+// GPUs, which on NVSwitch systems are the links of each GPU to the switches.
+// The split is searched exhaustively, which is limited to 16 GPUs.
+func (t *Topology) Bisection() (TopologyBisection, error) {
+	gpus := t.GPUs()
+	if len(gpus) > maxBisectionGPUs {
+		return TopologyBisection{}, fmt.Errorf("bisection of %d GPUs exceeds the limit of %d GPUs", len(gpus), maxBisectionGPUs)
+	}
+	if len(gpus) < 2 {
+		// A single GPU is the extra GPU of the second half
+		return TopologyBisection{Halves: [2][]uint{nil, gpus}}, nil
+	}
+
+	links := make([][]uint, len(gpus))
+	for i := range gpus {
+		links[i] = make([]uint, len(gpus))
+		for j := range gpus {
+			if edge, ok := t.Edge(TopologyNodeID{TopologyGPU, gpus[i]}, TopologyNodeID{TopologyGPU, gpus[j]}); ok && i != j {
+				links[i][j] = edge.Links
+			}
+		}
+	}
+
+	// The set bits of the mask are the GPUs of the second half. The first GPU
+	// stays in the first half so that each split is tried once.
+	best, bestLinks := uint64(0), uint(math.MaxUint)
+	for mask := uint64(0); mask < 1<<len(gpus); mask += 2 {
+		if bits.OnesCount64(mask) != len(gpus)-len(gpus)/2 {
+			continue
+		}
+		var cut uint
+		for i := range gpus {
+			for j := i + 1; j < len(gpus); j++ {
+				if (mask>>i)&1 != (mask>>j)&1 {
+					cut += links[i][j]
+				}
+			}
+		}
+		if cut < bestLinks {
+			best, bestLinks = mask, cut
+		}
+	}
+
+	bisection := TopologyBisection{Links: bestLinks}
+	for i, gpu := range gpus {
+		half := (best >> i) & 1
+		bisection.Halves[half] = append(bisection.Halves[half], gpu)
+	}
+	return bisection, nil
+}
+
+// topologyLegend explains the labels of Topology.Matrix, after nvidia-smi
+const topologyLegend = `Legend:
+
+  X    = Self
+  SYS  = Connection traversing PCIe as well as the SMP interconnect between NUMA nodes (e.g., QPI/UPI)
+  NODE = Connection traversing PCIe as well as the interconnect between PCIe Host Bridges within a NUMA node
+  PHB  = Connection traversing PCIe as well as a PCIe Host Bridge (typically the CPU)
+  PXB  = Connection traversing multiple PCIe bridges (without traversing the PCIe Host Bridge)
+  PIX  = Connection traversing at most a single PCIe bridge
+  PSB  = Connection traversing a single on-board PCIe switch
+  NV#  = Connection traversing a bonded set of # NVLinks
+`
+
+// Matrix renders the connections between GPUs like "nvidia-smi topo -m", with
+// the labels of P2PLinkType.PCIPaths, the CPU of each GPU and the cores close
+// to it
+func (t *Topology) Matrix() string {
+	gpus := t.GPUs()
+
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 8, 2, ' ', 0)
+	for _, gpu := range gpus {
+		fmt.Fprintf(w, "\tGPU%d", gpu)
+	}
+	fmt.Fprintln(w, "\tCPU\tCPU Affinity")
+	for _, a := range gpus {
+		id := TopologyNodeID{TopologyGPU, a}
+		fmt.Fprint(w, id)
+		for _, b := range gpus {
+			label := "X"
+			if a != b {
+				label = t.Link(a, b).PCIPaths()
+			}
+			fmt.Fprint(w, "\t"+label)
+		}
+		cpu, affinity := "N/A", "N/A"
+		for _, edge := range t.Edges {
+			if edge.A == id && edge.B.Kind == TopologyCPU {
+				cpu = edge.B.String()
+			}
+		}
+		if node, ok := t.Node(id); ok && node.CPUs.Len() > 0 {
+			affinity = node.CPUs.String()
+		}
+		fmt.Fprintf(w, "\t%s\t%s\n", cpu, affinity)
+	}
+	_ = w.Flush()
+
+	sb.WriteString("\n" + topologyLegend)
+	return sb.String()
+}
+
+var topologyNodeShapes = map[TopologyNodeKind]string{
+	TopologyGPU:      "box",
+	TopologyNVSwitch: "diamond",
+	TopologyCPU:      "ellipse",
+	TopologyNIC:      "hexagon",
+}
+
+// DOT renders the topology as a Graphviz graph. NVLink connections are drawn
+// bold and PCIe paths dashed, labelled like Matrix.
+func (t *Topology) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("graph topology {\n")
+	for _, node := range t.Nodes {
+		label := node.String()
+		if node.BusID != "" {
+			// \n is the DOT escape of a centered line break
+			label += `\n` + node.BusID
+		}
+		fmt.Fprintf(&sb, "\t%s [shape=%s, label=%s];\n", dotQuote(node.String()), topologyNodeShapes[node.Kind], dotQuote(label))
+	}
+	for _, edge := range t.Edges {
+		style := "dashed"
+		if edge.Link.IsNvLink() {
+			style = "bold"
+		}
+		fmt.Fprintf(&sb, "\t%s -- %s [label=%s, style=%s];\n",
+			dotQuote(edge.A.String()), dotQuote(edge.B.String()), dotQuote(edge.Link.PCIPaths()), style)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// dotQuote quotes a DOT ID. Only double quotes need escaping: DOT keeps other
+// backslash sequences, such as the \n line break, for the label renderer.
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// pciAddress is the domain, bus and device of a PCI bus ID
+type pciAddress struct {
+	domain, bus, device uint64
+}
+
+// parsePCIAddress parses a PCI bus ID such as "00000000:3B:00.0"
+func parsePCIAddress(busID string) (pciAddress, bool) {
+	var address pciAddress
+	var function uint64
+	if _, err := fmt.Sscanf(busID, "%x:%x:%x.%x", &address.domain, &address.bus, &address.device, &function); err != nil {
+		return pciAddress{}, false
+	}
+	return address, true
+}
+
+func (a pciAddress) String() string {
+	return fmt.Sprintf("%08X:%02X:%02X.0", a.domain, a.bus, a.device)
+}
+
+// getTopology builds the topology of the DCGM supported GPUs. The GPU paths
+// are required; CPUs, NVSwitches and NICs are added when DCGM reports them.
+func getTopology() (*Topology, error) {
+	gpus, err := getSupportedDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	topology := &Topology{}
+	if len(gpus) == 0 {
+		return topology, nil
+	}
+
+	gpuLinks, err := getGPUBusIDs(gpus)
+	if err != nil {
+		return nil, err
+	}
+
+	affinities := make(map[uint]CPUSet, len(gpus))
+	for _, gpu := range gpuLinks {
+		node := TopologyNode{TopologyNodeID: TopologyNodeID{TopologyGPU, gpu.GPU}, BusID: gpu.BusID}
+		if cpus, _, err := getAffinityMasks(gpu.GPU); err == nil && cpus.Len() > 0 {
+			affinities[gpu.GPU] = cpus
+			node.CPUs = cpus
+		}
+		topology.addNode(node)
+
+		links, err := getDeviceTopologyPaths(gpu.GPU)
+		if err != nil {
+			return nil, fmt.Errorf("get topology of GPU %d: %w", gpu.GPU, err)
+		}
+		for _, link := range links {
+			topology.addEdge(node.TopologyNodeID, TopologyNodeID{TopologyGPU, link.GPU}, link.Link)
+		}
+	}
+
+	topology.addCPUs(gpus, affinities)
+	topology.addNVSwitches(gpuLinks)
+	topology.addNICs()
+	topology.sort()
+	return topology, nil
+}
+
+// addCPUs adds the CPUs reported by DCGM or, on systems whose CPUs DCGM does
+// not manage, a NUMA node per distinct GPU CPU affinity. Each GPU is connected
+// to the CPU owning most of its close cores.
+func (t *Topology) addCPUs(gpus []uint, affinities map[uint]CPUSet) {
+	type cpu struct {
+		id    uint
+		cores CPUSet
+	}
+
+	var cpus []cpu
+	if hierarchy, err := GetCPUHierarchy_v2(); err == nil {
+		for _, c := range hierarchy.CPUs[:hierarchy.NumCPUs] {
+			cpus = append(cpus, cpu{id: c.CPUID, cores: c.Cores()})
+		}
+	}
+	if len(cpus) == 0 {
+		for _, gpu := range gpus {
+			affinity, ok := affinities[gpu]
+			if ok && !slices.ContainsFunc(cpus, func(c cpu) bool { return c.cores.Equal(affinity) }) {
+				cpus = append(cpus, cpu{cores: affinity})
+			}
+		}
+		slices.SortFunc(cpus, func(a, b cpu) int { return cmp.Compare(a.cores.List()[0], b.cores.List()[0]) })
+		for i := range cpus {
+			cpus[i].id = uint(i)
+		}
+	}
+
+	for i, c := range cpus {
+		id := TopologyNodeID{TopologyCPU, c.id}
+		t.addNode(TopologyNode{TopologyNodeID: id, CPUs: c.cores})
+		for _, peer := range cpus[:i] {
+			t.addEdge(id, TopologyNodeID{TopologyCPU, peer.id}, P2PLinkCrossCPU)
+		}
+	}
+
+	for gpu, affinity := range affinities {
+		var local *cpu
+		var shared int
+		for i := range cpus {
+			if n := cpus[i].cores.Intersection(affinity).Len(); n > shared {
+				local, shared = &cpus[i], n
+			}
+		}
+		if local != nil {
+			t.addEdge(TopologyNodeID{TopologyGPU, gpu}, TopologyNodeID{TopologyCPU, local.id}, P2PLinkHostBridge)
+		}
+	}
+}
+
+// addNVSwitches adds the NVSwitches and connects them to the GPUs at the remote
+// end of their active links
+func (t *Topology) addNVSwitches(gpus []P2PLink) {
+	switches, err := readNvSwitches(gpuPCIAddresses(gpus))
+	if err != nil {
+		return
+	}
+	for _, nvSwitch := range switches {
+		node := TopologyNode{TopologyNodeID: TopologyNodeID{TopologyNVSwitch, nvSwitch.Switch}, BusID: nvSwitch.BusID}
+		t.addNode(node)
+
+		links := make(map[uint]uint)
+		for _, link := range nvSwitch.Links {
+			if link.RemoteGPU >= 0 {
+				links[uint(link.RemoteGPU)]++
+			}
+		}
+		for gpu, count := range links {
+			t.addEdge(TopologyNodeID{TopologyGPU, gpu}, node.TopologyNodeID, nvLinkP2PLinkType(count))
+		}
+	}
+}
+
+// addNICs adds the ConnectX adapters. DCGM does not report their PCIe paths,
+// so they are not connected.
+func (t *Topology) addNICs() {
+	nics, err := getEntityGroupEntities(FE_CONNECTX)
+	if err != nil {
+		return
+	}
+	for _, nic := range nics {
+		t.addNode(TopologyNode{TopologyNodeID: TopologyNodeID{TopologyNIC, nic}})
+	}
+}
@@ -0,0 +1,170 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gpuNode(id uint) TopologyNodeID {
+	return TopologyNodeID{TopologyGPU, id}
+}
+
+// testTopology is two pairs of NVLink connected GPUs on two CPUs, GPU1 and GPU3
+// being also connected by NVLink, and two GPUs behind an NVSwitch
+func testTopology() *Topology {
+	topology := &Topology{}
+	for gpu := range uint(6) {
+		topology.addNode(TopologyNode{TopologyNodeID: gpuNode(gpu), CPUs: NewCPUSet(0, 1, 2, 3)})
+	}
+	topology.addNode(TopologyNode{TopologyNodeID: TopologyNodeID{TopologyCPU, 0}, CPUs: NewCPUSet(0, 1, 2, 3)})
+	topology.addNode(TopologyNode{TopologyNodeID: TopologyNodeID{TopologyCPU, 1}, CPUs: NewCPUSet(4, 5, 6, 7)})
+	topology.addNode(TopologyNode{TopologyNodeID: TopologyNodeID{TopologyNVSwitch, 0}, BusID: "00000000:A1:00.0"})
+
+	topology.addEdge(gpuNode(0), gpuNode(1), FourNVLINKLinks)
+	topology.addEdge(gpuNode(1), gpuNode(0), P2PLinkSameCPU)
+	topology.addEdge(gpuNode(2), gpuNode(3), FourNVLINKLinks)
+	topology.addEdge(gpuNode(1), gpuNode(3), SingleNVLINKLink)
+	topology.addEdge(gpuNode(0), gpuNode(2), P2PLinkCrossCPU)
+	topology.addEdge(gpuNode(0), gpuNode(3), P2PLinkCrossCPU)
+	topology.addEdge(gpuNode(1), gpuNode(2), P2PLinkCrossCPU)
+	topology.addEdge(gpuNode(4), TopologyNodeID{TopologyNVSwitch, 0}, nvLinkP2PLinkType(6))
+	topology.addEdge(gpuNode(5), TopologyNodeID{TopologyNVSwitch, 0}, nvLinkP2PLinkType(6))
+	topology.addEdge(gpuNode(4), gpuNode(4), P2PLinkSameBoard)
+	for gpu := range uint(2) {
+		topology.addEdge(gpuNode(gpu), TopologyNodeID{TopologyCPU, 0}, P2PLinkHostBridge)
+		topology.addEdge(gpuNode(gpu+2), TopologyNodeID{TopologyCPU, 1}, P2PLinkHostBridge)
+	}
+	topology.addEdge(TopologyNodeID{TopologyCPU, 1}, TopologyNodeID{TopologyCPU, 0}, P2PLinkCrossCPU)
+	topology.sort()
+	return topology
+}
+
+func TestTopologyEdges(t *testing.T) {
+	topology := testTopology()
+
+	edge, ok := topology.Edge(gpuNode(1), gpuNode(0))
+	require.True(t, ok)
+	assert.Equal(t, TopologyEdge{A: gpuNode(0), B: gpuNode(1), Link: FourNVLINKLinks, Links: 4}, edge, "the fastest link is kept")
+	assert.Equal(t, P2PLinkCrossCPU, topology.Link(2, 0))
+	assert.Equal(t, P2PLinkUnknown, topology.Link(0, 4))
+	_, ok = topology.Edge(gpuNode(4), gpuNode(4))
+	assert.False(t, ok, "nodes are not connected to themselves")
+	assert.Equal(t, []uint{0, 1, 2, 3, 4, 5}, topology.GPUs())
+	assert.Equal(t, "NVSwitch0", topology.Nodes[len(topology.Nodes)-3].String())
+}
+
+func TestTopologyPaths(t *testing.T) {
+	topology := testTopology()
+
+	path, ok := topology.ShortestPath(gpuNode(0), gpuNode(3))
+	require.True(t, ok)
+	assert.Equal(t, []TopologyNodeID{gpuNode(0), gpuNode(3)}, path.Nodes)
+	assert.Equal(t, P2PLinkCrossCPU, path.Link())
+
+	path, ok = topology.BestPath(gpuNode(0), gpuNode(3))
+	require.True(t, ok)
+	assert.Equal(t, []TopologyNodeID{gpuNode(0), gpuNode(1), gpuNode(3)}, path.Nodes)
+	assert.Equal(t, SingleNVLINKLink, path.Link())
+
+	path, ok = topology.BestPath(gpuNode(0), gpuNode(2))
+	require.True(t, ok)
+	assert.Equal(t, []TopologyNodeID{gpuNode(0), gpuNode(1), gpuNode(3), gpuNode(2)}, path.Nodes)
+
+	path, ok = topology.BestPath(gpuNode(4), gpuNode(5))
+	require.True(t, ok)
+	assert.Equal(t, []TopologyNodeID{gpuNode(4), {TopologyNVSwitch, 0}, gpuNode(5)}, path.Nodes)
+	assert.Equal(t, uint(6), path.Edges[0].Links)
+
+	path, ok = topology.BestPath(gpuNode(2), gpuNode(2))
+	require.True(t, ok)
+	assert.Equal(t, []TopologyNodeID{gpuNode(2)}, path.Nodes)
+	assert.Empty(t, path.Edges)
+
+	_, ok = topology.ShortestPath(gpuNode(0), gpuNode(4))
+	assert.False(t, ok)
+	_, ok = topology.BestPath(gpuNode(0), gpuNode(9))
+	assert.False(t, ok)
+}
+
+func TestTopologyIslands(t *testing.T) {
+	assert.Equal(t, [][]uint{{0, 1, 2, 3}, {4, 5}}, testTopology().Islands())
+}
+
+func TestTopologyBisection(t *testing.T) {
+	topology := testTopology()
+
+	bisection, err := topology.Bisection()
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), bisection.Links)
+	assert.Len(t, bisection.Halves[0], 3)
+	assert.Len(t, bisection.Halves[1], 3)
+
+	// Without the NVSwitch GPUs the NVLink pairs are split apart at best
+	four := &Topology{Nodes: topology.Nodes[:4], Edges: topology.Edges}
+	bisection, err = four.Bisection()
+	require.NoError(t, err)
+	assert.Equal(t, TopologyBisection{Halves: [2][]uint{{0, 1}, {2, 3}}, Links: 1}, bisection)
+
+	large := &Topology{}
+	for gpu := range uint(maxBisectionGPUs + 1) {
+		large.addNode(TopologyNode{TopologyNodeID: gpuNode(gpu)})
+	}
+	_, err = large.Bisection()
+	require.Error(t, err)
+
+	single := &Topology{Nodes: topology.Nodes[:1]}
+	bisection, err = single.Bisection()
+	require.NoError(t, err)
+	assert.Equal(t, TopologyBisection{Halves: [2][]uint{nil, {0}}, Links: 0}, bisection)
+
+	bisection, err = (&Topology{}).Bisection()
+	require.NoError(t, err)
+	assert.Zero(t, bisection.Links)
+}
+
+func TestDOTQuote(t *testing.T) {
+	assert.Equal(t, `"GPU0"`, dotQuote("GPU0"))
+	assert.Equal(t, `"a \"b\"\nc"`, dotQuote(`a "b"\nc`))
+}
+
+func TestTopologyMatrix(t *testing.T) {
+	four := testTopology()
+	four.Nodes = append(four.Nodes[:4:4], four.Nodes[6:]...)
+
+	lines := []string{
+		"      GPU0  GPU1  GPU2  GPU3  CPU   CPU Affinity",
+		"GPU0  X     NV4   SYS   SYS   CPU0  0-3",
+		"GPU1  NV4   X     SYS   NV1   CPU0  0-3",
+		"GPU2  SYS   SYS   X     NV4   CPU1  0-3",
+		"GPU3  SYS   NV1   NV4   X     CPU1  0-3",
+		"",
+		"Legend:",
+	}
+	matrix := four.Matrix()
+	for _, line := range lines {
+		assert.Contains(t, matrix, line+"\n")
+	}
+}
+
+func TestTopologyDOT(t *testing.T) {
+	dot := testTopology().DOT()
+
+	assert.Contains(t, dot, "graph topology {\n")
+	assert.Contains(t, dot, "\t\"GPU0\" [shape=box, label=\"GPU0\"];\n")
+	assert.Contains(t, dot, "\t\"NVSwitch0\" [shape=diamond, label=\"NVSwitch0\\n00000000:A1:00.0\"];\n")
+	assert.NotContains(t, dot, `\\`)
+	assert.Contains(t, dot, "\t\"GPU0\" -- \"GPU1\" [label=\"NV4\", style=bold];\n")
+	assert.Contains(t, dot, "\t\"GPU0\" -- \"CPU0\" [label=\"PHB\", style=dashed];\n")
+}
+
+func TestParsePCIAddress(t *testing.T) {
+	address, ok := parsePCIAddress("00000000:3b:00.0")
+	require.True(t, ok)
+	assert.Equal(t, pciAddress{domain: 0, bus: 0x3b, device: 0}, address)
+	assert.Equal(t, "00000000:3B:00.0", address.String())
+
+	_, ok = parsePCIAddress("N/A")
+	assert.False(t, ok)
+}
@@ -0,0 +1,363 @@
+package dcgm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ValueState describes whether a field value carries real data or one of the
+// DCGM sentinel values used to report missing data.
+type ValueState int
+
+const (
+	// ValueStateOK indicates the value holds real data
+	ValueStateOK ValueState = iota
+	// ValueStateBlank indicates DCGM has no data for the field (DCGM_FT_*_BLANK)
+	ValueStateBlank
+	// ValueStateNotFound indicates the field was not found (DCGM_FT_*_NOT_FOUND)
+	ValueStateNotFound
+	// ValueStateNotSupported indicates the field is not supported by the entity (DCGM_FT_*_NOT_SUPPORTED)
+	ValueStateNotSupported
+	// ValueStatePermission indicates the caller is not permitted to read the field (DCGM_FT_*_NOT_PERMISSIONED)
+	ValueStatePermission
+	// ValueStateError indicates DCGM returned a non-OK status for the value
+	ValueStateError
+)
+
+// String returns a human-readable representation of the value state.
+func (s ValueState) String() string {
+	switch s {
+	case ValueStateOK:
+		return "OK"
+	case ValueStateBlank:
+		return "Blank"
+	case ValueStateNotFound:
+		return "NotFound"
+	case ValueStateNotSupported:
+		return "NotSupported"
+	case ValueStatePermission:
+		return "Permission"
+	case ValueStateError:
+		return "Error"
+	default:
+		return fmt.Sprintf("ValueState(%d)", int(s))
+	}
+}
+
+// Unit is the unit of measurement of a DCGM field value.
+type Unit string
+
+const (
+	// UnitNone is used for dimensionless values, identifiers and strings
+	UnitNone Unit = ""
+	// UnitCelsius is degrees Celsius
+	UnitCelsius Unit = "celsius"
+	// UnitWatts is watts
+	UnitWatts Unit = "watts"
+	// UnitMilliwatts is milliwatts
+	UnitMilliwatts Unit = "milliwatts"
+	// UnitJoules is joules
+	UnitJoules Unit = "joules"
+	// UnitMillijoules is millijoules
+	UnitMillijoules Unit = "millijoules"
+	// UnitHertz is hertz
+	UnitHertz Unit = "hertz"
+	// UnitMegahertz is megahertz
+	UnitMegahertz Unit = "megahertz"
+	// UnitBytes is bytes
+	UnitBytes Unit = "bytes"
+	// UnitKibibytes is kibibytes
+	UnitKibibytes Unit = "kibibytes"
+	// UnitMebibytes is mebibytes, which DCGM documents as "MB"
+	UnitMebibytes Unit = "mebibytes"
+	// UnitBytesPerSecond is bytes per second
+	UnitBytesPerSecond Unit = "bytes_per_second"
+	// UnitMebibytesPerSecond is mebibytes per second, which DCGM documents as "MB/s"
+	UnitMebibytesPerSecond Unit = "mebibytes_per_second"
+	// UnitRatio is a ratio between 0.0 and 1.0
+	UnitRatio Unit = "ratio"
+	// UnitPercent is a percentage between 0 and 100
+	UnitPercent Unit = "percent"
+	// UnitNanoseconds is nanoseconds
+	UnitNanoseconds Unit = "nanoseconds"
+	// UnitMicroseconds is microseconds
+	UnitMicroseconds Unit = "microseconds"
+	// UnitMilliseconds is milliseconds
+	UnitMilliseconds Unit = "milliseconds"
+	// UnitSeconds is seconds
+	UnitSeconds Unit = "seconds"
+	// UnitVolts is volts
+	UnitVolts Unit = "volts"
+	// UnitMillivolts is millivolts
+	UnitMillivolts Unit = "millivolts"
+	// UnitMilliamps is milliamperes
+	UnitMilliamps Unit = "milliamps"
+	// UnitRPM is revolutions per minute
+	UnitRPM Unit = "rpm"
+	// UnitCount is a number of events or items
+	UnitCount Unit = "count"
+)
+
+// TypedValue is a field value decoded according to its DCGM field type.
+//
+// Only the accessor matching Type is meaningful, and only when State is
+// ValueStateOK. Sentinel values such as DCGM_FT_INT64_NOT_SUPPORTED are never
+// exposed as data; they are reported through State instead.
+type TypedValue struct {
+	FieldID       Short
+	Type          uint
+	EntityGroupId Field_Entity_Group
+	EntityID      uint
+	State         ValueState
+	// Status is the DCGM status code the value was returned with
+	Status int
+	// Timestamp is when DCGM sampled the value
+	Timestamp time.Time
+	Unit      Unit
+
+	intValue    int64
+	floatValue  float64
+	stringValue string
+	timeValue   time.Time
+	blobValue   []byte
+}
+
+// TypedValue decodes the field value according to its FieldType.
+func (fv FieldValue_v1) TypedValue() TypedValue {
+	return decodeTypedValue(FE_NONE, 0, fv.FieldID, fv.FieldType, fv.Status, fv.TS, fv.Value[:], nil)
+}
+
+// TypedValue decodes the field value according to its FieldType.
+func (fv FieldValue_v2) TypedValue() TypedValue {
+	return decodeTypedValue(fv.EntityGroupId, fv.EntityID, fv.FieldID, fv.FieldType, fv.Status, fv.TS,
+		fv.Value[:], fv.StringValue)
+}
+
+// IsValid reports whether the value holds real data.
+func (tv TypedValue) IsValid() bool {
+	return tv.State == ValueStateOK
+}
+
+// Int64 returns the value of a DCGM_FT_INT64 or DCGM_FT_TIMESTAMP field.
+// The boolean is false if the field has another type or holds no data.
+func (tv TypedValue) Int64() (int64, bool) {
+	if !tv.IsValid() || (tv.Type != DCGM_FT_INT64 && tv.Type != DCGM_FT_TIMESTAMP) {
+		return 0, false
+	}
+
+	return tv.intValue, true
+}
+
+// Float64 returns the value of a DCGM_FT_DOUBLE field.
+// The boolean is false if the field has another type or holds no data.
+func (tv TypedValue) Float64() (float64, bool) {
+	if !tv.IsValid() || tv.Type != DCGM_FT_DOUBLE {
+		return 0, false
+	}
+
+	return tv.floatValue, true
+}
+
+// Number returns the value of any numeric field as a float64, which is what
+// most metric exporters need. The boolean is false for strings, binary blobs
+// and values that hold no data.
+func (tv TypedValue) Number() (float64, bool) {
+	if !tv.IsValid() {
+		return 0, false
+	}
+
+	switch tv.Type {
+	case DCGM_FT_INT64, DCGM_FT_TIMESTAMP:
+		return float64(tv.intValue), true
+	case DCGM_FT_DOUBLE:
+		return tv.floatValue, true
+	default:
+		return 0, false
+	}
+}
+
+// Str returns the value of a DCGM_FT_STRING field.
+// The boolean is false if the field has another type or holds no data.
+func (tv TypedValue) Str() (string, bool) {
+	if !tv.IsValid() || tv.Type != DCGM_FT_STRING {
+		return "", false
+	}
+
+	return tv.stringValue, true
+}
+
+// Time returns the value of a DCGM_FT_TIMESTAMP field.
+// The boolean is false if the field has another type or holds no data.
+func (tv TypedValue) Time() (time.Time, bool) {
+	if !tv.IsValid() || tv.Type != DCGM_FT_TIMESTAMP {
+		return time.Time{}, false
+	}
+
+	return tv.timeValue, true
+}
+
+// Blob returns the payload of a DCGM_FT_BINARY field.
+// The boolean is false if the field has another type or holds no data.
+func (tv TypedValue) Blob() ([]byte, bool) {
+	if !tv.IsValid() || tv.Type != DCGM_FT_BINARY {
+		return nil, false
+	}
+
+	return tv.blobValue, true
+}
+
+// String formats the value for display, including its unit when known.
+func (tv TypedValue) String() string {
+	if !tv.IsValid() {
+		return tv.State.String()
+	}
+
+	var s string
+	switch tv.Type {
+	case DCGM_FT_INT64:
+		s = strconv.FormatInt(tv.intValue, 10)
+	case DCGM_FT_DOUBLE:
+		s = strconv.FormatFloat(tv.floatValue, 'f', -1, 64)
+	case DCGM_FT_STRING:
+		return tv.stringValue
+	case DCGM_FT_TIMESTAMP:
+		return tv.timeValue.Format(time.RFC3339Nano)
+	case DCGM_FT_BINARY:
+		return fmt.Sprintf("<%d bytes>", len(tv.blobValue))
+	default:
+		return fmt.Sprintf("<unknown field type %q>", rune(tv.Type))
+	}
+
+	if tv.Unit != UnitNone {
+		s += " " + string(tv.Unit)
+	}
+
+	return s
+}
+
+func decodeTypedValue(
+	entityGroup Field_Entity_Group, entityID uint, fieldID Short, fieldType uint, status int, ts int64,
+	value []byte, stringValue *string,
+) TypedValue {
+	tv := TypedValue{
+		FieldID:       fieldID,
+		Type:          fieldType,
+		EntityGroupId: entityGroup,
+		EntityID:      entityID,
+		Status:        status,
+		Timestamp:     timestampUSECToTime(ts),
+		Unit:          unitForField(fieldID),
+	}
+
+	if status != DCGM_ST_OK {
+		tv.State = stateForStatus(status)
+		return tv
+	}
+
+	switch fieldType {
+	case DCGM_FT_INT64, DCGM_FT_TIMESTAMP:
+		v := int64(binary.NativeEndian.Uint64(value))
+		tv.State = int64State(v)
+		tv.intValue = v
+		if fieldType == DCGM_FT_TIMESTAMP && tv.State == ValueStateOK {
+			tv.timeValue = timestampUSECToTime(v)
+		}
+	case DCGM_FT_DOUBLE:
+		v := math.Float64frombits(binary.NativeEndian.Uint64(value))
+		tv.State = float64State(v)
+		tv.floatValue = v
+	case DCGM_FT_STRING:
+		var s string
+		if stringValue != nil {
+			s = *stringValue
+		} else {
+			s = cString(value)
+		}
+		tv.State = stringState(s)
+		tv.stringValue = s
+	case DCGM_FT_BINARY:
+		tv.blobValue = append([]byte(nil), value...)
+	default:
+		tv.State = ValueStateError
+	}
+
+	return tv
+}
+
+// stateForStatus maps a non-OK DCGM status to the closest value state.
+func stateForStatus(status int) ValueState {
+	switch status {
+	case DCGM_ST_NO_DATA, DCGM_ST_NOT_WATCHED, DCGM_ST_STALE_DATA:
+		return ValueStateBlank
+	case DCGM_ST_NOT_SUPPORTED:
+		return ValueStateNotSupported
+	case DCGM_ST_NO_PERMISSION:
+		return ValueStatePermission
+	default:
+		return ValueStateError
+	}
+}
+
+func int64State(v int64) ValueState {
+	if v < DCGM_FT_INT64_BLANK {
+		return ValueStateOK
+	}
+
+	return sentinelState(v - DCGM_FT_INT64_BLANK)
+}
+
+func float64State(v float64) ValueState {
+	if v < DCGM_FT_FP64_BLANK {
+		return ValueStateOK
+	}
+
+	return sentinelState(int64(v - DCGM_FT_FP64_BLANK))
+}
+
+func stringState(s string) ValueState {
+	switch s {
+	case DCGM_FT_STR_BLANK:
+		return ValueStateBlank
+	case DCGM_FT_STR_NOT_FOUND:
+		return ValueStateNotFound
+	case DCGM_FT_STR_NOT_SUPPORTED:
+		return ValueStateNotSupported
+	case DCGM_FT_STR_NOT_PERMISSIONED:
+		return ValueStatePermission
+	default:
+		return ValueStateOK
+	}
+}
+
+// sentinelState maps the offset of a value from the type's BLANK sentinel.
+func sentinelState(offset int64) ValueState {
+	switch offset {
+	case 1:
+		return ValueStateNotFound
+	case 2:
+		return ValueStateNotSupported
+	case 3:
+		return ValueStatePermission
+	default:
+		return ValueStateBlank
+	}
+}
+
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+
+	return string(b)
+}
+
+// unitForField returns the unit recorded for a field in the generated
+// field metadata table.
+func unitForField(fieldID Short) Unit {
+	info, _ := GetFieldInfo(fieldID)
+	return info.Unit
+}
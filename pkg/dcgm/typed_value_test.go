@@ -0,0 +1,136 @@
+package dcgm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func typedTestValue(fieldID Short, fieldType uint, payload []byte) FieldValue_v2 {
+	fieldValue := FieldValue_v2{
+		EntityGroupId: FE_GPU,
+		EntityID:      3,
+		FieldID:       fieldID,
+		FieldType:     fieldType,
+		Status:        DCGM_ST_OK,
+		TS:            1700000000123456,
+	}
+	copy(fieldValue.Value[:], payload)
+	return fieldValue
+}
+
+func TestTypedValueSentinels(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     FieldValue_v2
+		wantState ValueState
+	}{
+		{"int64 value", typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(42)), ValueStateOK},
+		{"int64 blank", typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(DCGM_FT_INT64_BLANK)), ValueStateBlank},
+		{"int64 not found", typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(DCGM_FT_INT64_NOT_FOUND)), ValueStateNotFound},
+		{"int64 not supported", typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(DCGM_FT_INT64_NOT_SUPPORTED)), ValueStateNotSupported},
+		{"int64 not permissioned", typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(DCGM_FT_INT64_NOT_PERMISSIONED)), ValueStatePermission},
+		{"double value", typedTestValue(DCGM_FI_DEV_BOARD_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(250.5)), ValueStateOK},
+		{"double blank", typedTestValue(DCGM_FI_DEV_BOARD_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(DCGM_FT_FP64_BLANK)), ValueStateBlank},
+		{"double not supported", typedTestValue(DCGM_FI_DEV_BOARD_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(DCGM_FT_FP64_NOT_SUPPORTED)), ValueStateNotSupported},
+		{"double not permissioned", typedTestValue(DCGM_FI_DEV_BOARD_POWER_WATTS, DCGM_FT_DOUBLE, float64Bytes(DCGM_FT_FP64_NOT_PERMISSIONED)), ValueStatePermission},
+		{"string value", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_STRING, []byte("NVIDIA H100")), ValueStateOK},
+		{"string blank", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_STRING, []byte(DCGM_FT_STR_BLANK)), ValueStateBlank},
+		{"string not found", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_STRING, []byte(DCGM_FT_STR_NOT_FOUND)), ValueStateNotFound},
+		{"string not supported", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_STRING, []byte(DCGM_FT_STR_NOT_SUPPORTED)), ValueStateNotSupported},
+		{"string not permissioned", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_STRING, []byte(DCGM_FT_STR_NOT_PERMISSIONED)), ValueStatePermission},
+		{"timestamp blank", typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_TIMESTAMP, int64Bytes(DCGM_FT_INT64_BLANK)), ValueStateBlank},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tv := tt.value.TypedValue()
+			assert.Equal(t, tt.wantState, tv.State)
+			assert.Equal(t, tt.wantState == ValueStateOK, tv.IsValid())
+			if tt.wantState != ValueStateOK {
+				_, ok := tv.Number()
+				assert.False(t, ok, "sentinel values must not be exposed as numbers")
+				assert.Equal(t, tt.wantState.String(), tv.String())
+			}
+		})
+	}
+}
+
+func TestTypedValueStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		wantState ValueState
+	}{
+		{DCGM_ST_NO_DATA, ValueStateBlank},
+		{DCGM_ST_NOT_WATCHED, ValueStateBlank},
+		{DCGM_ST_NOT_SUPPORTED, ValueStateNotSupported},
+		{DCGM_ST_NO_PERMISSION, ValueStatePermission},
+		{DCGM_ST_GENERIC_ERROR, ValueStateError},
+	}
+
+	for _, tt := range tests {
+		value := typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(42))
+		value.Status = tt.status
+		tv := value.TypedValue()
+		assert.Equal(t, tt.wantState, tv.State, "status %d", tt.status)
+		assert.Equal(t, tt.status, tv.Status)
+	}
+}
+
+func TestTypedValueDecoding(t *testing.T) {
+	t.Run("int64 carries unit and entity", func(t *testing.T) {
+		tv := typedTestValue(DCGM_FI_DEV_GPU_TEMP_CELSIUS, DCGM_FT_INT64, int64Bytes(42)).TypedValue()
+		v, ok := tv.Int64()
+		require.True(t, ok)
+		assert.Equal(t, int64(42), v)
+		_, ok = tv.Float64()
+		assert.False(t, ok)
+		assert.Equal(t, UnitCelsius, tv.Unit)
+		assert.Equal(t, FE_GPU, tv.EntityGroupId)
+		assert.Equal(t, uint(3), tv.EntityID)
+		assert.Equal(t, "42 celsius", tv.String())
+		assert.Equal(t, time.UnixMicro(1700000000123456), tv.Timestamp)
+	})
+
+	t.Run("double", func(t *testing.T) {
+		tv := typedTestValue(DCGM_FI_DEV_FB_USED_RATIO, DCGM_FT_DOUBLE, float64Bytes(0.25)).TypedValue()
+		v, ok := tv.Float64()
+		require.True(t, ok)
+		assert.InDelta(t, 0.25, v, 0)
+		n, ok := tv.Number()
+		require.True(t, ok)
+		assert.InDelta(t, 0.25, n, 0)
+	})
+
+	t.Run("string prefers the decoded StringValue", func(t *testing.T) {
+		value := typedTestValue(DCGM_FI_DEV_GPU_UUID, DCGM_FT_STRING, []byte("raw"))
+		decoded := "GPU-1234"
+		value.StringValue = &decoded
+		v, ok := value.TypedValue().Str()
+		require.True(t, ok)
+		assert.Equal(t, "GPU-1234", v)
+	})
+
+	t.Run("timestamp converts to time", func(t *testing.T) {
+		tv := typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_TIMESTAMP, int64Bytes(1500000000000001)).TypedValue()
+		v, ok := tv.Time()
+		require.True(t, ok)
+		assert.Equal(t, time.UnixMicro(1500000000000001), v)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		tv := typedTestValue(DCGM_FI_DEV_GPU_NAME, DCGM_FT_BINARY, []byte{1, 2, 3}).TypedValue()
+		v, ok := tv.Blob()
+		require.True(t, ok)
+		assert.Len(t, v, 4096)
+		assert.Equal(t, []byte{1, 2, 3}, v[:3])
+	})
+
+	t.Run("v1 values decode the same way", func(t *testing.T) {
+		v1 := FieldValue_v1{FieldID: DCGM_FI_DEV_BOARD_POWER_WATTS, FieldType: DCGM_FT_INT64, Status: DCGM_ST_OK}
+		copy(v1.Value[:], int64Bytes(DCGM_FT_INT64_NOT_SUPPORTED))
+		assert.Equal(t, ValueStateNotSupported, v1.TypedValue().State)
+	})
+}
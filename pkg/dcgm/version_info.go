@@ -23,6 +23,7 @@ package dcgm
 import "C"
 
 import (
+	"strings"
 	"unsafe"
 )
 
@@ -33,6 +34,24 @@ type VersionInfo struct {
 	RawBuildInfoString string
 }
 
+// Fields returns the key-value pairs of RawBuildInfoString
+func (v VersionInfo) Fields() map[string]string {
+	fields := make(map[string]string)
+	for _, pair := range strings.Split(v.RawBuildInfoString, ";") {
+		key, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return fields
+}
+
+// Version returns the DCGM version, such as "4.2.3", or an empty string if it is not reported
+func (v VersionInfo) Version() string {
+	return v.Fields()["version"]
+}
+
 func versionInfo() (VersionInfo, error) {
 	var cVersionInfo C.dcgmVersionInfo_t
 	cVersionInfo.version = makeVersion2(unsafe.Sizeof(cVersionInfo))
@@ -0,0 +1,22 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionInfoFields(t *testing.T) {
+	info := VersionInfo{
+		RawBuildInfoString: "version:4.2.3;arch:x86_64;buildtype:Release;commit:0123abc;builddate:2025-06-01 10:00:00;",
+	}
+
+	fields := info.Fields()
+	assert.Equal(t, "4.2.3", fields["version"])
+	assert.Equal(t, "x86_64", fields["arch"])
+	assert.Equal(t, "2025-06-01 10:00:00", fields["builddate"])
+	assert.Len(t, fields, 5)
+	assert.Equal(t, "4.2.3", info.Version())
+
+	assert.Empty(t, VersionInfo{}.Version())
+}
@@ -0,0 +1,215 @@
+package dcgm
+
+import (
+	"cmp"
+	"slices"
+)
+
+// XIDAction is the recommended response to an XID error
+type XIDAction string
+
+const (
+	// XIDActionNone means the error is informational or handled by the driver
+	XIDActionNone = XIDAction("none")
+	// XIDActionCheckApplication means the error is most likely caused by the
+	// application, which should be checked and restarted
+	XIDActionCheckApplication = XIDAction("check application")
+	// XIDActionResetGPU means the GPU must be reset before it is used again
+	XIDActionResetGPU = XIDAction("reset GPU")
+	// XIDActionDrainNode means the node must be drained for a reboot or a
+	// hardware inspection
+	XIDActionDrainNode = XIDAction("drain node")
+)
+
+// XIDInfo describes an XID error
+type XIDInfo struct {
+	// XID is the XID error number
+	XID uint `json:"xid"`
+	// Name is the short name of the error
+	Name string `json:"name"`
+	// Description explains the error
+	Description string `json:"description"`
+	// Cause is the most likely cause of the error
+	Cause string `json:"cause"`
+	// Action is the recommended response
+	Action XIDAction `json:"action"`
+}
+
+// Severity returns the severity of a policy violation for the error: warning
+// when the application or nobody needs to act, error when the GPU must be reset
+// and critical when the node must be drained
+func (x XIDInfo) Severity() PolicySeverity {
+	switch x.Action {
+	case XIDActionResetGPU:
+		return PolicySeverityError
+	case XIDActionDrainNode:
+		return PolicySeverityCritical
+	default:
+		return PolicySeverityWarning
+	}
+}
+
+// xidCatalog lists the XID errors most commonly seen on data center GPUs, after
+// the NVIDIA XID errors documentation
+var xidCatalog = map[uint]XIDInfo{
+	8: {
+		Name:        "GPU stopped processing",
+		Description: "The GPU stopped processing work and the driver could not recover the channel",
+		Cause:       "Driver error, application error, bus error or thermal issue",
+		Action:      XIDActionResetGPU,
+	},
+	13: {
+		Name:        "Graphics engine exception",
+		Description: "The graphics engine raised an exception, such as an out-of-range memory access or an illegal instruction",
+		Cause:       "Application error; rarely a hardware or driver error",
+		Action:      XIDActionCheckApplication,
+	},
+	31: {
+		Name:        "GPU memory page fault",
+		Description: "A GPU engine accessed an invalid memory address",
+		Cause:       "Application error, such as an illegal memory access; rarely a driver error",
+		Action:      XIDActionCheckApplication,
+	},
+	32: {
+		Name:        "Invalid or corrupted push buffer stream",
+		Description: "The DMA controller of the PCI Express bus reported a corrupted command stream",
+		Cause:       "PCI Express bus error, driver error or system memory corruption",
+		Action:      XIDActionResetGPU,
+	},
+	38: {
+		Name:        "Driver firmware error",
+		Description: "The GPU firmware reported an error to the driver",
+		Cause:       "Driver or firmware error",
+		Action:      XIDActionResetGPU,
+	},
+	43: {
+		Name:        "GPU stopped processing",
+		Description: "A channel was stopped after a fault; the GPU keeps serving other applications",
+		Cause:       "Application error that terminated the faulting application",
+		Action:      XIDActionCheckApplication,
+	},
+	45: {
+		Name:        "Preemptive cleanup",
+		Description: "The driver cleaned up the channels of an application, usually after an earlier error or because the application was killed",
+		Cause:       "Application terminated or an earlier XID error",
+		Action:      XIDActionNone,
+	},
+	48: {
+		Name:        "Double bit ECC error",
+		Description: "An uncorrectable double bit ECC error occurred in GPU memory",
+		Cause:       "Hardware memory error",
+		Action:      XIDActionResetGPU,
+	},
+	61: {
+		Name:        "Internal micro-controller breakpoint",
+		Description: "An internal micro-controller of the GPU hit a breakpoint or raised a warning",
+		Cause:       "Firmware or hardware error",
+		Action:      XIDActionResetGPU,
+	},
+	62: {
+		Name:        "Internal micro-controller halt",
+		Description: "An internal micro-controller of the GPU halted",
+		Cause:       "Firmware, hardware or thermal error",
+		Action:      XIDActionResetGPU,
+	},
+	63: {
+		Name:        "ECC page retirement or row remapping event",
+		Description: "A memory page was retired or a row was remapped after an ECC error; it takes effect at the next GPU reset",
+		Cause:       "Hardware memory error",
+		Action:      XIDActionResetGPU,
+	},
+	64: {
+		Name:        "ECC page retirement or row remapping failure",
+		Description: "The GPU failed to record a page retirement or row remapping",
+		Cause:       "Hardware memory error",
+		Action:      XIDActionDrainNode,
+	},
+	68: {
+		Name:        "Video processor exception",
+		Description: "The NVDEC video decoder raised an exception",
+		Cause:       "Application or driver error",
+		Action:      XIDActionCheckApplication,
+	},
+	69: {
+		Name:        "Graphics engine class error",
+		Description: "The graphics engine received an invalid command",
+		Cause:       "Application or driver error",
+		Action:      XIDActionCheckApplication,
+	},
+	74: {
+		Name:        "NVLink error",
+		Description: "An NVLink reported a fatal error",
+		Cause:       "NVLink hardware error or bad connection",
+		Action:      XIDActionResetGPU,
+	},
+	79: {
+		Name:        "GPU has fallen off the bus",
+		Description: "The GPU no longer responds on the PCI Express bus",
+		Cause:       "Hardware, power or thermal error, or a PCI Express link failure",
+		Action:      XIDActionDrainNode,
+	},
+	92: {
+		Name:        "High single-bit ECC error rate",
+		Description: "The GPU reported an unusually high rate of correctable ECC errors",
+		Cause:       "Degrading GPU memory",
+		Action:      XIDActionNone,
+	},
+	94: {
+		Name:        "Contained ECC error",
+		Description: "An uncorrectable ECC error was contained to the applications using the affected memory",
+		Cause:       "Hardware memory error",
+		Action:      XIDActionCheckApplication,
+	},
+	95: {
+		Name:        "Uncontained ECC error",
+		Description: "An uncorrectable ECC error could not be contained and may affect every application on the GPU",
+		Cause:       "Hardware memory error",
+		Action:      XIDActionResetGPU,
+	},
+	109: {
+		Name:        "Context switch timeout",
+		Description: "The GPU timed out switching between application contexts",
+		Cause:       "Application error, such as a long-running kernel; rarely a driver error",
+		Action:      XIDActionCheckApplication,
+	},
+	119: {
+		Name:        "GSP RPC timeout",
+		Description: "The GPU System Processor did not answer a driver request in time",
+		Cause:       "Firmware or driver error",
+		Action:      XIDActionResetGPU,
+	},
+	120: {
+		Name:        "GSP error",
+		Description: "The GPU System Processor reported an error",
+		Cause:       "Firmware or driver error",
+		Action:      XIDActionResetGPU,
+	},
+	143: {
+		Name:        "GPU initialization failure",
+		Description: "The GPU failed to initialize",
+		Cause:       "Hardware or firmware error",
+		Action:      XIDActionDrainNode,
+	},
+}
+
+// LookupXID returns the description of an XID error
+func LookupXID(xid uint) (XIDInfo, bool) {
+	info, ok := xidCatalog[xid]
+	if !ok {
+		return XIDInfo{}, false
+	}
+	info.XID = xid
+	return info, true
+}
+
+// XIDs returns the descriptions of all the XID errors known to LookupXID,
+// ordered by number
+func XIDs() []XIDInfo {
+	infos := make([]XIDInfo, 0, len(xidCatalog))
+	for xid := range xidCatalog {
+		info, _ := LookupXID(xid)
+		infos = append(infos, info)
+	}
+	slices.SortFunc(infos, func(a, b XIDInfo) int { return cmp.Compare(a.XID, b.XID) })
+	return infos
+}
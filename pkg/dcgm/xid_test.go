@@ -0,0 +1,55 @@
+package dcgm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupXID(t *testing.T) {
+	info, ok := LookupXID(79)
+	require.True(t, ok)
+	assert.Equal(t, uint(79), info.XID)
+	assert.Equal(t, "GPU has fallen off the bus", info.Name)
+	assert.Equal(t, XIDActionDrainNode, info.Action)
+	assert.Equal(t, PolicySeverityCritical, info.Severity())
+
+	_, ok = LookupXID(999)
+	assert.False(t, ok)
+}
+
+func TestXIDCatalog(t *testing.T) {
+	actions := []XIDAction{XIDActionNone, XIDActionCheckApplication, XIDActionResetGPU, XIDActionDrainNode}
+
+	infos := XIDs()
+	require.Len(t, infos, len(xidCatalog))
+	for i, info := range infos {
+		if i > 0 {
+			assert.Less(t, infos[i-1].XID, info.XID)
+		}
+		assert.NotEmpty(t, info.Name, info.XID)
+		assert.NotEmpty(t, info.Description, info.XID)
+		assert.NotEmpty(t, info.Cause, info.XID)
+		assert.Contains(t, actions, info.Action, info.XID)
+	}
+}
+
+func TestXIDsAt(t *testing.T) {
+	sample := func(ts, xid int64) FieldValue_v2 {
+		value := FieldValue_v2{EntityGroupId: FE_GPU, FieldID: DCGM_FI_DEV_XID_ERROR, FieldType: DCGM_FT_INT64, TS: ts}
+		copy(value.Value[:], int64Bytes(xid))
+		return value
+	}
+	failed := sample(3000, 48)
+	failed.Status = DCGM_ST_NO_DATA
+	values := []FieldValue_v2{sample(1000, 13), sample(2000, 79), failed, sample(4000, 31)}
+
+	errs := xidsAt(values, []uint64{4000, 2000, 3000, 5000})
+	require.Len(t, errs, 2)
+	assert.Equal(t, uint64(4000), errs[0].Timestamp)
+	assert.Equal(t, uint(31), errs[0].XID)
+	assert.Equal(t, uint64(2000), errs[1].Timestamp)
+	assert.Equal(t, uint(79), errs[1].XID)
+	assert.Equal(t, "GPU has fallen off the bus", errs[1].Info.Name)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/NVIDIA/go-dcgm/pkg/dcgm"
+)
+
+const (
+	healthInterval = 10
+
+	header = `# cpu    pwr  temp  util  cores  busiest  util      clock
+# Idx      W     C     %     #    core      %`
+)
+
+// dcgmi dmon -e 1130,1110,1100,1120
+// dcgmi health -s tp -c
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cleanup, err := dcgm.Init(dcgm.Embedded)
+	if err != nil {
+		log.Panicln(err)
+	}
+	defer cleanup()
+
+	cpus, err := dcgm.GetCPUs()
+	if err != nil {
+		log.Panicln(err)
+	}
+	if len(cpus) == 0 {
+		log.Println("No CPU is managed by DCGM")
+		return
+	}
+
+	for _, cpu := range cpus {
+		fmt.Printf("CPU %d (serial %s): %d cores %s\n", cpu.CPU, cpu.Serial, cpu.Cores.Len(), cpu.Cores)
+	}
+
+	// The health watches gather data while the CPUs are sampled and are
+	// checked every healthInterval samples
+	healthGroup, err := dcgm.EnableCPUHealth(cpus)
+	if err != nil {
+		log.Panicln(err)
+	}
+	defer func() {
+		if err := dcgm.DestroyGroup(healthGroup); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	samples, err := dcgm.WatchCPUs(ctx, cpus, time.Second)
+	if err != nil {
+		log.Panicln(err)
+	}
+
+	fmt.Println(header)
+	count := 0
+	for sample := range samples {
+		if sample.Err != nil {
+			log.Println(sample.Err)
+			continue
+		}
+		if count++; count%healthInterval == 0 {
+			printHealth(healthGroup, cpus)
+		}
+		for _, st := range sample.CPUs {
+			var busiest dcgm.CPUCoreStatus
+			for _, core := range st.Cores {
+				if core.Utilization.Total >= busiest.Utilization.Total {
+					busiest = core
+				}
+			}
+			fmt.Printf("%5d %6.1f %5.0f %5.1f %6d %8d %5.1f %10d\n",
+				st.CPU, st.Power, st.Temperature, 100*st.Utilization.Total, len(st.Cores),
+				busiest.Core, 100*busiest.Utilization.Total, busiest.Clock)
+		}
+	}
+}
+
+func printHealth(group dcgm.GroupHandle, cpus []dcgm.CPUInfo) {
+	health, err := dcgm.CheckCPUHealth(group, cpus)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	for _, report := range health.CPUs {
+		fmt.Printf("# CPU %d health (%s): %s\n", report.Entity.EntityId, health.Watches, report.Overall)
+		for _, incident := range report.Incidents {
+			fmt.Printf("#   %s: %s\n", incident.System, incident.Message)
+		}
+	}
+}
@@ -31,6 +31,9 @@ Avg PCIe Tx Bandwidth (MB)   : {{or .PCI.Throughput.Tx "N/A"}}
 Single Bit ECC Errors        : {{or .Memory.ECCErrors.SingleBit "N/A"}}
 Double Bit ECC Errors        : {{or .Memory.ECCErrors.DoubleBit "N/A"}}
 Critical XID Errors          : {{.XIDErrors.NumErrors}}
+{{- range .XIDErrors.Errors}}
+       - XID {{.XID}}{{with .Info.Name}} ({{.}}){{end}}{{with .Info.Action}}: {{.}}{{end}}
+{{- end}}
 ----------Slowdown Stats----------------------------------------------
 Due to - Power (%)           : {{or .Violations.Power "N/A"}}
        - Thermal (%)         : {{or .Violations.Thermal "N/A"}}
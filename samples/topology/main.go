@@ -70,7 +70,7 @@ func main() {
 			log.Panicln(err)
 		}
 
-		fmt.Printf("%5s\n", deviceInfo.CPUAffinity)
+		fmt.Printf("%5s\n", deviceInfo.CPUs)
 	}
 
 	fmt.Println(legend)